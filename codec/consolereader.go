@@ -101,6 +101,9 @@ func (l *ConsoleReader) Read() (out interface{}, err error) {
 		case strings.HasPrefix(line, "DB_OP"):
 			err = ctx.readDBOp(line)
 
+		case strings.HasPrefix(line, "SEC_IDX_OP"):
+			err = ctx.readSecondaryIndexOp(line)
+
 		case strings.HasPrefix(line, "RLIMIT_OP"):
 			err = ctx.readRlimitOp(line)
 
@@ -199,6 +202,10 @@ func (ctx *parseCtx) recordDBOp(operation *pbcodec.DBOp) {
 	ctx.trx.DbOps = append(ctx.trx.DbOps, operation)
 }
 
+func (ctx *parseCtx) recordSecondaryIndexOp(operation *pbcodec.DBSecondaryIndexOp) {
+	ctx.trx.DbSecondaryIndexOps = append(ctx.trx.DbSecondaryIndexOps, operation)
+}
+
 func (ctx *parseCtx) recordDTrxOp(transaction *pbcodec.DTrxOp) {
 	ctx.trx.DtrxOps = append(ctx.trx.DtrxOps, transaction)
 
@@ -282,6 +289,7 @@ func (ctx *parseCtx) recordTransaction(trace *pbcodec.TransactionTrace) error {
 	trace.CreationTree = CreationTreeToDEOS(toFlatTree(creationTreeRoots...))
 	trace.DtrxOps = ctx.trx.DtrxOps
 	trace.DbOps = ctx.trx.DbOps
+	trace.DbSecondaryIndexOps = ctx.trx.DbSecondaryIndexOps
 	trace.FeatureOps = ctx.trx.FeatureOps
 	trace.PermOps = ctx.trx.PermOps
 	trace.RamOps = ctx.trx.RamOps
@@ -593,6 +601,113 @@ func (ctx *parseCtx) readDBOp(line string) error {
 	return nil
 }
 
+// Line formats:
+//   SEC_IDX_OP INS ${action_id} ${payer} ${table_code} ${scope} ${index_table_name} ${index_kind} ${primkey} ${nsecondary}
+//   SEC_IDX_OP UPD ${action_id} ${opayer}:${npayer} ${table_code} ${scope} ${index_table_name} ${index_kind} ${primkey} ${osecondary}:${nsecondary}
+//   SEC_IDX_OP REM ${action_id} ${payer} ${table_code} ${scope} ${index_table_name} ${index_kind} ${primkey} ${osecondary}
+//
+// The `${index_table_name}` is the name of the table as stored by nodeos for the secondary
+// index, i.e. the primary table name with the index position encoded in its low 4 bits.
+//
+// Stock deep-mind nodeos does NOT emit these lines. They require a nodeos patched so that
+// the `store`, `update` and `remove` methods of `apply_context::generic_index` (one per
+// `idx64`, `idx128`, `idx256`, `idx_double` and `idx_long_double`) print them the same
+// way `DB_OP` lines are printed by `apply_context::db_*_i64`, with the same `${action_id}`
+// and the secondary keys hex encoded as laid out in memory. Without them, no secondary
+// index is ever recorded, which is why FluxDB only serves secondary index reads when
+// explicitly enabled.
+func (ctx *parseCtx) readSecondaryIndexOp(line string) error {
+	chunks := strings.SplitN(line, " ", 10)
+	if len(chunks) != 10 {
+		return fmt.Errorf("expected 10 fields, got %d", len(chunks))
+	}
+
+	actionIndex, err := strconv.Atoi(chunks[2])
+	if err != nil {
+		return fmt.Errorf("action_index is not a valid number, got: %q", chunks[2])
+	}
+
+	indexTable, err := eos.StringToName(chunks[6])
+	if err != nil {
+		return fmt.Errorf("index_table_name is not a valid name, got: %q", chunks[6])
+	}
+
+	indexKind := chunks[7]
+	switch indexKind {
+	case "idx64", "idx128", "idx256", "idx_double", "idx_long_double":
+	default:
+		return fmt.Errorf("unknown index kind: %q", indexKind)
+	}
+
+	opString := chunks[1]
+
+	op := pbcodec.DBOp_OPERATION_UNKNOWN
+	var oldKey, newKey string
+	var oldPayer, newPayer string
+	switch opString {
+	case "INS":
+		op = pbcodec.DBOp_OPERATION_INSERT
+		newKey = chunks[9]
+		newPayer = chunks[3]
+	case "UPD":
+		op = pbcodec.DBOp_OPERATION_UPDATE
+
+		keyChunks := strings.SplitN(chunks[9], ":", 2)
+		if len(keyChunks) != 2 {
+			return fmt.Errorf("should have old and new secondary key in field 9, found only one")
+		}
+
+		oldKey = keyChunks[0]
+		newKey = keyChunks[1]
+
+		payerChunks := strings.SplitN(chunks[3], ":", 2)
+		if len(payerChunks) != 2 {
+			return fmt.Errorf("should have two payers in field 3, separated by a ':', found only one")
+		}
+
+		oldPayer = payerChunks[0]
+		newPayer = payerChunks[1]
+	case "REM":
+		op = pbcodec.DBOp_OPERATION_REMOVE
+		oldKey = chunks[9]
+		oldPayer = chunks[3]
+	default:
+		return fmt.Errorf("unknown operation: %q", opString)
+	}
+
+	var oldBytes, newBytes []byte
+	if len(oldKey) != 0 {
+		oldBytes, err = hex.DecodeString(oldKey)
+		if err != nil {
+			return fmt.Errorf("couldn't decode old_secondary_key: %s", err)
+		}
+	}
+
+	if len(newKey) != 0 {
+		newBytes, err = hex.DecodeString(newKey)
+		if err != nil {
+			return fmt.Errorf("couldn't decode new_secondary_key: %s", err)
+		}
+	}
+
+	ctx.recordSecondaryIndexOp(&pbcodec.DBSecondaryIndexOp{
+		Operation:       op,
+		ActionIndex:     uint32(actionIndex),
+		OldPayer:        oldPayer,
+		NewPayer:        newPayer,
+		Code:            chunks[4],
+		Scope:           chunks[5],
+		TableName:       eos.NameToString(indexTable & 0xFFFFFFFFFFFFFFF0),
+		IndexPosition:   uint32(indexTable & 0x000000000000000F),
+		IndexKind:       indexKind,
+		PrimaryKey:      chunks[8],
+		OldSecondaryKey: oldBytes,
+		NewSecondaryKey: newBytes,
+	})
+
+	return nil
+}
+
 // Line formats:
 //   DTRX_OP MODIFY_CANCEL ${action_id} ${sender} ${sender_id} ${payer} ${published} ${delay} ${expiration} ${trx_id} ${trx}
 //   DTRX_OP MODIFY_CREATE ${action_id} ${sender} ${sender_id} ${payer} ${published} ${delay} ${expiration} ${trx_id} ${trx}
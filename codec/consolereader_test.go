@@ -393,6 +393,90 @@ func Test_readPermOp(t *testing.T) {
 	}
 }
 
+func Test_readSecondaryIndexOp(t *testing.T) {
+	tests := []struct {
+		line        string
+		expected    *pbcodec.DBSecondaryIndexOp
+		expectedErr error
+	}{
+		{
+			`SEC_IDX_OP INS 0 eosio eosio.token eosio accounts idx64 eos 0000000000ea3055`,
+			&pbcodec.DBSecondaryIndexOp{
+				Operation:       pbcodec.DBOp_OPERATION_INSERT,
+				Code:            "eosio.token",
+				Scope:           "eosio",
+				TableName:       "accounts",
+				IndexKind:       "idx64",
+				PrimaryKey:      "eos",
+				NewPayer:        "eosio",
+				NewSecondaryKey: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0xea, 0x30, 0x55},
+			},
+			nil,
+		},
+		{
+			`SEC_IDX_OP UPD 1 eosio:bob eosio.token eosio accounts....2 idx128 eos 0100000000000000000000000000000:02000000000000000000000000000000`,
+			nil,
+			fmt.Errorf("couldn't decode old_secondary_key: encoding/hex: odd length hex string"),
+		},
+		{
+			`SEC_IDX_OP UPD 1 eosio:bob eosio.token eosio accounts....2 idx128 eos 01000000000000000000000000000000:02000000000000000000000000000000`,
+			&pbcodec.DBSecondaryIndexOp{
+				Operation:       pbcodec.DBOp_OPERATION_UPDATE,
+				ActionIndex:     1,
+				Code:            "eosio.token",
+				Scope:           "eosio",
+				TableName:       "accounts",
+				IndexPosition:   2,
+				IndexKind:       "idx128",
+				PrimaryKey:      "eos",
+				OldPayer:        "eosio",
+				NewPayer:        "bob",
+				OldSecondaryKey: []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+				NewSecondaryKey: []byte{0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			},
+			nil,
+		},
+		{
+			`SEC_IDX_OP REM 0 bob eosio.token eosio accounts....1 idx_double eos 000000000000f03f`,
+			&pbcodec.DBSecondaryIndexOp{
+				Operation:       pbcodec.DBOp_OPERATION_REMOVE,
+				Code:            "eosio.token",
+				Scope:           "eosio",
+				TableName:       "accounts",
+				IndexPosition:   1,
+				IndexKind:       "idx_double",
+				PrimaryKey:      "eos",
+				OldPayer:        "bob",
+				OldSecondaryKey: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f},
+			},
+			nil,
+		},
+		{
+			`SEC_IDX_OP INS 0 eosio eosio.token eosio accounts idx32 eos 00000000`,
+			nil,
+			fmt.Errorf("unknown index kind: \"idx32\""),
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			ctx := newParseCtx()
+			err := ctx.readSecondaryIndexOp(test.line)
+
+			require.Equal(t, test.expectedErr, err)
+
+			if test.expectedErr == nil {
+				require.Len(t, ctx.trx.DbSecondaryIndexOps, 1)
+
+				expected := protoJSONMarshalIndent(t, test.expected)
+				actual := protoJSONMarshalIndent(t, ctx.trx.DbSecondaryIndexOps[0])
+
+				assert.JSONEq(t, expected, actual, diff.LineDiff(expected, actual))
+			}
+		})
+	}
+}
+
 func mustTimeParse(input string) time.Time {
 	value, err := time.Parse("2006-01-02T15:04:05", input)
 	if err != nil {
@@ -0,0 +1,77 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosdb
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/dfuse-io/dfuse-eosio/eosdb/mdl"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
+)
+
+// AccountsTouchedByTransaction returns the accounts a transaction is listed under by the
+// `AccountHistoryReader` drivers: the receivers, contracts and authorizers of its actions.
+func AccountsTouchedByTransaction(trxTrace *pbcodec.TransactionTrace) (out []string) {
+	seen := map[string]bool{}
+	add := func(account string) {
+		if account != "" && !seen[account] {
+			seen[account] = true
+			out = append(out, account)
+		}
+	}
+
+	for _, act := range trxTrace.ActionTraces {
+		add(act.Receiver)
+		if act.Action != nil {
+			add(act.Action.Account)
+			for _, auth := range act.Action.Authorization {
+				add(auth.Actor)
+			}
+		}
+	}
+
+	return
+}
+
+// AccountTransactionCursor returns the cursor resuming a listing right after `ref`, it
+// has the same format for all the drivers.
+func AccountTransactionCursor(ref *mdl.AccountTransactionRef) string {
+	return ref.BlockID + ":" + ref.TransactionID
+}
+
+func DecodeAccountTransactionCursor(cursor string) (blockID, trxID string, err error) {
+	chunks := strings.Split(cursor, ":")
+	if len(chunks) != 2 || len(chunks[0]) != 64 || len(chunks[1]) != 64 {
+		return "", "", fmt.Errorf("invalid account transactions cursor %q", cursor)
+	}
+
+	if _, err := hex.DecodeString(chunks[0] + chunks[1]); err != nil {
+		return "", "", fmt.Errorf("invalid account transactions cursor %q: %w", cursor, err)
+	}
+
+	return chunks[0], chunks[1], nil
+}
+
+// NewAccountTransactionRef returns the listed reference to transaction `trxID` of block `blockID`
+func NewAccountTransactionRef(blockID, trxID string) *mdl.AccountTransactionRef {
+	return &mdl.AccountTransactionRef{
+		TransactionID: trxID,
+		BlockID:       blockID,
+		BlockNum:      eos.BlockNum(blockID),
+	}
+}
@@ -112,6 +112,36 @@ func (Keyer) ReadAccountLink(key string) (creator, created uint64, err error) {
 	return
 }
 
+// Account transactions, in the timeline table
+
+func (Keyer) AccountTransaction(account uint64, blockID, transactionID string) string {
+	return Keys.AccountTransactionPrefix(account) + kvdb.ReversedBlockID(blockID) + ":" + transactionID
+}
+
+func (Keyer) AccountTransactionPrefix(account uint64) string {
+	return "at:" + kvdb.HexName(account) + ":"
+}
+
+func (Keyer) ReadAccountTransaction(key string) (account uint64, blockID, transactionID string, err error) {
+	chunks := strings.Split(key, ":")
+	if len(chunks) != 4 {
+		err = fmt.Errorf("should have found 4 elements in key %q, found %d", key, len(chunks))
+		return
+	}
+	if chunks[0] != "at" {
+		err = fmt.Errorf("account transaction key [%s] should start with 'at'", key)
+		return
+	}
+
+	account, err = strconv.ParseUint(chunks[1], 16, 64)
+	if err != nil {
+		err = fmt.Errorf("parsing account of account transaction key: %s", err)
+		return
+	}
+
+	return account, kvdb.ReversedBlockID(chunks[2]), chunks[3], nil
+}
+
 // Transactions table
 
 func (Keyer) Transaction(transactionID, blockID string) string {
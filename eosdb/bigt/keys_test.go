@@ -44,6 +44,16 @@ func TestAccountsTableKey(t *testing.T) {
 	assert.Equal(t, uint64(99), account)
 }
 
+func TestAccountTransactionKey(t *testing.T) {
+	assert.Equal(t, "at:0000000000000063:ffff7ff71122334455:trxid", Keys.AccountTransaction(99, "000080081122334455", "trxid"))
+
+	account, blockID, trxID, err := Keys.ReadAccountTransaction("at:0000000000000063:ffff7ff71122334455:trxid")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(99), account)
+	assert.Equal(t, "000080081122334455", blockID)
+	assert.Equal(t, "trxid", trxID)
+}
+
 func TestBlocksTableKey(t *testing.T) {
 	expectedOutput := "ffff7ff71122334455"
 	assert.Equal(t, expectedOutput, Keys.Block("000080081122334455"))
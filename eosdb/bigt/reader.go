@@ -412,6 +412,60 @@ func (b *EOSDatabase) GetAccount(ctx context.Context, accountName string) (*pbco
 	return out, nil
 }
 
+func (b *EOSDatabase) ListTransactionsForAccount(ctx context.Context, accountName string, cursor string, limit int) (*mdl.AccountTransactionList, error) {
+	ctx, span := b.StartSpan(ctx, "list transactions for account",
+		trace.StringAttribute("account_name", accountName),
+		trace.StringAttribute("cursor", cursor),
+		trace.Int64Attribute("limit", int64(limit)),
+	)
+	defer span.End()
+
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit %d", limit)
+	}
+
+	name, err := eos.StringToName(accountName)
+	if err != nil {
+		return nil, fmt.Errorf("string to name: %s", err)
+	}
+
+	prefix := Keys.AccountTransactionPrefix(name)
+	start := prefix
+	if cursor != "" {
+		blockID, trxID, err := eosdb.DecodeAccountTransactionCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		// Keys having a fixed length, the zero byte makes the start exclusive
+		start = Keys.AccountTransaction(name, blockID, trxID) + "\x00"
+	}
+
+	// One more than the limit is read to know if there is a next page
+	end := prefix[:len(prefix)-1] + ";"
+	keys, err := b.Timeline.ReadRows(ctx, bigtable.NewRange(start, end), latestCellFilter, bigtable.LimitRows(int64(limit+1)))
+	if err != nil {
+		return nil, err
+	}
+
+	out := &mdl.AccountTransactionList{}
+	for _, key := range keys {
+		if len(out.Transactions) == limit {
+			out.NextCursor = eosdb.AccountTransactionCursor(out.Transactions[limit-1])
+			break
+		}
+
+		_, blockID, trxID, err := Keys.ReadAccountTransaction(key)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Transactions = append(out.Transactions, eosdb.NewAccountTransactionRef(blockID, trxID))
+	}
+
+	return out, nil
+}
+
 // FIXME: delete this
 // // ListMostRecentTransactions has a cursor that looks like: `block_id`:`trx_index:uint16`
 // func (b *EOSDatabase) ListMostRecentTransactions(ctx context.Context, startKey string, limit int, chainDiscriminator eosdb.ChainDiscriminator) (*mdl.TransactionList, error) {
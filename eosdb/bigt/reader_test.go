@@ -19,6 +19,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"cloud.google.com/go/bigtable/bttest"
 	"github.com/dfuse-io/bstream"
 	"github.com/dfuse-io/dfuse-eosio/eosdb"
+	"github.com/dfuse-io/dfuse-eosio/eosdb/mdl"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	"github.com/dfuse-io/kvdb"
 	eos "github.com/eoscanada/eos-go"
@@ -1130,3 +1132,57 @@ func TestTimeline(t *testing.T) {
 	_, _, err = db.BlockIDBefore(context.Background(), t1.Add(-time.Millisecond*123), true)
 	assert.Error(t, err)
 }
+
+func accountTransaction(account, blockID, trxID string) DBInserter {
+	return func(db *EOSDatabase) {
+		db.Timeline.PutMetaExists(Keys.AccountTransaction(eos.MustStringToName(account), blockID, trxID))
+	}
+}
+
+func TestBigtable_ListTransactionsForAccount(t *testing.T) {
+	db, cleanup := newServer(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	blockID := func(num int) string { return fmt.Sprintf("%08x", num) + strings.Repeat("a", 56) }
+	trxID := func(num int) string { return fmt.Sprintf("%08x", num) + strings.Repeat("b", 56) }
+
+	populateBigtableWithRows(t, db,
+		accountTransaction("bob", blockID(2), trxID(1)),
+		accountTransaction("bob", blockID(3), trxID(3)),
+		accountTransaction("bob", blockID(4), trxID(4)),
+		accountTransaction("bob", blockID(4), trxID(5)),
+
+		// Accounts whose keys surround bob's ones must not be listed
+		accountTransaction("bo", blockID(2), trxID(2)),
+		accountTransaction("boba", blockID(3), trxID(3)),
+	)
+	require.NoError(t, db.FlushAllMutations(ctx))
+
+	page, err := db.ListTransactionsForAccount(ctx, "bob", "", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []*mdl.AccountTransactionRef{
+		{TransactionID: trxID(4), BlockID: blockID(4), BlockNum: 4},
+		{TransactionID: trxID(5), BlockID: blockID(4), BlockNum: 4},
+		{TransactionID: trxID(3), BlockID: blockID(3), BlockNum: 3},
+	}, page.Transactions)
+	require.Equal(t, blockID(3)+":"+trxID(3), page.NextCursor)
+
+	page, err = db.ListTransactionsForAccount(ctx, "bob", page.NextCursor, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []*mdl.AccountTransactionRef{
+		{TransactionID: trxID(1), BlockID: blockID(2), BlockNum: 2},
+	}, page.Transactions)
+	assert.Empty(t, page.NextCursor)
+
+	page, err = db.ListTransactionsForAccount(ctx, "alice", "", 3)
+	require.NoError(t, err)
+	assert.Empty(t, page.Transactions)
+	assert.Empty(t, page.NextCursor)
+
+	_, err = db.ListTransactionsForAccount(ctx, "bob", "not a cursor", 3)
+	assert.Error(t, err)
+
+	_, err = db.ListTransactionsForAccount(ctx, "bob", "", 0)
+	assert.Error(t, err)
+}
@@ -17,6 +17,7 @@ package bigt
 import (
 	"encoding/json"
 
+	"github.com/dfuse-io/dfuse-eosio/eosdb"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	"github.com/eoscanada/eos-go"
 	"github.com/eoscanada/eos-go/system"
@@ -70,3 +71,17 @@ func (db *EOSDatabase) storeNewAccount(blk *pbcodec.Block, trxTrace *pbcodec.Tra
 	linkKey := Keys.AccountLink(creatorID, createdID)
 	db.Accounts.PutMetaExists(linkKey)
 }
+
+func (db *EOSDatabase) storeAccountTransactions(blk *pbcodec.Block) {
+	for _, trxTrace := range blk.TransactionTraces {
+		for _, account := range eosdb.AccountsTouchedByTransaction(trxTrace) {
+			accountID, err := eos.StringToName(account)
+			if err != nil {
+				zlog.Error("account touched by transaction should have been a valid EOS name", zap.Error(err), zap.String("account", account), zap.String("trx_id", trxTrace.Id))
+				continue
+			}
+
+			db.Timeline.PutMetaExists(Keys.AccountTransaction(accountID, blk.Id, trxTrace.Id))
+		}
+	}
+}
@@ -54,6 +54,8 @@ func (db *EOSDatabase) PutBlock(ctx context.Context, blk *pbcodec.Block) error {
 		db.Transactions.PutMetaWritten(transactionKey, true)
 	}
 
+	db.storeAccountTransactions(blk)
+
 	db.BlocksLast.PutMetaWritten(btKey)
 	db.blocksSinceFlush++
 	return db.flushIfNeeded(ctx)
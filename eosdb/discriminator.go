@@ -0,0 +1,59 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosdb
+
+import (
+	"context"
+
+	"github.com/dfuse-io/kvdb"
+	"github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+// NewIrreversibleChainDiscriminator returns a `ChainDiscriminator` using the irreversible
+// blocks recorded in the database: a block is out of the canonical chain when another
+// block is irreversible at its height. Blocks above the last irreversible block cannot be
+// discriminated this way, they are all considered in the canonical chain.
+//
+// The irreversible block of each height is fetched once with `ctx` and remembered, so a
+// discriminator is meant to be created for each request, like merging the events of the
+// transactions it returns, and not kept around. It is not safe for concurrent use.
+func NewIrreversibleChainDiscriminator(ctx context.Context, reader BlocksReader) ChainDiscriminator {
+	// Irreversible block ID at each height, empty when there is none
+	irreversibleIDs := map[uint32]string{}
+
+	return func(blockID string) bool {
+		blockNum := eos.BlockNum(blockID)
+		irreversibleID, found := irreversibleIDs[blockNum]
+		if !found {
+			ref, err := reader.GetClosestIrreversibleIDAtBlockNum(ctx, blockNum)
+			if err != nil && err != kvdb.ErrNotFound {
+				zlog.Warn("unable to get irreversible block, considering block in canonical chain", zap.String("block_id", blockID), zap.Error(err))
+				return true
+			}
+
+			if err == nil && ref.Num() == uint64(blockNum) {
+				irreversibleID = ref.ID()
+			}
+			irreversibleIDs[blockNum] = irreversibleID
+		}
+
+		if irreversibleID == "" {
+			return true
+		}
+
+		return irreversibleID == blockID
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/kvdb"
+	"github.com/stretchr/testify/assert"
+)
+
+type irreversibleBlocksReader struct {
+	BlocksReader
+
+	irreversibleIDs map[uint32]string
+	calls           map[uint32]int
+	ctx             context.Context
+}
+
+func (r *irreversibleBlocksReader) GetClosestIrreversibleIDAtBlockNum(ctx context.Context, num uint32) (bstream.BlockRef, error) {
+	r.ctx = ctx
+	r.calls[num]++
+
+	id, found := r.irreversibleIDs[num]
+	if !found {
+		return nil, kvdb.ErrNotFound
+	}
+
+	return bstream.NewBlockRefFromID(bstream.BlockRefFromID(id)), nil
+}
+
+func TestIrreversibleChainDiscriminator_FetchesEachHeightOnce(t *testing.T) {
+	reader := &irreversibleBlocksReader{
+		irreversibleIDs: map[uint32]string{2: "00000002aa"},
+		calls:           map[uint32]int{},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	inCanonicalChain := NewIrreversibleChainDiscriminator(ctx, reader)
+
+	assert.True(t, inCanonicalChain("00000002aa"))
+	assert.False(t, inCanonicalChain("00000002bb"))
+	assert.True(t, inCanonicalChain("00000002aa"))
+	assert.True(t, inCanonicalChain("00000003aa"))
+	assert.True(t, inCanonicalChain("00000003bb"))
+
+	assert.Equal(t, map[uint32]int{2: 1, 3: 1}, reader.calls)
+	assert.Equal(t, ctx, reader.ctx, "the caller's context should be used")
+}
@@ -0,0 +1,172 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosdbtest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/eosdb"
+	"github.com/dfuse-io/dfuse-eosio/eosdb/mdl"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var accountHistoryReaderTests = []struct {
+	name string
+	test func(t *testing.T, driverFactory DriverFactory)
+}{
+	{"TestListTransactionsForAccount", TestListTransactionsForAccount},
+	{"TestListTransactionsForAccountInvalidCursor", TestListTransactionsForAccountInvalidCursor},
+	{"TestListTransactionsForAccountBlockPutAgain", TestListTransactionsForAccountBlockPutAgain},
+}
+
+// TestAllAccountHistoryReader skips the drivers not implementing `eosdb.AccountHistoryReader`
+func TestAllAccountHistoryReader(t *testing.T, driverName string, driverFactory DriverFactory) {
+	for _, rt := range accountHistoryReaderTests {
+		t.Run(driverName+"/"+rt.name, func(t *testing.T) {
+			rt.test(t, driverFactory)
+		})
+	}
+}
+
+func TestListTransactionsForAccount(t *testing.T, driverFactory DriverFactory) {
+	db, clean := driverFactory()
+	defer clean()
+
+	reader := accountHistoryReader(t, db)
+	ctx := context.Background()
+
+	putBlocks(t, db,
+		TestBlock(t, blockID(2), blockID(1),
+			transferTrace(trxID(1), "alice", "bob"),
+			transferTrace(trxID(2), "carol", "dan"),
+		),
+		TestBlock(t, blockID(3), blockID(2),
+			transferTrace(trxID(3), "bob", "carol"),
+		),
+		TestBlock(t, blockID(4), blockID(3),
+			transferTrace(trxID(5), "bob", "alice"),
+			transferTrace(trxID(4), "alice", "bob"),
+		),
+	)
+
+	page, err := reader.ListTransactionsForAccount(ctx, "bob", "", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []*mdl.AccountTransactionRef{
+		{TransactionID: trxID(4), BlockID: blockID(4), BlockNum: 4},
+		{TransactionID: trxID(5), BlockID: blockID(4), BlockNum: 4},
+	}, page.Transactions)
+	require.NotEmpty(t, page.NextCursor)
+
+	page, err = reader.ListTransactionsForAccount(ctx, "bob", page.NextCursor, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []*mdl.AccountTransactionRef{
+		{TransactionID: trxID(3), BlockID: blockID(3), BlockNum: 3},
+		{TransactionID: trxID(1), BlockID: blockID(2), BlockNum: 2},
+	}, page.Transactions)
+	assert.Empty(t, page.NextCursor, "exactly the limit being left, there should be no next page")
+
+	// Accounts are listed whether they authorized the transaction or were only notified by it
+	page, err = reader.ListTransactionsForAccount(ctx, "dan", "", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*mdl.AccountTransactionRef{
+		{TransactionID: trxID(2), BlockID: blockID(2), BlockNum: 2},
+	}, page.Transactions)
+
+	page, err = reader.ListTransactionsForAccount(ctx, "eosio.token", "", 10)
+	require.NoError(t, err)
+	assert.Len(t, page.Transactions, 5)
+	assert.Empty(t, page.NextCursor)
+
+	page, err = reader.ListTransactionsForAccount(ctx, "nobody", "", 10)
+	require.NoError(t, err)
+	assert.Empty(t, page.Transactions)
+	assert.Empty(t, page.NextCursor)
+}
+
+func TestListTransactionsForAccountInvalidCursor(t *testing.T, driverFactory DriverFactory) {
+	db, clean := driverFactory()
+	defer clean()
+
+	reader := accountHistoryReader(t, db)
+
+	_, err := reader.ListTransactionsForAccount(context.Background(), "bob", "not a cursor", 10)
+	assert.Error(t, err)
+
+	_, err = reader.ListTransactionsForAccount(context.Background(), "bob", "", 0)
+	assert.Error(t, err)
+}
+
+// TestListTransactionsForAccountBlockPutAgain covers the backfill of the index, done by
+// putting blocks again, which must not list their transactions twice
+func TestListTransactionsForAccountBlockPutAgain(t *testing.T, driverFactory DriverFactory) {
+	db, clean := driverFactory()
+	defer clean()
+
+	reader := accountHistoryReader(t, db)
+
+	blk := TestBlock(t, blockID(2), blockID(1), transferTrace(trxID(1), "alice", "bob"))
+	putBlocks(t, db, blk)
+	putBlocks(t, db, blk)
+
+	page, err := reader.ListTransactionsForAccount(context.Background(), "bob", "", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*mdl.AccountTransactionRef{
+		{TransactionID: trxID(1), BlockID: blockID(2), BlockNum: 2},
+	}, page.Transactions)
+}
+
+func accountHistoryReader(t *testing.T, db eosdb.Driver) eosdb.AccountHistoryReader {
+	reader, ok := db.(eosdb.AccountHistoryReader)
+	if !ok {
+		t.Skipf("driver %T does not implement eosdb.AccountHistoryReader", db)
+	}
+
+	return reader
+}
+
+func putBlocks(t *testing.T, db eosdb.Driver, blocks ...*pbcodec.Block) {
+	ctx := context.Background()
+	for _, blk := range blocks {
+		require.NoError(t, db.PutBlock(ctx, blk))
+	}
+	require.NoError(t, db.Flush(ctx))
+}
+
+// transferTrace is a trace of `from` transferring to `to`, which is notified
+func transferTrace(id string, from, to string) string {
+	return fmt.Sprintf(`{"id":"%s","action_traces":[
+		{"receiver":"eosio.token","action":{"account":"eosio.token","name":"transfer","authorization":[{"actor":"%s","permission":"active"}]}},
+		{"receiver":"%s","action":{"account":"eosio.token","name":"transfer","authorization":[{"actor":"%s","permission":"active"}]}},
+		{"receiver":"%s","action":{"account":"eosio.token","name":"transfer","authorization":[{"actor":"%s","permission":"active"}]}}
+	]}`, id, from, from, from, to, from)
+}
+
+func blockID(num uint32) string {
+	return forkBlockID(num, "aa")
+}
+
+// forkBlockID returns the ID of block `num` in the fork identified by the hex byte `fork`
+func forkBlockID(num uint32, fork string) string {
+	return fmt.Sprintf("%08x", num) + strings.Repeat(fork, 28)
+}
+
+func trxID(index int) string {
+	return fmt.Sprintf("%02x", index) + strings.Repeat("bb", 31)
+}
@@ -0,0 +1,126 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosdbtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/eosdb"
+	"github.com/dfuse-io/kvdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var forkPrunerTests = []struct {
+	name string
+	test func(t *testing.T, driverFactory DriverFactory)
+}{
+	{"TestIrreversibleChainDiscriminator", TestIrreversibleChainDiscriminator},
+	{"TestPruneForkedBlocks", TestPruneForkedBlocks},
+}
+
+func TestAllForkPruner(t *testing.T, driverName string, driverFactory DriverFactory) {
+	for _, rt := range forkPrunerTests {
+		t.Run(driverName+"/"+rt.name, func(t *testing.T) {
+			rt.test(t, driverFactory)
+		})
+	}
+}
+
+func TestIrreversibleChainDiscriminator(t *testing.T, driverFactory DriverFactory) {
+	db, clean := driverFactory()
+	defer clean()
+
+	putForkedBlocks(t, db)
+
+	inCanonicalChain := eosdb.NewIrreversibleChainDiscriminator(context.Background(), db)
+	assert.True(t, inCanonicalChain(forkBlockID(5, "aa")), "irreversible block")
+	assert.False(t, inCanonicalChain(forkBlockID(5, "bb")), "forked out block")
+	assert.True(t, inCanonicalChain(forkBlockID(6, "aa")), "block above the last irreversible block")
+	assert.True(t, inCanonicalChain(forkBlockID(6, "bb")), "block above the last irreversible block")
+}
+
+func TestPruneForkedBlocks(t *testing.T, driverFactory DriverFactory) {
+	db, clean := driverFactory()
+	defer clean()
+
+	pruner, ok := db.(eosdb.ForkPruner)
+	if !ok {
+		t.Skipf("driver %T does not implement eosdb.ForkPruner", db)
+	}
+
+	ctx := context.Background()
+	putForkedBlocks(t, db)
+
+	prunedCount, err := pruner.PruneForkedBlocks(ctx, 4, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 1, prunedCount)
+	require.NoError(t, db.Flush(ctx))
+
+	_, err = db.GetBlock(ctx, forkBlockID(5, "bb"))
+	assert.Equal(t, kvdb.ErrNotFound, err)
+
+	// The irreversible block is kept, as well as both blocks which can still be reorganized
+	for _, blockID := range []string{forkBlockID(5, "aa"), forkBlockID(6, "aa"), forkBlockID(6, "bb")} {
+		_, err := db.GetBlock(ctx, blockID)
+		assert.NoError(t, err, blockID)
+	}
+
+	// The transaction included in both forks is only left in the irreversible one
+	evs, err := db.GetTransactionEvents(ctx, trxID(1))
+	require.NoError(t, err)
+	require.NotEmpty(t, evs)
+	for _, ev := range evs {
+		assert.Equal(t, forkBlockID(5, "aa"), ev.BlockId)
+	}
+
+	evs, _ = db.GetTransactionEvents(ctx, trxID(2))
+	assert.Empty(t, evs, "transaction only in the forked out block")
+
+	if reader, ok := db.(eosdb.AccountHistoryReader); ok {
+		page, err := reader.ListTransactionsForAccount(ctx, "bob", "", 10)
+		require.NoError(t, err)
+		for _, ref := range page.Transactions {
+			assert.NotEqual(t, forkBlockID(5, "bb"), ref.BlockID)
+		}
+		assert.Len(t, page.Transactions, 3)
+	}
+
+	prunedCount, err = pruner.PruneForkedBlocks(ctx, 4, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 0, prunedCount, "pruning again should be a no-op")
+}
+
+// putForkedBlocks puts two blocks at height 5, only `aa` becoming irreversible, and two
+// reversible ones at height 6. Lower heights are avoided, some drivers hard-code the
+// irreversible blocks of heights 1 and 2.
+func putForkedBlocks(t *testing.T, db eosdb.Driver) {
+	ctx := context.Background()
+
+	irreversibleBlock := TestBlock(t, forkBlockID(5, "aa"), forkBlockID(4, "aa"), transferTrace(trxID(1), "alice", "bob"))
+	putBlocks(t, db,
+		irreversibleBlock,
+		TestBlock(t, forkBlockID(5, "bb"), forkBlockID(4, "aa"),
+			transferTrace(trxID(1), "alice", "bob"),
+			transferTrace(trxID(2), "bob", "carol"),
+		),
+		TestBlock(t, forkBlockID(6, "aa"), forkBlockID(5, "aa"), transferTrace(trxID(3), "bob", "carol")),
+		TestBlock(t, forkBlockID(6, "bb"), forkBlockID(5, "aa"), transferTrace(trxID(4), "carol", "bob")),
+	)
+
+	require.NoError(t, db.UpdateNowIrreversibleBlock(ctx, irreversibleBlock))
+	require.NoError(t, db.Flush(ctx))
+}
@@ -40,6 +40,8 @@ func TestAll(t *testing.T, driverName string, driverFactory DriverFactory) {
 	TestAllDbReader(t, driverName, driverFactory)
 	TestAllTimelineExplorer(t, driverName, driverFactory)
 	TestAllTransactionsReader(t, driverName, driverFactory)
+	TestAllAccountHistoryReader(t, driverName, driverFactory)
+	TestAllForkPruner(t, driverName, driverFactory)
 }
 
 func TestBlock(t *testing.T, blkId string, previousBlkId string, trxTraceJSONs ...string) *pbcodec.Block {
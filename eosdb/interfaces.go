@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/dfuse-eosio/eosdb/mdl"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 )
 
@@ -65,6 +66,32 @@ type TransactionsReader interface {
 	GetTransactionEventsBatch(ctx context.Context, idPrefixes []string) ([][]*pbcodec.TransactionEvent, error)
 }
 
+// AccountHistoryReader is implemented by the drivers indexing, when a block is put, the
+// transactions that touched each account. It's not part of `DBReader`, callers type
+// assert the driver to know if it's supported.
+//
+// There is no dedicated backfill, blocks put before the index was introduced have none of
+// their transactions listed. Putting a block again is harmless, so the index is backfilled
+// by running `kvdb-loader` in `batch` mode over the blocks already loaded.
+type AccountHistoryReader interface {
+	// ListTransactionsForAccount returns at most `limit` transactions that touched
+	// `account`, most recent first, starting after `cursor` (from the most recent when
+	// empty). Transactions in forked blocks are listed too, it's up to the caller to
+	// discriminate them using the block IDs.
+	ListTransactionsForAccount(ctx context.Context, account string, cursor string, limit int) (*mdl.AccountTransactionList, error)
+}
+
+// ForkPruner is implemented by the drivers able to delete the blocks that were forked out,
+// along with their transactions and traces. It's not part of `DBWriter`, callers type
+// assert the driver to know if it's supported.
+type ForkPruner interface {
+	// PruneForkedBlocks deletes, for each block number between `lowBlockNum` and
+	// `highBlockNum` inclusively, the blocks that are not the irreversible one. Block
+	// numbers for which no irreversible block is recorded yet are left untouched, so it's
+	// safe to call on blocks that can still be reorganized.
+	PruneForkedBlocks(ctx context.Context, lowBlockNum, highBlockNum uint32) (prunedCount int, err error)
+}
+
 type TimelineExplorer interface {
 	BlockIDAt(ctx context.Context, start time.Time) (id string, err error)
 	BlockIDAfter(ctx context.Context, start time.Time, inclusive bool) (id string, foundtime time.Time, err error)
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/dfuse-io/kvdb"
@@ -21,6 +22,7 @@ const (
 
 	idxPrefixTimelineFwd = 0x80
 	idxPrefixTimelineBck = 0x81
+	idxPrefixAcctTrxs    = 0x82
 )
 
 var Keys Keyer
@@ -220,6 +222,64 @@ func (Keyer) EndOfTimelineIndex(fwd bool) []byte {
 	return []byte{idxPrefixTimelineBck + 1}
 }
 
+// Account transactions index
+
+// PackAccountTrxsKey packs the key recording that the transaction touched the account,
+// an error is returned when the account name, the block ID or the transaction ID is invalid.
+func (k Keyer) PackAccountTrxsKey(accountName, blockID, trxID string) ([]byte, error) {
+	prefix, err := k.PackAccountTrxsPrefix(accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := hex.DecodeString(kvdb.ReversedBlockID(blockID) + trxID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block ID %q or trx ID %q: %w", blockID, trxID, err)
+	}
+	if len(id) != 64 {
+		return nil, fmt.Errorf("invalid block ID %q or trx ID %q: expected 32 bytes each", blockID, trxID)
+	}
+
+	return append(prefix, id...), nil
+}
+
+func (Keyer) UnpackAccountTrxsKey(key []byte) (accountName, blockID, trxID string) {
+	if len(key) != 73 {
+		panic("invalid key length")
+	}
+	accountName = eos.NameToString(binary.BigEndian.Uint64(key[1:9]))
+	blockID = kvdb.ReversedBlockID(hex.EncodeToString(key[9:41]))
+	trxID = hex.EncodeToString(key[41:73])
+	return
+}
+
+// PackAccountTrxsPrefix packs the prefix of all the account's transactions, the block ID
+// following it being reversed, they are ordered from the most recent block.
+func (Keyer) PackAccountTrxsPrefix(accountName string) ([]byte, error) {
+	name, err := eos.StringToName(accountName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account name %q: %w", accountName, err)
+	}
+	b := make([]byte, 9)
+	b[0] = idxPrefixAcctTrxs
+	binary.BigEndian.PutUint64(b[1:], name)
+	return b, nil
+}
+
+func (k Keyer) EndOfAccountTrxs(accountName string) ([]byte, error) {
+	b, err := k.PackAccountTrxsPrefix(accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	name := binary.BigEndian.Uint64(b[1:])
+	if name == math.MaxUint64 {
+		return []byte{idxPrefixAcctTrxs + 1}, nil
+	}
+	binary.BigEndian.PutUint64(b[1:], name+1)
+	return b, nil
+}
+
 // Common
 
 func (Keyer) packTrxBlockIDKey(prefix byte, trxID, blockID string) []byte {
@@ -1,6 +1,7 @@
 package kv
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -30,3 +31,47 @@ func TestKeyer_PackTimelineKey(t *testing.T) {
 	require.Equal(t, expectedBlockID, blockID)
 	require.Equal(t, expectedBlockTime, blockTime)
 }
+
+func TestKeyer_PackAccountTrxsKey(t *testing.T) {
+	expectedBlockID := "00000002aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	expectedTrxID := "00112233aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+	packed, err := Keys.PackAccountTrxsKey("eoscanadacom", expectedBlockID, expectedTrxID)
+	require.NoError(t, err)
+
+	account, blockID, trxID := Keys.UnpackAccountTrxsKey(packed)
+	require.Equal(t, "eoscanadacom", account)
+	require.Equal(t, expectedBlockID, blockID)
+	require.Equal(t, expectedTrxID, trxID)
+
+	prefix, err := Keys.PackAccountTrxsPrefix("eoscanadacom")
+	require.NoError(t, err)
+	end, err := Keys.EndOfAccountTrxs("eoscanadacom")
+	require.NoError(t, err)
+
+	require.True(t, bytes.Compare(prefix, packed) < 0)
+	require.True(t, bytes.Compare(packed, end) < 0)
+}
+
+func TestKeyer_PackAccountTrxsKey_Invalid(t *testing.T) {
+	validID := "00000002aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+	tests := []struct {
+		name        string
+		account     string
+		blockID     string
+		trxID       string
+		expectedErr string
+	}{
+		{"non hex trx ID", "eoscanadacom", validID, "zz", "invalid block ID"},
+		{"short trx ID", "eoscanadacom", validID, "0011", "expected 32 bytes each"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Keys.PackAccountTrxsKey(test.account, test.blockID, test.trxID)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
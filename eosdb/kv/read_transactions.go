@@ -2,7 +2,10 @@ package kv
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/dfuse-io/dfuse-eosio/eosdb"
+	"github.com/dfuse-io/dfuse-eosio/eosdb/mdl"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	pbeosdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/eosdb/v1"
 	"github.com/dfuse-io/kvdb"
@@ -218,3 +221,54 @@ func (db *DB) getTransactionDtrxEvents(ctx context.Context, idPrefix string) (ou
 
 	return
 }
+
+func (db *DB) ListTransactionsForAccount(ctx context.Context, account string, cursor string, limit int) (*mdl.AccountTransactionList, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit %d", limit)
+	}
+
+	start, err := Keys.PackAccountTrxsPrefix(account)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := Keys.EndOfAccountTrxs(account)
+	if err != nil {
+		return nil, err
+	}
+
+	if cursor != "" {
+		blockID, trxID, err := eosdb.DecodeAccountTransactionCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		cursorKey, err := Keys.PackAccountTrxsKey(account, blockID, trxID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor: %w", err)
+		}
+
+		// Keys having a fixed length, the zero byte makes the start exclusive
+		start = append(cursorKey, 0x00)
+	}
+
+	out := &mdl.AccountTransactionList{}
+
+	// One more than the limit is read to know if there is a next page
+	it := db.store.Scan(ctx, start, end, limit+1)
+	defer it.Close()
+	for it.Next() {
+		if len(out.Transactions) == limit {
+			out.NextCursor = eosdb.AccountTransactionCursor(out.Transactions[limit-1])
+			break
+		}
+
+		_, blockID, trxID := Keys.UnpackAccountTrxsKey(it.Item().Key)
+		out.Transactions = append(out.Transactions, eosdb.NewAccountTransactionRef(blockID, trxID))
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
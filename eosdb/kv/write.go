@@ -53,6 +53,10 @@ func (db *DB) PutBlock(ctx context.Context, blk *pbcodec.Block) error {
 		return fmt.Errorf("put block: unable to putTransactions: %w", err)
 	}
 
+	if err := db.putAccountTransactions(ctx, blk); err != nil {
+		return fmt.Errorf("put block: unable to putAccountTransactions: %w", err)
+	}
+
 	return db.putBlock(ctx, blk)
 }
 
@@ -169,6 +173,26 @@ func (db *DB) putImplicitTransactions(ctx context.Context, blk *pbcodec.Block) e
 	return nil
 }
 
+// putAccountTransactions indexes the transactions of the block by account. Blocks put
+// before the index existed are only indexed when put again, see `eosdb.AccountHistoryReader`.
+func (db *DB) putAccountTransactions(ctx context.Context, blk *pbcodec.Block) error {
+	for _, trxTrace := range blk.TransactionTraces {
+		for _, account := range eosdb.AccountsTouchedByTransaction(trxTrace) {
+			key, err := Keys.PackAccountTrxsKey(account, blk.Id, trxTrace.Id)
+			if err != nil {
+				zlog.Error("unable to pack account transaction key, skipping it", zap.Error(err), zap.String("account", account), zap.String("block_id", blk.Id), zap.String("trx_id", trxTrace.Id))
+				continue
+			}
+
+			if err := db.store.Put(ctx, key, oneByte); err != nil {
+				return fmt.Errorf("put account trx: write to db: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (db *DB) getRefs(blk *pbcodec.Block) (implicitTrxRefs, trxRefs, tracesRefs *pbcodec.TransactionRefs) {
 	implicitTrxRefs = &pbcodec.TransactionRefs{}
 	for _, trxOp := range blk.ImplicitTransactionOps {
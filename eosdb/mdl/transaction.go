@@ -22,3 +22,16 @@ type TransactionList struct {
 	NextCursor   string
 	Transactions [][]*pbcodec.TransactionEvent
 }
+
+// AccountTransactionList is a page of the transactions that touched an account, most
+// recent first. `NextCursor` is empty when there is nothing left to list.
+type AccountTransactionList struct {
+	NextCursor   string
+	Transactions []*AccountTransactionRef
+}
+
+type AccountTransactionRef struct {
+	TransactionID string
+	BlockID       string
+	BlockNum      uint32
+}
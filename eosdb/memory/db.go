@@ -0,0 +1,75 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"sync"
+
+	"github.com/dfuse-io/dfuse-eosio/eosdb"
+	"go.uber.org/zap"
+)
+
+// DB is an `eosdb.Driver` keeping everything in memory, nothing is persisted and all data
+// is lost when the process exits. It's meant to be used in unit tests and for short lived
+// local chains. Drivers created with the same DSN share the same data, so all the apps of a
+// process configured with the same `memory://` DSN read what the loader writes.
+type DB struct {
+	lock sync.RWMutex
+
+	// Required only when writing
+	writerChainID []byte
+
+	blocks       *table // keyed by reversed block ID, most recent block first
+	irrBlocks    *table // keyed by reversed block ID, without rows
+	trxs         *table // keyed by `<trxID>:<blockID>`, like the other transaction tables
+	trxTraces    *table
+	implicitTrxs *table
+	dtrxs        *table
+	accounts     *table // keyed by account name
+	accountTrxs  *table // keyed by `<account>:<reversed block ID>:<trxID>`, without rows
+
+	// Irreversible blocks only, sorted by block time then block ID
+	timeline []*timelineEntry
+}
+
+var dbCachePool = make(map[string]eosdb.Driver)
+var dbCachePoolLock sync.Mutex
+
+func init() {
+	eosdb.Register("memory", New)
+}
+
+func New(dsnString string, opts ...eosdb.Option) (eosdb.Driver, error) {
+	dbCachePoolLock.Lock()
+	defer dbCachePoolLock.Unlock()
+
+	db := dbCachePool[dsnString]
+	if db == nil {
+		zlog.Info("creating in-memory eosdb", zap.String("dsn", dsnString))
+		db = eosdb.Driver(&DB{
+			blocks:       newTable(),
+			irrBlocks:    newTable(),
+			trxs:         newTable(),
+			trxTraces:    newTable(),
+			implicitTrxs: newTable(),
+			dtrxs:        newTable(),
+			accounts:     newTable(),
+			accountTrxs:  newTable(),
+		})
+		dbCachePool[dsnString] = db
+	}
+
+	return db, nil
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"os"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/eosdb"
+	"github.com/dfuse-io/dfuse-eosio/eosdb/eosdbtest"
+	"github.com/dfuse-io/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func init() {
+	if os.Getenv("TEST_LOG") != "" {
+		zlog = logging.MustCreateLoggerWithLevel("test", zap.NewAtomicLevelAt(zap.DebugLevel))
+		logging.Set(zlog)
+	}
+}
+
+func TestAll(t *testing.T) {
+	eosdbtest.TestAll(t, "memory", newTestDBFactory(t))
+}
+
+func TestNew_SameDSNSharesDatabase(t *testing.T) {
+	defer func() { dbCachePool = make(map[string]eosdb.Driver) }()
+
+	first, err := eosdb.New("memory://shared")
+	require.NoError(t, err)
+
+	second, err := eosdb.New("memory://shared")
+	require.NoError(t, err)
+
+	other, err := eosdb.New("memory://other")
+	require.NoError(t, err)
+
+	assert.True(t, first == second, "same DSN should return the same database")
+	assert.False(t, first == other, "different DSN should return another database")
+}
+
+func newTestDBFactory(t *testing.T) eosdbtest.DriverFactory {
+	return func() (eosdb.Driver, eosdbtest.DriverCleanupFunc) {
+		db, err := New("memory://test")
+		require.NoError(t, err)
+
+		return db, func() {
+			dbCachePool = make(map[string]eosdb.Driver)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/dfuse-io/dfuse-eosio/eosdb"
+	pbeosdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/eosdb/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+func (db *DB) PruneForkedBlocks(ctx context.Context, lowBlockNum, highBlockNum uint32) (prunedCount int, err error) {
+	zlog.Debug("prune forked blocks", zap.Uint32("low_block_num", lowBlockNum), zap.Uint32("high_block_num", highBlockNum))
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	end := ""
+	if lowBlockNum > 0 {
+		end = blockNumPrefix(lowBlockNum - 1)
+	}
+
+	irrBlockIDs := map[uint32]string{}
+	db.irrBlocks.scan(blockNumPrefix(highBlockNum), end, func(key string, _ proto.Message) bool {
+		blockID := blockIDFromKey(key)
+		irrBlockIDs[eos.BlockNum(blockID)] = blockID
+		return true
+	})
+
+	var forkedBlockRows []*pbeosdb.BlockRow
+	db.blocks.scan(blockNumPrefix(highBlockNum), end, func(key string, row proto.Message) bool {
+		blockID := blockIDFromKey(key)
+		irrBlockID, found := irrBlockIDs[eos.BlockNum(blockID)]
+		if found && irrBlockID != blockID {
+			forkedBlockRows = append(forkedBlockRows, row.(*pbeosdb.BlockRow))
+		}
+
+		return true
+	})
+
+	for _, blockRow := range forkedBlockRows {
+		zlog.Debug("deleting forked block", zap.String("block_id", blockRow.Block.Id))
+		db.deleteForkedBlock(blockRow)
+		prunedCount++
+	}
+
+	return prunedCount, nil
+}
+
+// deleteForkedBlock deletes everything written by `PutBlock` for the block
+func (db *DB) deleteForkedBlock(blockRow *pbeosdb.BlockRow) {
+	blockID := blockRow.Block.Id
+
+	for _, hash := range blockRow.TrxRefs.GetHashes() {
+		db.trxs.delete(trxKey(hex.EncodeToString(hash), blockID))
+	}
+
+	for _, hash := range blockRow.ImplicitTrxRefs.GetHashes() {
+		db.implicitTrxs.delete(trxKey(hex.EncodeToString(hash), blockID))
+	}
+
+	for _, hash := range blockRow.TraceRefs.GetHashes() {
+		trxID := hex.EncodeToString(hash)
+		row, found := db.trxTraces.get(trxKey(trxID, blockID))
+		if !found {
+			continue
+		}
+
+		trxTrace := row.(*pbeosdb.TrxTraceRow).TrxTrace
+		for _, dtrxOp := range trxTrace.DtrxOps {
+			db.dtrxs.delete(trxKey(dtrxOp.TransactionId, blockID))
+		}
+
+		for _, account := range eosdb.AccountsTouchedByTransaction(trxTrace) {
+			db.accountTrxs.delete(accountTrxKey(account, blockID, trxID))
+		}
+
+		db.trxTraces.delete(trxKey(trxID, blockID))
+	}
+
+	db.blocks.delete(blockKey(blockID))
+}
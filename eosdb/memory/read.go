@@ -0,0 +1,257 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dfuse-io/bstream"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbeosdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/eosdb/v1"
+	"github.com/dfuse-io/kvdb"
+	"github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+func (db *DB) GetLastWrittenBlockID(ctx context.Context) (blockID string, err error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	db.blocks.scan("", "", func(key string, _ proto.Message) bool {
+		blockID = blockIDFromKey(key)
+		return false
+	})
+
+	if blockID == "" {
+		return "", kvdb.ErrNotFound
+	}
+
+	return blockID, nil
+}
+
+func (db *DB) GetBlock(ctx context.Context, id string) (*pbcodec.BlockWithRefs, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	row, found := db.blocks.get(blockKey(id))
+	if !found {
+		return nil, kvdb.ErrNotFound
+	}
+
+	return db.blockRowToBlockWithRef(row.(*pbeosdb.BlockRow)), nil
+}
+
+func (db *DB) GetBlockByNum(ctx context.Context, num uint32) (out []*pbcodec.BlockWithRefs, err error) {
+	zlog.Debug("get block by num", zap.Uint32("block_num", num))
+
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	db.blocks.prefix(blockNumPrefix(num), func(_ string, row proto.Message) bool {
+		out = append(out, db.blockRowToBlockWithRef(row.(*pbeosdb.BlockRow)))
+		return true
+	})
+
+	if len(out) == 0 {
+		return nil, kvdb.ErrNotFound
+	}
+
+	return
+}
+
+func (db *DB) blockRowToBlockWithRef(blockRow *pbeosdb.BlockRow) *pbcodec.BlockWithRefs {
+	return &pbcodec.BlockWithRefs{
+		Id:                      blockRow.Block.Id,
+		Block:                   blockRow.Block,
+		ImplicitTransactionRefs: blockRow.ImplicitTrxRefs,
+		TransactionRefs:         blockRow.TrxRefs,
+		TransactionTraceRefs:    blockRow.TraceRefs,
+		Irreversible:            db.irrBlocks.has(blockKey(blockRow.Block.Id)),
+	}
+}
+
+func (db *DB) GetClosestIrreversibleIDAtBlockNum(ctx context.Context, num uint32) (ref bstream.BlockRef, err error) {
+	zlog.Debug("get closest irr id at block num", zap.Uint32("block_num", num))
+
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	db.irrBlocks.scan(blockNumPrefix(num), "", func(key string, _ proto.Message) bool {
+		ref = bstream.NewBlockRefFromID(bstream.BlockRefFromID(blockIDFromKey(key)))
+		return false
+	})
+
+	if ref == nil {
+		return nil, kvdb.ErrNotFound
+	}
+
+	return ref, nil
+}
+
+func (db *DB) GetIrreversibleIDAtBlockID(ctx context.Context, ID string) (ref bstream.BlockRef, err error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	row, found := db.blocks.get(blockKey(ID))
+	if !found {
+		return nil, fmt.Errorf("get irreversible id at block id: get block: %w", kvdb.ErrNotFound)
+	}
+
+	dposIrrNum := row.(*pbeosdb.BlockRow).Block.DposIrreversibleBlocknum
+
+	zlog.Debug("get irr block by num", zap.Uint32("block_num", dposIrrNum))
+	db.irrBlocks.prefix(blockNumPrefix(dposIrrNum), func(key string, _ proto.Message) bool {
+		ref = bstream.NewBlockRefFromID(bstream.BlockRefFromID(blockIDFromKey(key)))
+		return false
+	})
+
+	if ref == nil {
+		return nil, kvdb.ErrNotFound
+	}
+
+	return ref, nil
+}
+
+func (db *DB) BlockIDAt(ctx context.Context, start time.Time) (id string, err error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	i := db.timelineIndex(start)
+	if i < len(db.timeline) && db.timeline[i].blockTime.Equal(start) {
+		return db.timeline[i].blockID, nil
+	}
+
+	return "", kvdb.ErrNotFound
+}
+
+func (db *DB) BlockIDAfter(ctx context.Context, start time.Time, inclusive bool) (id string, foundTime time.Time, err error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	for i := db.timelineIndex(start); i < len(db.timeline); i++ {
+		entry := db.timeline[i]
+		if !inclusive && entry.blockTime.Equal(start) {
+			continue
+		}
+
+		return entry.blockID, entry.blockTime, nil
+	}
+
+	return "", time.Time{}, kvdb.ErrNotFound
+}
+
+func (db *DB) BlockIDBefore(ctx context.Context, start time.Time, inclusive bool) (id string, foundTime time.Time, err error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	// Entries at `start` are all before the index of the first entry after it
+	i := sort.Search(len(db.timeline), func(i int) bool {
+		return db.timeline[i].blockTime.After(start)
+	})
+
+	for i--; i >= 0; i-- {
+		entry := db.timeline[i]
+		if !inclusive && entry.blockTime.Equal(start) {
+			continue
+		}
+
+		return entry.blockID, entry.blockTime, nil
+	}
+
+	return "", time.Time{}, kvdb.ErrNotFound
+}
+
+// timelineIndex returns the index of the first timeline entry at or after `start`
+func (db *DB) timelineIndex(start time.Time) int {
+	return sort.Search(len(db.timeline), func(i int) bool {
+		return !db.timeline[i].blockTime.Before(start)
+	})
+}
+
+func (db *DB) ListBlocks(ctx context.Context, highBlockNum uint32, limit int) (out []*pbcodec.BlockWithRefs, err error) {
+	zlog.Debug("list blocks", zap.Uint32("high_block_num", highBlockNum), zap.Int("limit", limit))
+
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	db.blocks.scan(blockNumPrefix(highBlockNum), "", func(_ string, row proto.Message) bool {
+		out = append(out, db.blockRowToBlockWithRef(row.(*pbeosdb.BlockRow)))
+		return limit <= 0 || len(out) < limit
+	})
+
+	return
+}
+
+func (db *DB) ListSiblingBlocks(ctx context.Context, blockNum uint32, spread uint32) (out []*pbcodec.BlockWithRefs, err error) {
+	highBlockNum := blockNum + spread
+
+	// Lists down to the first block when there are less than `spread` blocks below `blockNum`
+	end := ""
+	if blockNum > spread {
+		end = blockNumPrefix(blockNum - (spread + 1))
+	}
+
+	zlog.Debug("list sibling blocks", zap.Uint32("high_block_num", highBlockNum), zap.String("end", end))
+
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	db.blocks.scan(blockNumPrefix(highBlockNum), end, func(_ string, row proto.Message) bool {
+		out = append(out, db.blockRowToBlockWithRef(row.(*pbeosdb.BlockRow)))
+		return true
+	})
+
+	return
+}
+
+func (db *DB) GetAccount(ctx context.Context, accountName string) (*pbcodec.AccountCreationRef, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	row, found := db.accounts.get(accountName)
+	if !found {
+		return nil, kvdb.ErrNotFound
+	}
+
+	acctRow := row.(*pbeosdb.AccountRow)
+	return &pbcodec.AccountCreationRef{
+		Account:       acctRow.Name,
+		Creator:       acctRow.Creator,
+		BlockNum:      uint64(eos.BlockNum(acctRow.BlockId)),
+		BlockId:       acctRow.BlockId,
+		BlockTime:     acctRow.BlockTime,
+		TransactionId: acctRow.TrxId,
+	}, nil
+}
+
+func (db *DB) ListAccountNames(ctx context.Context, concurrentReadCount uint32) (out []string, err error) {
+	if concurrentReadCount == 0 {
+		return nil, fmt.Errorf("invalid concurrent read")
+	}
+
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	db.accounts.scan("", "", func(name string, _ proto.Message) bool {
+		out = append(out, name)
+		return true
+	})
+
+	return
+}
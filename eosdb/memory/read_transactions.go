@@ -0,0 +1,220 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dfuse-io/dfuse-eosio/eosdb"
+	"github.com/dfuse-io/dfuse-eosio/eosdb/mdl"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbeosdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/eosdb/v1"
+	"github.com/dfuse-io/kvdb"
+	"github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/proto"
+)
+
+func (db *DB) GetTransactionTraces(ctx context.Context, idPrefix string) (out []*pbcodec.TransactionEvent, err error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	return db.getTransactionExecutionEvents(idPrefix)
+}
+
+func (db *DB) GetTransactionEventsBatch(ctx context.Context, idPrefixes []string) (out [][]*pbcodec.TransactionEvent, err error) {
+	for _, idPrefix := range idPrefixes {
+		trxResult, err := db.GetTransactionEvents(ctx, idPrefix)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, trxResult)
+	}
+	return
+}
+
+func (db *DB) GetTransactionTracesBatch(ctx context.Context, idPrefixes []string) (out [][]*pbcodec.TransactionEvent, err error) {
+	for _, idPrefix := range idPrefixes {
+		trxResult, err := db.GetTransactionTraces(ctx, idPrefix)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, trxResult)
+	}
+	return
+}
+
+func (db *DB) GetTransactionEvents(ctx context.Context, idPrefix string) (out []*pbcodec.TransactionEvent, err error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	out = append(out, db.getTransactionAdditionEvents(idPrefix)...)
+
+	evs, err := db.getTransactionExecutionEvents(idPrefix)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, evs...)
+
+	out = append(out, db.getTransactionDtrxEvents(idPrefix)...)
+	out = append(out, db.getTransactionImplicitEvents(idPrefix)...)
+
+	return
+}
+
+func (db *DB) newTransactionEvent(key string) *pbcodec.TransactionEvent {
+	trxID, blockID := splitTrxKey(key)
+
+	return &pbcodec.TransactionEvent{
+		Id:           trxID,
+		BlockId:      blockID,
+		BlockNum:     eos.BlockNum(blockID),
+		Irreversible: db.irrBlocks.has(blockKey(blockID)),
+	}
+}
+
+func (db *DB) getTransactionAdditionEvents(idPrefix string) (out []*pbcodec.TransactionEvent) {
+	db.trxs.prefix(idPrefix, func(key string, value proto.Message) bool {
+		row := value.(*pbeosdb.TrxRow)
+
+		ev := db.newTransactionEvent(key)
+		if row.Receipt != nil {
+			ev.Event = &pbcodec.TransactionEvent_Addition{
+				Addition: &pbcodec.TransactionEvent_Added{
+					Receipt:     row.Receipt,
+					Transaction: row.SignedTrx,
+					PublicKeys:  row.PublicKeys,
+				},
+			}
+		} else {
+			ev.Event = &pbcodec.TransactionEvent_InternalAddition{
+				InternalAddition: &pbcodec.TransactionEvent_AddedInternally{
+					Transaction: row.SignedTrx,
+				},
+			}
+		}
+
+		out = append(out, ev)
+		return true
+	})
+
+	return
+}
+
+func (db *DB) getTransactionImplicitEvents(idPrefix string) (out []*pbcodec.TransactionEvent) {
+	db.implicitTrxs.prefix(idPrefix, func(key string, value proto.Message) bool {
+		row := value.(*pbeosdb.ImplicitTrxRow)
+
+		ev := db.newTransactionEvent(key)
+		ev.Event = &pbcodec.TransactionEvent_InternalAddition{
+			InternalAddition: &pbcodec.TransactionEvent_AddedInternally{
+				Transaction: row.SignedTrx,
+			},
+		}
+
+		out = append(out, ev)
+		return true
+	})
+
+	return
+}
+
+func (db *DB) getTransactionExecutionEvents(idPrefix string) (out []*pbcodec.TransactionEvent, err error) {
+	db.trxTraces.prefix(idPrefix, func(key string, value proto.Message) bool {
+		row := value.(*pbeosdb.TrxTraceRow)
+
+		ev := db.newTransactionEvent(key)
+		ev.Event = &pbcodec.TransactionEvent_Execution{
+			Execution: &pbcodec.TransactionEvent_Executed{
+				Trace:       row.TrxTrace,
+				BlockHeader: row.BlockHeader,
+			},
+		}
+
+		out = append(out, ev)
+		return true
+	})
+
+	if len(out) == 0 {
+		return nil, kvdb.ErrNotFound
+	}
+
+	return
+}
+
+func (db *DB) getTransactionDtrxEvents(idPrefix string) (out []*pbcodec.TransactionEvent) {
+	db.dtrxs.prefix(idPrefix, func(key string, value proto.Message) bool {
+		row := value.(*pbeosdb.DtrxRow)
+
+		ev := db.newTransactionEvent(key)
+		if row.CreatedBy != nil {
+			ev.Event = &pbcodec.TransactionEvent_DtrxScheduling{
+				DtrxScheduling: &pbcodec.TransactionEvent_DtrxScheduled{
+					CreatedBy:   row.CreatedBy,
+					Transaction: row.SignedTrx,
+				},
+			}
+		} else {
+			ev.Event = &pbcodec.TransactionEvent_DtrxCancellation{
+				DtrxCancellation: &pbcodec.TransactionEvent_DtrxCanceled{
+					CanceledBy: row.CanceledBy,
+				},
+			}
+		}
+
+		out = append(out, ev)
+		return true
+	})
+
+	return
+}
+
+func (db *DB) ListTransactionsForAccount(ctx context.Context, account string, cursor string, limit int) (*mdl.AccountTransactionList, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit %d", limit)
+	}
+
+	if _, err := eos.StringToName(account); err != nil {
+		return nil, fmt.Errorf("invalid account name %q: %w", account, err)
+	}
+
+	start := accountTrxsPrefix(account)
+	if cursor != "" {
+		blockID, trxID, err := eosdb.DecodeAccountTransactionCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		// The zero byte makes the start exclusive, it's the first key after the cursor's one
+		start = accountTrxKey(account, blockID, trxID) + "\x00"
+	}
+
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	out := &mdl.AccountTransactionList{}
+	db.accountTrxs.scan(start, endOfAccountTrxs(account), func(key string, _ proto.Message) bool {
+		if len(out.Transactions) == limit {
+			out.NextCursor = eosdb.AccountTransactionCursor(out.Transactions[limit-1])
+			return false
+		}
+
+		_, blockID, trxID := splitAccountTrxKey(key)
+		out.Transactions = append(out.Transactions, eosdb.NewAccountTransactionRef(blockID, trxID))
+		return true
+	})
+
+	return out, nil
+}
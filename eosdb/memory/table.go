@@ -0,0 +1,149 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dfuse-io/kvdb"
+	"github.com/golang/protobuf/proto"
+)
+
+// table holds rows sorted by key, the way the `kv` driver lays them out in its store, so
+// they can be read by key range or key prefix. Rows are cloned when put and when read,
+// callers never share a row with the table.
+type table struct {
+	keys []string
+	rows map[string]proto.Message
+}
+
+func newTable() *table {
+	return &table{rows: make(map[string]proto.Message)}
+}
+
+func (t *table) put(key string, row proto.Message) {
+	if _, found := t.rows[key]; !found {
+		i := sort.SearchStrings(t.keys, key)
+		t.keys = append(t.keys, "")
+		copy(t.keys[i+1:], t.keys[i:])
+		t.keys[i] = key
+	}
+
+	t.rows[key] = cloneRow(row)
+}
+
+func (t *table) get(key string) (row proto.Message, found bool) {
+	row, found = t.rows[key]
+	return cloneRow(row), found
+}
+
+func (t *table) has(key string) bool {
+	_, found := t.rows[key]
+	return found
+}
+
+func (t *table) delete(key string) {
+	if _, found := t.rows[key]; !found {
+		return
+	}
+
+	delete(t.rows, key)
+	i := sort.SearchStrings(t.keys, key)
+	t.keys = append(t.keys[:i], t.keys[i+1:]...)
+}
+
+// scan calls `onRow` in key order for the keys in [start, end[, until it returns false. An
+// empty `end` scans up to the last key. The table must not be modified by `onRow`.
+func (t *table) scan(start, end string, onRow func(key string, row proto.Message) bool) {
+	for i := sort.SearchStrings(t.keys, start); i < len(t.keys); i++ {
+		key := t.keys[i]
+		if end != "" && key >= end {
+			return
+		}
+
+		if !onRow(key, cloneRow(t.rows[key])) {
+			return
+		}
+	}
+}
+
+// prefix calls `onRow` in key order for the keys starting with `prefix`, until it returns false
+func (t *table) prefix(prefix string, onRow func(key string, row proto.Message) bool) {
+	for i := sort.SearchStrings(t.keys, prefix); i < len(t.keys) && strings.HasPrefix(t.keys[i], prefix); i++ {
+		if !onRow(t.keys[i], cloneRow(t.rows[t.keys[i]])) {
+			return
+		}
+	}
+}
+
+func cloneRow(row proto.Message) proto.Message {
+	if row == nil {
+		return nil
+	}
+
+	return proto.Clone(row)
+}
+
+type timelineEntry struct {
+	blockTime time.Time
+	blockID   string
+}
+
+func (e *timelineEntry) before(blockTime time.Time, blockID string) bool {
+	return e.blockTime.Before(blockTime) || (e.blockTime.Equal(blockTime) && e.blockID < blockID)
+}
+
+// Keys, the blocks being keyed by their reversed ID to have the most recent ones first
+
+func blockKey(blockID string) string {
+	return kvdb.ReversedBlockID(blockID)
+}
+
+func blockIDFromKey(key string) string {
+	return kvdb.ReversedBlockID(key)
+}
+
+func blockNumPrefix(blockNum uint32) string {
+	return kvdb.HexRevBlockNum(blockNum)
+}
+
+func trxKey(trxID, blockID string) string {
+	return trxID + ":" + blockID
+}
+
+func splitTrxKey(key string) (trxID, blockID string) {
+	chunks := strings.SplitN(key, ":", 2)
+	return chunks[0], chunks[1]
+}
+
+func accountTrxsPrefix(account string) string {
+	return account + ":"
+}
+
+// endOfAccountTrxs is the first key after all the account's keys, `;` following `:`
+func endOfAccountTrxs(account string) string {
+	return account + ";"
+}
+
+func accountTrxKey(account, blockID, trxID string) string {
+	return accountTrxsPrefix(account) + kvdb.ReversedBlockID(blockID) + ":" + trxID
+}
+
+func splitAccountTrxKey(key string) (account, blockID, trxID string) {
+	chunks := strings.SplitN(key, ":", 3)
+	return chunks[0], kvdb.ReversedBlockID(chunks[1]), chunks[2]
+}
@@ -0,0 +1,219 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/dfuse-eosio/codec"
+	"github.com/dfuse-io/dfuse-eosio/eosdb"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbeosdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/eosdb/v1"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+)
+
+// Flush does nothing, writes are readable as soon as they are done
+func (db *DB) Flush(ctx context.Context) error {
+	return nil
+}
+
+func (db *DB) SetWriterChainID(chainID []byte) {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	db.writerChainID = chainID
+}
+
+func (db *DB) GetLastWrittenIrreversibleBlockRef(ctx context.Context) (ref bstream.BlockRef, err error) {
+	return db.GetClosestIrreversibleIDAtBlockNum(ctx, math.MaxUint32)
+}
+
+func (db *DB) PutBlock(ctx context.Context, blk *pbcodec.Block) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if err := db.putTransactions(blk); err != nil {
+		return fmt.Errorf("put block: unable to putTransactions: %w", err)
+	}
+
+	db.putTransactionTraces(blk)
+	db.putImplicitTransactions(blk)
+	db.putAccountTransactions(blk)
+	db.putBlock(blk)
+
+	return nil
+}
+
+func (db *DB) putTransactions(blk *pbcodec.Block) error {
+	for _, trxReceipt := range blk.Transactions {
+		if trxReceipt.PackedTransaction == nil {
+			// This means we deal with a deferred transaction receipt, and that it has been handled through DtrxOps already
+			continue
+		}
+
+		signedTransaction, err := codec.ExtractEOSSignedTransactionFromReceipt(trxReceipt)
+		if err != nil {
+			return fmt.Errorf("unable to extract EOS signed transaction from transaction receipt: %s", err)
+		}
+
+		db.trxs.put(trxKey(trxReceipt.Id, blk.Id), &pbeosdb.TrxRow{
+			Receipt:   trxReceipt,
+			SignedTrx: codec.SignedTransactionToDEOS(signedTransaction),
+			PublicKeys: &pbcodec.PublicKeys{
+				PublicKeys: codec.GetPublicKeysFromSignedTransaction(db.writerChainID, signedTransaction),
+			},
+		})
+	}
+
+	return nil
+}
+
+func (db *DB) putTransactionTraces(blk *pbcodec.Block) {
+	for _, trxTrace := range blk.TransactionTraces {
+		for _, dtrxOp := range trxTrace.DtrxOps {
+			extDtrxOp := dtrxOp.ToExtDTrxOp(blk, trxTrace)
+
+			dtrxRow := &pbeosdb.DtrxRow{}
+			if dtrxOp.IsCreateOperation() {
+				dtrxRow.SignedTrx = dtrxOp.Transaction
+				dtrxRow.CreatedBy = extDtrxOp
+			} else if dtrxOp.IsCancelOperation() {
+				dtrxRow.CanceledBy = extDtrxOp
+			}
+
+			db.dtrxs.put(trxKey(dtrxOp.TransactionId, blk.Id), dtrxRow)
+		}
+
+		db.trxTraces.put(trxKey(trxTrace.Id, blk.Id), &pbeosdb.TrxTraceRow{
+			BlockHeader: blk.Header,
+			TrxTrace:    trxTrace,
+		})
+	}
+}
+
+func (db *DB) putImplicitTransactions(blk *pbcodec.Block) {
+	for _, trxOp := range blk.ImplicitTransactionOps {
+		db.implicitTrxs.put(trxKey(trxOp.TransactionId, blk.Id), &pbeosdb.ImplicitTrxRow{
+			Name:      trxOp.Name,
+			SignedTrx: trxOp.Transaction,
+		})
+	}
+}
+
+func (db *DB) putAccountTransactions(blk *pbcodec.Block) {
+	for _, trxTrace := range blk.TransactionTraces {
+		for _, account := range eosdb.AccountsTouchedByTransaction(trxTrace) {
+			db.accountTrxs.put(accountTrxKey(account, blk.Id, trxTrace.Id), nil)
+		}
+	}
+}
+
+func (db *DB) putBlock(blk *pbcodec.Block) {
+	blockRow := &pbeosdb.BlockRow{
+		ImplicitTrxRefs: &pbcodec.TransactionRefs{},
+		TrxRefs:         &pbcodec.TransactionRefs{},
+		TraceRefs:       &pbcodec.TransactionRefs{},
+	}
+
+	for _, trxOp := range blk.ImplicitTransactionOps {
+		blockRow.ImplicitTrxRefs.Hashes = append(blockRow.ImplicitTrxRefs.Hashes, eosdb.MustHexDecode(trxOp.TransactionId))
+	}
+
+	for _, trx := range blk.Transactions {
+		blockRow.TrxRefs.Hashes = append(blockRow.TrxRefs.Hashes, eosdb.MustHexDecode(trx.Id))
+	}
+
+	for _, trx := range blk.TransactionTraces {
+		blockRow.TraceRefs.Hashes = append(blockRow.TraceRefs.Hashes, eosdb.MustHexDecode(trx.Id))
+	}
+
+	holdTransactions := blk.Transactions
+	holdTransactionTraces := blk.TransactionTraces
+	holdImplicitTransactionOps := blk.ImplicitTransactionOps
+
+	blk.ImplicitTransactionOps = nil
+	blk.Transactions = nil
+	blk.TransactionTraces = nil
+
+	// The row is cloned by the table, the transactions are not part of it
+	blockRow.Block = blk
+	zlog.Debug("put block", zap.String("block_id", blk.Id))
+	db.blocks.put(blockKey(blk.Id), blockRow)
+
+	blk.ImplicitTransactionOps = holdImplicitTransactionOps
+	blk.Transactions = holdTransactions
+	blk.TransactionTraces = holdTransactionTraces
+}
+
+func (db *DB) UpdateNowIrreversibleBlock(ctx context.Context, blk *pbcodec.Block) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	db.putTimelineEntry(blk.MustTime(), blk.Id)
+
+	// Specialized indexing for `newaccount` on the chain.
+	for _, trxTrace := range blk.TransactionTraces {
+		for _, act := range trxTrace.ActionTraces {
+			if act.Account() == "eosio" && act.Receiver == "eosio" && act.Name() == "newaccount" {
+				if err := db.putNewAccount(blk, trxTrace, act); err != nil {
+					return fmt.Errorf("failed to put new account: %w", err)
+				}
+			}
+		}
+	}
+
+	zlog.Debug("adding irreversible block", zap.String("block_id", blk.Id))
+	db.irrBlocks.put(blockKey(blk.Id), nil)
+
+	return nil
+}
+
+func (db *DB) putNewAccount(blk *pbcodec.Block, trace *pbcodec.TransactionTrace, act *pbcodec.ActionTrace) error {
+	t, err := ptypes.TimestampProto(blk.MustTime())
+	if err != nil {
+		return fmt.Errorf("block time to proto: %w", err)
+	}
+
+	acctRow := &pbeosdb.AccountRow{
+		Name:      act.GetData("name").String(),
+		Creator:   act.GetData("creator").String(),
+		BlockTime: t,
+		BlockId:   blk.Id,
+		TrxId:     trace.Id,
+	}
+
+	db.accounts.put(acctRow.Name, acctRow)
+	return nil
+}
+
+func (db *DB) putTimelineEntry(blockTime time.Time, blockID string) {
+	i := sort.Search(len(db.timeline), func(i int) bool {
+		return !db.timeline[i].before(blockTime, blockID)
+	})
+
+	if i < len(db.timeline) && db.timeline[i].blockID == blockID && db.timeline[i].blockTime.Equal(blockTime) {
+		return
+	}
+
+	db.timeline = append(db.timeline, nil)
+	copy(db.timeline[i+1:], db.timeline[i:])
+	db.timeline[i] = &timelineEntry{blockTime: blockTime, blockID: blockID}
+}
@@ -61,9 +61,9 @@ func (db *DB) scanAccountRows(rows *sql.Rows) (out []*pbcodec.AccountCreationRef
 	return
 }
 
-func (db *DB) putNewAccount(blk *pbcodec.Block, trace *pbcodec.TransactionTrace, act *pbcodec.ActionTrace) error {
+func (db *DB) putNewAccount(ctx context.Context, tx *sql.Tx, blk *pbcodec.Block, trace *pbcodec.TransactionTrace, act *pbcodec.ActionTrace) error {
 	// TODO: do we ALWAYS have the decoded data for `newaccount`, even at the beginning of the chain? Do we have an ABI set during the boot sequence?
-	_, err := db.db.Exec(INSERT_IGNORE+"INTO accts (account, blockId, blockNum, blockTime, creator, trxId) VALUES (?, ?, ?, ?, ?, ?)",
+	err := db.insertIgnore(ctx, tx, "INSERT INTO accts (account, blockId, blockNum, blockTime, creator, trxId) VALUES (?, ?, ?, ?, ?, ?)",
 		act.GetData("name").String(),
 		blk.ID(),
 		blk.Num(),
@@ -79,7 +79,7 @@ func (db *DB) putNewAccount(blk *pbcodec.Block, trace *pbcodec.TransactionTrace,
 
 func (db *DB) GetAccount(ctx context.Context, accountName string) (*pbcodec.AccountCreationRef, error) {
 	q := accountSelectFields + `WHERE accts.account = ?`
-	rows, err := db.db.QueryContext(ctx, q, accountName)
+	rows, err := db.query(ctx, q, accountName)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +99,7 @@ func (db *DB) ListAccountNames(ctx context.Context, concurrentReadCount uint32)
 		return nil, fmt.Errorf("invalid concurrent read")
 	}
 	q := accountSelectFields
-	rows, err := db.db.QueryContext(ctx, q)
+	rows, err := db.query(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -102,3 +102,164 @@ var createAccountsTableStmt = `CREATE TABLE IF NOT EXISTS accts (
 
     PRIMARY KEY (account, blockId)
 );`
+
+// Index of the transactions that touched each account, the primary key ordering them
+// the way `ListTransactionsForAccount` lists them. The migration creates it empty, only
+// blocks put from then on are indexed, see `eosdb.AccountHistoryReader` to backfill it.
+var createAccountTrxsTableStmt = `CREATE TABLE IF NOT EXISTS accttrxs (
+    account varchar(13) NOT NULL,
+    blockNum int unsigned NOT NULL,
+    blockId varchar(64) NOT NULL,
+    trxId varchar(64) NOT NULL,
+
+    PRIMARY KEY (account, blockNum, blockId, trxId)
+);`
+
+var mysqlMigrations = []*Migration{
+	{
+		Version:     1,
+		Description: "create trxs, implicittrxs, dtrxs, trxtraces, blks, irrblks and accts tables",
+		statements: []string{
+			createTrxsTableStmt,
+			createImplicitTrxsTableStmt,
+			createDtrxsTableStmt,
+			createTracesTableStmt,
+			createBlocksTableStmt,
+			createBlocksBlksTimeIndexesStmt,
+			createBlocksBlksNumIndexesStmt,
+			createIrrBlocksTableStmt,
+			createAccountsTableStmt,
+		},
+	},
+	{
+		Version:     2,
+		Description: "create accttrxs table",
+		statements: []string{
+			createAccountTrxsTableStmt,
+		},
+	},
+}
+
+// SQLite understands the MySQL types through its type affinity rules
+var sqliteMigrations = mysqlMigrations
+
+var postgresMigrations = []*Migration{
+	{
+		Version:     1,
+		Description: "create trxs, implicittrxs, dtrxs, trxtraces, blks, irrblks and accts tables",
+		statements: []string{
+			postgresCreateTrxsTableStmt,
+			postgresCreateImplicitTrxsTableStmt,
+			postgresCreateDtrxsTableStmt,
+			postgresCreateTracesTableStmt,
+			postgresCreateBlocksTableStmt,
+			postgresCreateBlocksBlksTimeIndexesStmt,
+			postgresCreateBlocksBlksNumIndexesStmt,
+			postgresCreateIrrBlocksTableStmt,
+			postgresCreateAccountsTableStmt,
+		},
+	},
+	{
+		Version:     2,
+		Description: "create accttrxs table",
+		statements: []string{
+			postgresCreateAccountTrxsTableStmt,
+		},
+	},
+}
+
+// PostgreSQL has no `blob`, `datetime` nor unsigned integers, `bytea`, `timestamptz` and
+// `bigint` (block numbers being unsigned 32 bits integers) are used instead. Unquoted
+// identifiers are folded to lower case, queries using the same unquoted names, the
+// mixed case column names work as is.
+var postgresCreateTrxsTableStmt = `CREATE TABLE IF NOT EXISTS trxs (
+    id varchar(64) NOT NULL,
+    blockId varchar(64) NOT NULL,
+
+    signedTrx bytea NOT NULL,
+    receipt bytea,            -- Will be NULL if we're talking about an Internal Addition
+    publicKeys bytea,         -- Will be NULL if it's an internal addition
+
+    PRIMARY KEY (id, blockId)
+);`
+
+var postgresCreateImplicitTrxsTableStmt = `CREATE TABLE IF NOT EXISTS implicittrxs (
+    id varchar(64) NOT NULL,
+    blockId varchar(64) NOT NULL,
+
+    signedTrx bytea NOT NULL,
+    name varchar(12) NOT NULL,
+
+    PRIMARY KEY (id, blockId)
+);`
+
+var postgresCreateIrrBlocksTableStmt = `CREATE TABLE IF NOT EXISTS irrblks (
+    id varchar(64) NOT NULL PRIMARY KEY,
+
+    irreversible boolean NOT NULL
+);`
+
+var postgresCreateTracesTableStmt = `CREATE TABLE IF NOT EXISTS trxtraces (
+    id varchar(64) NOT NULL,
+    blockId varchar(64) NOT NULL,
+
+    trace bytea,
+
+    PRIMARY KEY (id, blockId)
+);`
+
+var postgresCreateDtrxsTableStmt = `CREATE TABLE IF NOT EXISTS dtrxs (
+    id varchar(64) NOT NULL,
+    blockId varchar(64) NOT NULL,
+
+    signedTrx bytea,
+    createdBy bytea,
+    canceledBy bytea,
+
+    PRIMARY KEY (id, blockId)
+);`
+
+var postgresCreateBlocksTableStmt = `CREATE TABLE IF NOT EXISTS blks (
+    id varchar(64) NOT NULL PRIMARY KEY,
+    number bigint NOT NULL,
+    previousId varchar(64),
+    irrBlockNum bigint NOT NULL,
+
+    blockProducer varchar(13) NOT NULL,
+    blockTime timestamp(6) with time zone NOT NULL,
+
+    block bytea,
+    blockHeader bytea,
+
+    implicitTrxRefs bytea,
+    trxRefs bytea,
+    traceRefs bytea
+);`
+
+var postgresCreateBlocksBlksTimeIndexesStmt = `
+	CREATE INDEX blks_time ON blks (blockTime);
+`
+var postgresCreateBlocksBlksNumIndexesStmt = `
+	CREATE INDEX blks_num ON blks (number);
+`
+
+var postgresCreateAccountsTableStmt = `CREATE TABLE IF NOT EXISTS accts (
+    account varchar(13) NOT NULL,
+    blockId varchar(64) NOT NULL,
+    blockNum bigint NOT NULL,
+    blockTime timestamp(6) with time zone NOT NULL,
+    trxId varchar(64) NOT NULL,
+
+    creator varchar(13) NOT NULL,
+
+    PRIMARY KEY (account, blockId)
+);`
+
+var postgresCreateAccountTrxsTableStmt = `CREATE TABLE IF NOT EXISTS accttrxs (
+    account varchar(13) NOT NULL,
+    blockNum bigint NOT NULL,
+    blockId varchar(64) NOT NULL,
+    trxId varchar(64) NOT NULL,
+
+    PRIMARY KEY (account, blockNum, blockId, trxId)
+);`
@@ -19,19 +19,15 @@ import (
 	"database/sql"
 	"fmt"
 	"net/url"
-	"strings"
 	"time"
 
 	"github.com/dfuse-io/dfuse-eosio/eosdb"
-	_ "github.com/go-sql-driver/mysql" // FIXME: Have those be registered by the CALLER
-	_ "github.com/mattn/go-sqlite3"    // FIXME: Have this be registered by the CALLER
+	"go.uber.org/zap"
 )
 
-var INSERT_IGNORE = ""
-var BEGIN_TRANSACTION = ""
-
 type DB struct {
-	db *sql.DB
+	db      *sql.DB
+	dialect *dialect
 
 	enc *eosdb.ProtoEncoder
 	dec *eosdb.ProtoDecoder
@@ -41,9 +37,9 @@ type DB struct {
 }
 
 func init() {
-	eosdb.Register("mysql", New)
-	eosdb.Register("sqlite3", New)
-	eosdb.Register("sqlite", New)
+	for scheme := range dialects {
+		eosdb.Register(scheme, New)
+	}
 }
 
 func New(dsnString string, opts ...eosdb.Option) (eosdb.Driver, error) {
@@ -52,104 +48,94 @@ func New(dsnString string, opts ...eosdb.Option) (eosdb.Driver, error) {
 		return nil, err
 	}
 
+	dialect, found := dialects[u.Scheme]
+	if !found {
+		return nil, fmt.Errorf("unsupported sql scheme %q", u.Scheme)
+	}
+
 	createTables := u.Query().Get("createTables") == "true"
 	vals := u.Query()
 	vals.Del("createTables")
+	u.RawQuery = vals.Encode()
 
-	//MySql specific stuff
-	var db *sql.DB
-
-	switch u.Scheme {
-	case "mysql":
-		BEGIN_TRANSACTION = "START TRANSACTION "
-		INSERT_IGNORE = "INSERT IGNORE "
-		vals.Set("parseTime", "true")
-
-		var pw string
-		if u.User != nil {
-			pass, _ := u.User.Password()
-			pw = fmt.Sprintf("%s:%s@", u.User.Username(), pass)
-		}
-		dsn := fmt.Sprintf("%s(%s)%s?%s", pw, u.Host, u.Path, vals.Encode())
-
-		db, err = sql.Open("mysql", dsn)
-
-	case "sqlite3", "sqlite":
-		BEGIN_TRANSACTION = "BEGIN TRANSACTION "
-		INSERT_IGNORE = "INSERT OR IGNORE "
-		dsn := u.Host // for sqlite://:memory:
-		if dsn == "" {
-			dsn = u.Path // for sqlite:///tmp/mama.sqlite
-		}
-		db, err = sql.Open("sqlite3", dsn)
-	}
+	db, err := sql.Open(dialect.driverName, dialect.dsn(u))
 	if err != nil {
 		return nil, err
 	}
 
-	if createTables {
-		_, err = db.ExecContext(context.Background(), createTrxsTableStmt)
-		if err != nil {
-			return nil, fmt.Errorf("create trxs: %s", err)
-		}
+	out := &DB{
+		db:      db,
+		dialect: dialect,
+		enc:     eosdb.NewProtoEncoder(),
+		dec:     eosdb.NewProtoDecoder(),
+	}
 
-		_, err = db.ExecContext(context.Background(), createImplicitTrxsTableStmt)
-		if err != nil {
-			return nil, fmt.Errorf("create implicittrxs: %s", err)
+	ctx := context.Background()
+	if createTables {
+		if err := out.Migrate(ctx, nil); err != nil {
+			return nil, fmt.Errorf("migrate schema: %w", err)
 		}
 
-		_, err = db.ExecContext(context.Background(), createDtrxsTableStmt)
-		if err != nil {
-			return nil, fmt.Errorf("create dtrxs: %s", err)
-		}
+		return out, nil
+	}
 
-		_, err = db.ExecContext(context.Background(), createTracesTableStmt)
-		if err != nil {
-			return nil, fmt.Errorf("create traces: %s", err)
-		}
+	// Without `createTables`, the schema is never upgraded implicitly, that's done with
+	// `dfuseeos tools eosdb-migrate`
+	pending, err := out.PendingMigrations(ctx)
+	if err != nil {
+		zlog.Warn("unable to check database schema version", zap.Error(err))
+	} else if len(pending) > 0 {
+		zlog.Warn("database schema is not up to date, run `dfuseeos tools eosdb-migrate` to upgrade it", zap.Int("pending_migration_count", len(pending)))
+	}
 
-		_, err := db.ExecContext(context.Background(), createBlocksTableStmt)
-		if err != nil {
-			return nil, fmt.Errorf("create blks: %s", err)
-		}
+	return out, nil
+}
 
-		_, err = db.ExecContext(context.Background(), createBlocksBlksTimeIndexesStmt)
-		if err != nil {
-			if !strings.Contains(err.Error(), "Duplicate key name") && !strings.Contains(err.Error(), "already exists") {
-				return nil, fmt.Errorf("create blks blks_time indexes: %s", err)
-			}
-		}
+// execer is implemented by both `*sql.DB` and `*sql.Tx`, so writes can happen inside a
+// transaction or not
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
 
-		_, err = db.ExecContext(context.Background(), createBlocksBlksNumIndexesStmt)
-		if err != nil {
-			if !strings.Contains(err.Error(), "Duplicate key name") && !strings.Contains(err.Error(), "already exists") {
-				return nil, fmt.Errorf("create blks blks_num indexes: %s", err)
-			}
-		}
+func (db *DB) query(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return db.db.QueryContext(ctx, db.dialect.rebind(query), args...)
+}
 
-		_, err = db.ExecContext(context.Background(), createIrrBlocksTableStmt)
-		if err != nil {
-			return nil, fmt.Errorf("create irrblks: %s", err)
-		}
+func (db *DB) queryRow(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return db.db.QueryRowContext(ctx, db.dialect.rebind(query), args...)
+}
 
-		_, err = db.ExecContext(context.Background(), createAccountsTableStmt)
-		if err != nil {
-			return nil, fmt.Errorf("create accts: %s", err)
-		}
-	}
+func (db *DB) exec(ctx context.Context, exec execer, query string, args ...interface{}) error {
+	_, err := exec.ExecContext(ctx, db.dialect.rebind(query), args...)
+	return err
+}
 
-	return &DB{
-		db:  db,
-		enc: eosdb.NewProtoEncoder(),
-		dec: eosdb.NewProtoDecoder(),
-	}, nil
+// insertIgnore runs an `INSERT INTO` statement, rows already present being left as is
+func (db *DB) insertIgnore(ctx context.Context, exec execer, query string, args ...interface{}) error {
+	_, err := exec.ExecContext(ctx, db.dialect.rebind(db.dialect.insertIgnore(query)), args...)
+	return err
 }
 
+// SqliteTime scans a time column whatever the driver returns for it: SQLite gives back
+// the string it stored while MySQL (with `parseTime`) and PostgreSQL return a `time.Time`.
 type SqliteTime time.Time
 
 func (t *SqliteTime) Scan(v interface{}) error {
-	ti := v.(string)
-	vt, err := time.Parse("2006-01-02 15:04:05-07:00", ti)
+	switch value := v.(type) {
+	case time.Time:
+		*t = SqliteTime(value.UTC())
+		return nil
+	case []byte:
+		return t.parse(string(value))
+	case string:
+		return t.parse(value)
+	default:
+		return fmt.Errorf("unsupported time value of type %T", v)
+	}
+}
+
+func (t *SqliteTime) parse(value string) error {
+	vt, err := time.Parse("2006-01-02 15:04:05-07:00", value)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,136 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql" // FIXME: Have those be registered by the CALLER
+	_ "github.com/lib/pq"              // FIXME: Have this be registered by the CALLER
+	_ "github.com/mattn/go-sqlite3"    // FIXME: Have this be registered by the CALLER
+)
+
+// dialect groups what differs from one SQL database to another, queries are written
+// once with `?` placeholders and `INSERT INTO` statements, then adapted by the dialect
+// of the database in use.
+type dialect struct {
+	// driverName is the `database/sql` driver opening the database
+	driverName string
+
+	// dsn converts the eosdb DSN (without the eosdb specific parameters) into the
+	// data source name expected by the `database/sql` driver
+	dsn func(u *url.URL) string
+
+	// insertIgnore turns an `INSERT INTO` statement into one silently skipping rows
+	// already present
+	insertIgnore func(query string) string
+
+	// rebind turns the `?` placeholders of a query into the dialect's ones
+	rebind func(query string) string
+
+	// tableExistsQuery counts the tables named like its single argument
+	tableExistsQuery string
+
+	// migrations are the schema upgrades, ordered by version, see `Migrate`
+	migrations []*Migration
+}
+
+var dialects = map[string]*dialect{
+	"mysql":    mysqlDialect,
+	"sqlite3":  sqliteDialect,
+	"sqlite":   sqliteDialect,
+	"postgres": postgresDialect,
+}
+
+var mysqlDialect = &dialect{
+	driverName: "mysql",
+	dsn: func(u *url.URL) string {
+		vals := u.Query()
+		vals.Set("parseTime", "true")
+
+		var pw string
+		if u.User != nil {
+			pass, _ := u.User.Password()
+			pw = fmt.Sprintf("%s:%s@", u.User.Username(), pass)
+		}
+
+		return fmt.Sprintf("%s(%s)%s?%s", pw, u.Host, u.Path, vals.Encode())
+	},
+	insertIgnore: func(query string) string {
+		return strings.Replace(query, "INSERT INTO", "INSERT IGNORE INTO", 1)
+	},
+	rebind:           noRebind,
+	tableExistsQuery: "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?",
+	migrations:       mysqlMigrations,
+}
+
+var sqliteDialect = &dialect{
+	driverName: "sqlite3",
+	dsn: func(u *url.URL) string {
+		dsn := u.Host // for sqlite://:memory:
+		if dsn == "" {
+			dsn = u.Path // for sqlite:///tmp/mama.sqlite
+		}
+
+		return dsn
+	},
+	insertIgnore: func(query string) string {
+		return strings.Replace(query, "INSERT INTO", "INSERT OR IGNORE INTO", 1)
+	},
+	rebind:           noRebind,
+	tableExistsQuery: "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?",
+	migrations:       sqliteMigrations,
+}
+
+var postgresDialect = &dialect{
+	driverName: "postgres",
+	dsn: func(u *url.URL) string {
+		// The `lib/pq` driver accepts `postgres://` URLs as is
+		return u.String()
+	},
+	insertIgnore: func(query string) string {
+		return query + " ON CONFLICT DO NOTHING"
+	},
+	rebind:           dollarRebind,
+	tableExistsQuery: "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = ?",
+	migrations:       postgresMigrations,
+}
+
+func noRebind(query string) string {
+	return query
+}
+
+// dollarRebind numbers the placeholders, `?` becoming `$1`, `$2` and so on. Queries
+// never contain a literal `?`, values always being passed as arguments.
+func dollarRebind(query string) string {
+	var out strings.Builder
+	out.Grow(len(query) + 16)
+
+	position := 0
+	for _, char := range query {
+		if char != '?' {
+			out.WriteRune(char)
+			continue
+		}
+
+		position++
+		out.WriteString("$" + strconv.Itoa(position))
+	}
+
+	return out.String()
+}
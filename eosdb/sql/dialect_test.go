@@ -0,0 +1,45 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDialectInsertIgnore(t *testing.T) {
+	query := "INSERT INTO irrblks (id, irreversible) VALUES (?, ?)"
+
+	tests := []struct {
+		dialect  *dialect
+		expected string
+	}{
+		{mysqlDialect, "INSERT IGNORE INTO irrblks (id, irreversible) VALUES (?, ?)"},
+		{sqliteDialect, "INSERT OR IGNORE INTO irrblks (id, irreversible) VALUES (?, ?)"},
+		{postgresDialect, "INSERT INTO irrblks (id, irreversible) VALUES ($1, $2) ON CONFLICT DO NOTHING"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.dialect.driverName, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.dialect.rebind(test.dialect.insertIgnore(query)))
+		})
+	}
+}
+
+func TestDollarRebind(t *testing.T) {
+	assert.Equal(t, "SELECT id FROM blks", dollarRebind("SELECT id FROM blks"))
+	assert.Equal(t, "WHERE blks.number <= $1 AND blks.number > $2 LIMIT $3", dollarRebind("WHERE blks.number <= ? AND blks.number > ? LIMIT ?"))
+}
@@ -0,0 +1,175 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// Migration upgrades the schema from `Version - 1` to `Version`. The migrations of a
+// dialect are ordered by version, starting at 1 without gaps, and are never modified
+// once released: changing the schema is done by appending a new migration.
+//
+// Each migration runs in a transaction along with the recording of its version in the
+// `schema_version` table. MySQL commits DDL statements implicitly though, so there, a
+// migration failing midway must be completed by hand.
+type Migration struct {
+	Version     int
+	Description string
+
+	statements []string
+}
+
+var createSchemaVersionTableStmt = `CREATE TABLE IF NOT EXISTS schema_version (
+    version int NOT NULL PRIMARY KEY,
+    description varchar(255) NOT NULL
+);`
+
+// SchemaVersion returns the version of the database's schema, 0 when empty.
+//
+// Databases created before the schema was versioned have no `schema_version` table,
+// they are at version 1 as long as the `blks` table exists.
+func (db *DB) SchemaVersion(ctx context.Context) (int, error) {
+	versioned, err := db.tableExists(ctx, "schema_version")
+	if err != nil {
+		return 0, err
+	}
+
+	if versioned {
+		var version sql.NullInt64
+		if err := db.queryRow(ctx, "SELECT MAX(version) FROM schema_version").Scan(&version); err != nil {
+			return 0, fmt.Errorf("reading schema version: %w", err)
+		}
+
+		if version.Valid {
+			return int(version.Int64), nil
+		}
+	}
+
+	unversioned, err := db.tableExists(ctx, "blks")
+	if err != nil {
+		return 0, err
+	}
+
+	if unversioned {
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+// PendingMigrations returns the migrations `Migrate` would apply, in order.
+func (db *DB) PendingMigrations(ctx context.Context) ([]*Migration, error) {
+	return db.pendingMigrations(ctx, db.dialect.migrations)
+}
+
+// Migrate upgrades the schema to the latest version, applying the pending migrations
+// in order. `onApplied`, when not nil, is called after each migration is committed.
+func (db *DB) Migrate(ctx context.Context, onApplied func(migration *Migration)) error {
+	return db.migrate(ctx, db.dialect.migrations, onApplied)
+}
+
+func (db *DB) pendingMigrations(ctx context.Context, migrations []*Migration) (out []*Migration, err error) {
+	version, err := db.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, migration := range migrations {
+		if migration.Version > version {
+			out = append(out, migration)
+		}
+	}
+
+	return out, nil
+}
+
+func (db *DB) migrate(ctx context.Context, migrations []*Migration, onApplied func(migration *Migration)) error {
+	version, err := db.SchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := db.db.ExecContext(ctx, createSchemaVersionTableStmt); err != nil {
+		return fmt.Errorf("create schema_version: %w", err)
+	}
+
+	// The version of a database created before versioning is recorded, so it's no longer
+	// inferred from the tables present
+	if version == 1 {
+		err := db.insertIgnore(ctx, db.db, "INSERT INTO schema_version (version, description) VALUES (?, ?)", 1, migrations[0].Description)
+		if err != nil {
+			return fmt.Errorf("record unversioned schema: %w", err)
+		}
+	}
+
+	for _, migration := range migrations {
+		if migration.Version <= version {
+			continue
+		}
+
+		zlog.Info("applying schema migration", zap.Int("version", migration.Version), zap.String("description", migration.Description))
+		if err := db.applyMigration(ctx, migration); err != nil {
+			return fmt.Errorf("migration #%d (%s): %w", migration.Version, migration.Description, err)
+		}
+
+		if onApplied != nil {
+			onApplied(migration)
+		}
+	}
+
+	return nil
+}
+
+func (db *DB) applyMigration(ctx context.Context, migration *Migration) error {
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	for i, statement := range migration.statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			if err := tx.Rollback(); err != nil {
+				zlog.Error("rollback failed", zap.Error(err))
+			}
+
+			return fmt.Errorf("statement #%d: %w", i+1, err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, db.dialect.rebind("INSERT INTO schema_version (version, description) VALUES (?, ?)"), migration.Version, migration.Description)
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
+			zlog.Error("rollback failed", zap.Error(err))
+		}
+
+		return fmt.Errorf("record version: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (db *DB) tableExists(ctx context.Context, table string) (bool, error) {
+	var count int
+	if err := db.queryRow(ctx, db.dialect.tableExistsQuery, table).Scan(&count); err != nil {
+		return false, fmt.Errorf("checking if table %s exists: %w", table, err)
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/eosdb/eosdbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMigrationDB(t *testing.T, createTables bool) (*DB, func()) {
+	dir, err := ioutil.TempDir("", "eosdb-migrations")
+	require.NoError(t, err)
+
+	rawdb, err := New(fmt.Sprintf("sqlite3://%s?createTables=%t", path.Join(dir, "eosdb.sqlite"), createTables))
+	require.NoError(t, err)
+
+	db := rawdb.(*DB)
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := newTestMigrationDB(t, false)
+	defer cleanup()
+
+	version, err := db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, version)
+
+	pending, err := db.PendingMigrations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, sqliteMigrations, pending)
+
+	var applied []int
+	require.NoError(t, db.Migrate(ctx, func(migration *Migration) { applied = append(applied, migration.Version) }))
+	assert.Equal(t, []int{1, 2}, applied)
+
+	version, err = db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(sqliteMigrations), version)
+
+	// Nothing left to do once up to date
+	applied = nil
+	require.NoError(t, db.Migrate(ctx, func(migration *Migration) { applied = append(applied, migration.Version) }))
+	assert.Empty(t, applied)
+}
+
+func TestMigrateUnversionedDatabase(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := newTestMigrationDB(t, false)
+	defer cleanup()
+
+	// Tables and indexes created before the schema was versioned, creating the indexes
+	// again would fail
+	for _, statement := range sqliteMigrations[0].statements {
+		_, err := db.db.Exec(statement)
+		require.NoError(t, err)
+	}
+
+	version, err := db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, version)
+
+	require.NoError(t, db.Migrate(ctx, nil))
+
+	var recorded int
+	require.NoError(t, db.db.QueryRow("SELECT COUNT(*) FROM schema_version WHERE version = 1").Scan(&recorded))
+	assert.Equal(t, 1, recorded)
+
+	exists, err := db.tableExists(ctx, "accttrxs")
+	require.NoError(t, err)
+	assert.True(t, exists, "migrations after the first one should have been applied")
+}
+
+func TestMigrateAddColumnAndIndex(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := newTestMigrationDB(t, true)
+	defer cleanup()
+
+	blk := eosdbtest.TestBlock(t, "00000002aa", "00000001aa")
+	require.NoError(t, db.PutBlock(ctx, blk))
+
+	migrations := append([]*Migration{}, sqliteMigrations...)
+	migrations = append(migrations, &Migration{
+		Version:     len(sqliteMigrations) + 1,
+		Description: "add blks producer index and trxCount column",
+		statements: []string{
+			"CREATE INDEX blks_producer ON blks (blockProducer)",
+			"ALTER TABLE blks ADD COLUMN trxCount int",
+		},
+	})
+
+	require.NoError(t, db.migrate(ctx, migrations, nil))
+
+	version, err := db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(migrations), version)
+
+	// Existing rows are still readable
+	resp, err := db.GetBlock(ctx, blk.Id)
+	require.NoError(t, err)
+	assert.Equal(t, blk.Id, resp.Block.Id)
+
+	// A failing migration leaves the version untouched
+	migrations = append(migrations, &Migration{
+		Version:     len(migrations) + 1,
+		Description: "invalid",
+		statements:  []string{"ALTER TABLE unknown ADD COLUMN value int"},
+	})
+
+	require.Error(t, db.migrate(ctx, migrations, nil))
+
+	version, err = db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(migrations)-1, version)
+}
@@ -0,0 +1,210 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/dfuse-io/dfuse-eosio/eosdb"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+// forkedBlock holds what's needed to find all the rows written by `PutBlock` for a block
+type forkedBlock struct {
+	id             string
+	trxIDs         []string
+	implicitTrxIDs []string
+	traceIDs       []string
+
+	// Traces by transaction ID, the ones not found being missing
+	traces map[string]*pbcodec.TransactionTrace
+}
+
+// Forked blocks are the ones not irreversible while another block at the same height is
+var selectForkedBlocksQuery = `SELECT blks.id, blks.trxRefs, blks.traceRefs, blks.implicitTrxRefs
+          FROM blks
+          WHERE blks.number BETWEEN ? AND ?
+            AND NOT EXISTS (SELECT 1 FROM irrblks WHERE irrblks.id = blks.id)
+            AND EXISTS (SELECT 1 FROM blks canonical JOIN irrblks ON (canonical.id = irrblks.id) WHERE canonical.number = blks.number)`
+
+func (db *DB) PruneForkedBlocks(ctx context.Context, lowBlockNum, highBlockNum uint32) (prunedCount int, err error) {
+	zlog.Debug("prune forked blocks", zap.Uint32("low_block_num", lowBlockNum), zap.Uint32("high_block_num", highBlockNum))
+
+	forkedBlocks, err := db.getForkedBlocks(ctx, lowBlockNum, highBlockNum)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, blk := range forkedBlocks {
+		if err := db.deleteForkedBlock(ctx, blk); err != nil {
+			return prunedCount, fmt.Errorf("delete forked block %s: %w", blk.id, err)
+		}
+
+		prunedCount++
+	}
+
+	return prunedCount, nil
+}
+
+func (db *DB) getForkedBlocks(ctx context.Context, lowBlockNum, highBlockNum uint32) (out []*forkedBlock, err error) {
+	rows, err := db.query(ctx, selectForkedBlocksQuery, lowBlockNum, highBlockNum)
+	if err != nil {
+		return nil, fmt.Errorf("running query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		blk := &forkedBlock{}
+		var rawTrxRefs, rawTraceRefs, rawImplicitTrxRefs []byte
+		if err := rows.Scan(&blk.id, &rawTrxRefs, &rawTraceRefs, &rawImplicitTrxRefs); err != nil {
+			return nil, fmt.Errorf("scanning: %w", err)
+		}
+
+		if blk.trxIDs, err = db.decodeTransactionRefs(rawTrxRefs); err != nil {
+			return nil, fmt.Errorf("decode trx refs: %w", err)
+		}
+
+		if blk.implicitTrxIDs, err = db.decodeTransactionRefs(rawImplicitTrxRefs); err != nil {
+			return nil, fmt.Errorf("decode implicit trx refs: %w", err)
+		}
+
+		if blk.traceIDs, err = db.decodeTransactionRefs(rawTraceRefs); err != nil {
+			return nil, fmt.Errorf("decode trace refs: %w", err)
+		}
+
+		out = append(out, blk)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Traces are read once all blocks are scanned, not to hold two connections at once
+	for _, blk := range out {
+		blk.traces = map[string]*pbcodec.TransactionTrace{}
+		for _, traceID := range blk.traceIDs {
+			var traceData []byte
+			err := db.queryRow(ctx, "SELECT trace FROM trxtraces WHERE id = ? AND blockId = ?", traceID, blk.id).Scan(&traceData)
+			if err == sql.ErrNoRows {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("get trx trace %s: %w", traceID, err)
+			}
+
+			trace := &pbcodec.TransactionTrace{}
+			if err := db.dec.Into(traceData, trace); err != nil {
+				return nil, fmt.Errorf("decode trx trace %s: %w", traceID, err)
+			}
+			blk.traces[traceID] = trace
+		}
+	}
+
+	return out, nil
+}
+
+func (db *DB) decodeTransactionRefs(data []byte) (out []string, err error) {
+	refs := &pbcodec.TransactionRefs{}
+	if err := db.dec.Into(data, refs); err != nil {
+		return nil, err
+	}
+
+	for _, hash := range refs.Hashes {
+		out = append(out, hex.EncodeToString(hash))
+	}
+
+	return out, nil
+}
+
+// deleteForkedBlock deletes, in a single transaction, all the rows `PutBlock` wrote for the block
+func (db *DB) deleteForkedBlock(ctx context.Context, blk *forkedBlock) error {
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	if err := db.deleteForkedBlockRows(ctx, tx, blk); err != nil {
+		if err := tx.Rollback(); err != nil {
+			zlog.Error("rollback failed", zap.Error(err))
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+
+	return nil
+}
+
+func (db *DB) deleteForkedBlockRows(ctx context.Context, tx *sql.Tx, blk *forkedBlock) error {
+	for _, trxID := range blk.trxIDs {
+		if err := db.exec(ctx, tx, "DELETE FROM trxs WHERE id = ? AND blockId = ?", trxID, blk.id); err != nil {
+			return err
+		}
+	}
+
+	for _, trxID := range blk.implicitTrxIDs {
+		if err := db.exec(ctx, tx, "DELETE FROM implicittrxs WHERE id = ? AND blockId = ?", trxID, blk.id); err != nil {
+			return err
+		}
+	}
+
+	for _, traceID := range blk.traceIDs {
+		if err := db.deleteTraceRows(ctx, tx, blk, blk.traces[traceID]); err != nil {
+			return err
+		}
+
+		if err := db.exec(ctx, tx, "DELETE FROM trxtraces WHERE id = ? AND blockId = ?", traceID, blk.id); err != nil {
+			return err
+		}
+	}
+
+	return db.exec(ctx, tx, "DELETE FROM blks WHERE id = ?", blk.id)
+}
+
+// deleteTraceRows deletes the rows derived from a trace, nothing when `trace` is nil
+func (db *DB) deleteTraceRows(ctx context.Context, tx *sql.Tx, blk *forkedBlock, trace *pbcodec.TransactionTrace) error {
+	if trace == nil {
+		return nil
+	}
+
+	for _, dtrxOp := range trace.DtrxOps {
+		if err := db.exec(ctx, tx, "DELETE FROM dtrxs WHERE id = ? AND blockId = ?", dtrxOp.TransactionId, blk.id); err != nil {
+			return err
+		}
+	}
+
+	for _, act := range trace.ActionTraces {
+		if act.Account() == "eosio" && act.Receiver == "eosio" && act.Name() == "newaccount" {
+			if err := db.exec(ctx, tx, "DELETE FROM accts WHERE account = ? AND blockId = ?", act.GetData("name").String(), blk.id); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, account := range eosdb.AccountsTouchedByTransaction(trace) {
+		if err := db.exec(ctx, tx, "DELETE FROM accttrxs WHERE account = ? AND blockNum = ? AND blockId = ? AND trxId = ?", account, eos.BlockNum(blk.id), blk.id, trace.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
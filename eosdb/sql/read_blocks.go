@@ -28,7 +28,11 @@ import (
 func (db *DB) GetLastWrittenBlockID(ctx context.Context) (blockID string, err error) {
 	q := `SELECT id FROM blks ORDER BY number DESC LIMIT 1`
 	var id string
-	if err = db.db.QueryRowContext(ctx, q).Scan(&id); err != nil {
+	if err = db.queryRow(ctx, q).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return "", kvdb.ErrNotFound
+		}
+
 		return "", err
 	}
 
@@ -39,7 +43,7 @@ func (db *DB) GetBlock(ctx context.Context, id string) (*pbcodec.BlockWithRefs,
 	// TODO: did this function support prefix search on the block ID?  Is it important?
 	// if id is full length, then check for equality, otherwise for LIKE = '?%'
 	q := getBlockSelectFields + `WHERE blks.id = ? LIMIT 1`
-	rows, err := db.db.QueryContext(ctx, q, id)
+	rows, err := db.query(ctx, q, id)
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +117,7 @@ func (db *DB) scanBlockRows(rows *sql.Rows) (out []*pbcodec.BlockWithRefs, err e
 
 func (db *DB) GetBlockByNum(ctx context.Context, num uint32) ([]*pbcodec.BlockWithRefs, error) {
 	q := getBlockSelectFields + `WHERE blks.number = ?`
-	rows, err := db.db.QueryContext(ctx, q, num)
+	rows, err := db.query(ctx, q, num)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +137,7 @@ func (db *DB) GetBlockByNum(ctx context.Context, num uint32) ([]*pbcodec.BlockWi
 func (db *DB) GetClosestIrreversibleIDAtBlockNum(ctx context.Context, num uint32) (ref bstream.BlockRef, err error) {
 	q := getBlockSelectFields + `where blks.number <= ? and irrblks.irreversible = true ORDER BY blks.number DESC limit 10`
 
-	rows, err := db.db.QueryContext(ctx, q, num)
+	rows, err := db.query(ctx, q, num)
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +161,7 @@ func (db *DB) GetIrreversibleIDAtBlockID(ctx context.Context, ID string) (ref bs
 	}
 	q := getBlockSelectFields + `where blks.number = ? and irrblks.irreversible = true`
 
-	rows, err := db.db.QueryContext(ctx, q, blk.Block.DposIrreversibleBlocknum)
+	rows, err := db.query(ctx, q, blk.Block.DposIrreversibleBlocknum)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +184,7 @@ func (db *DB) ListBlocks(ctx context.Context, startBlockNum uint32, limit int) (
 		ORDER BY blks.number DESC
 		LIMIT ?
 `
-	rows, err := db.db.QueryContext(ctx, q, startBlockNum, limit)
+	rows, err := db.query(ctx, q, startBlockNum, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +209,7 @@ func (db *DB) ListSiblingBlocks(ctx context.Context, blockNum uint32, spread uin
 		AND blks.number > ?
 		ORDER BY blks.number DESC
 `
-	rows, err := db.db.QueryContext(ctx, q, startBlockNum, endBlockNum)
+	rows, err := db.query(ctx, q, startBlockNum, endBlockNum)
 	if err != nil {
 		return nil, err
 	}
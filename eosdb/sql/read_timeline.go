@@ -43,7 +43,7 @@ func (db *DB) BlockIDAt(ctx context.Context, start time.Time) (id string, err er
 }
 
 func (db *DB) scanBlockIDIrreversibleOrFirst(ctx context.Context, q string, start time.Time) (id string, tm time.Time, err error) {
-	rows, err := db.db.QueryContext(ctx, q, start)
+	rows, err := db.query(ctx, q, start)
 	if err != nil {
 		return
 	}
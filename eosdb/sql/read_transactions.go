@@ -23,6 +23,7 @@ import (
 	"github.com/dfuse-io/dfuse-eosio/eosdb"
 	"github.com/dfuse-io/dfuse-eosio/eosdb/mdl"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
 )
 
 func (db *DB) GetTransactionTraces(ctx context.Context, idPrefix string) (out []*pbcodec.TransactionEvent, err error) {
@@ -88,7 +89,7 @@ func (db *DB) getTransactionAdditionEvents(ctx context.Context, idPrefix string)
           FROM trxs
           LEFT JOIN irrblks ON (trxs.blockId = irrblks.id)
           WHERE trxs.id LIKE ?`
-	rows, err := db.db.QueryContext(ctx, q, idPrefix+"%")
+	rows, err := db.query(ctx, q, idPrefix+"%")
 	if err != nil {
 		return nil, fmt.Errorf("running query: %s", err)
 	}
@@ -151,7 +152,7 @@ func (db *DB) getTransactionImplicitEvents(ctx context.Context, idPrefix string)
           FROM implicittrxs
           LEFT JOIN irrblks ON (implicittrxs.blockId = irrblks.id)
           WHERE implicittrxs.id LIKE ?`
-	rows, err := db.db.QueryContext(ctx, q, idPrefix+"%")
+	rows, err := db.query(ctx, q, idPrefix+"%")
 	if err != nil {
 		return nil, fmt.Errorf("running query: %s", err)
 	}
@@ -195,7 +196,7 @@ func (db *DB) getTransactionExecutionEvents(ctx context.Context, idPrefix string
           LEFT JOIN irrblks ON (trxtraces.blockId = irrblks.id)
           LEFT JOIN blks ON (trxtraces.blockId = blks.id)
           WHERE trxtraces.id LIKE ?`
-	rows, err := db.db.QueryContext(ctx, q, idPrefix+"%")
+	rows, err := db.query(ctx, q, idPrefix+"%")
 	if err != nil {
 		return nil, fmt.Errorf("running query: %s", err)
 	}
@@ -249,7 +250,7 @@ func (db *DB) getTransactionDtrxEvents(ctx context.Context, idPrefix string) (ou
           FROM dtrxs
           LEFT JOIN irrblks ON (dtrxs.blockId = irrblks.id)
           WHERE dtrxs.id LIKE ?`
-	rows, err := db.db.QueryContext(ctx, q, idPrefix+"%")
+	rows, err := db.query(ctx, q, idPrefix+"%")
 	if err != nil {
 		return nil, fmt.Errorf("running query: %s", err)
 	}
@@ -257,15 +258,15 @@ func (db *DB) getTransactionDtrxEvents(ctx context.Context, idPrefix string) (ou
 	for rows.Next() {
 		ev := &pbcodec.TransactionEvent{}
 		var signedTrxData, createdByData, canceledByData []byte
+		var irr *bool
 		err := rows.Scan(
-			&ev.Id, &ev.BlockId, &signedTrxData, &createdByData, &canceledByData, &ev.Irreversible,
+			&ev.Id, &ev.BlockId, &signedTrxData, &createdByData, &canceledByData, &irr,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		// TODO: ev.Irreversible will crash when `irrblks` has no data,
-		// as irreversible will be NULL, use `BoolPtr()` like in `read_blocks`
+		ev.Irreversible = eosdb.BoolPtr(irr)
 
 		signedTrx := &pbcodec.SignedTransaction{}
 		if err := db.dec.Into(signedTrxData, signedTrx); err != nil {
@@ -318,3 +319,52 @@ func (db *DB) ListMostRecentTransactions(ctx context.Context, startKey string, l
 func (db *DB) ListTransactionsForBlockID(ctx context.Context, id string, startKey string, limit int, chainDiscriminator eosdb.ChainDiscriminator) (*mdl.TransactionList, error) {
 	return nil, nil
 }
+
+func (db *DB) ListTransactionsForAccount(ctx context.Context, account string, cursor string, limit int) (*mdl.AccountTransactionList, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit %d", limit)
+	}
+
+	q := "SELECT blockId, trxId FROM accttrxs WHERE account = ?"
+	args := []interface{}{account}
+	if cursor != "" {
+		blockID, trxID, err := eosdb.DecodeAccountTransactionCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		blockNum := eos.BlockNum(blockID)
+		q += " AND (blockNum < ? OR (blockNum = ? AND (blockId > ? OR (blockId = ? AND trxId > ?))))"
+		args = append(args, blockNum, blockNum, blockID, blockID, trxID)
+	}
+
+	// One more than the limit is read to know if there is a next page
+	q += " ORDER BY blockNum DESC, blockId ASC, trxId ASC LIMIT ?"
+	args = append(args, limit+1)
+
+	rows, err := db.query(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("running query: %s", err)
+	}
+	defer rows.Close()
+
+	out := &mdl.AccountTransactionList{}
+	for rows.Next() {
+		if len(out.Transactions) == limit {
+			out.NextCursor = eosdb.AccountTransactionCursor(out.Transactions[limit-1])
+			break
+		}
+
+		var blockID, trxID string
+		if err := rows.Scan(&blockID, &trxID); err != nil {
+			return nil, fmt.Errorf("scanning: %s", err)
+		}
+
+		out.Transactions = append(out.Transactions, eosdb.NewAccountTransactionRef(blockID, trxID))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -16,7 +16,10 @@ package sql
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/url"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/dfuse-io/dfuse-eosio/eosdb"
@@ -25,21 +28,59 @@ import (
 )
 
 func TestAll(t *testing.T) {
-	//eosdbtest.TestAll(t, "SQL", newTestSqlFactory(t, "/tmp/kvdbtest.db"))
+	eosdbtest.TestAll(t, "SQL", newTestSqlFactory(t))
+}
+
+// TestAllPostgres runs the suite against the database of `EOSDB_POSTGRES_TEST_DSN`, like
+// `postgres://postgres@localhost:5432/eosdb_test?sslmode=disable`. All tables are dropped
+// after each test, never point it to a database holding anything of value.
+func TestAllPostgres(t *testing.T) {
+	dsn := os.Getenv("EOSDB_POSTGRES_TEST_DSN")
+	if dsn == "" {
+		t.Skip("EOSDB_POSTGRES_TEST_DSN is not set, skipping PostgreSQL tests")
+	}
 
+	eosdbtest.TestAll(t, "postgres", newTestPostgresFactory(t, dsn))
 }
 
-func newTestSqlFactory(t *testing.T, testDbFilename string) eosdbtest.DriverFactory {
+func newTestPostgresFactory(t *testing.T, dsn string) eosdbtest.DriverFactory {
+	u, err := url.Parse(dsn)
+	require.NoError(t, err)
+
+	vals := u.Query()
+	vals.Set("createTables", "true")
+	u.RawQuery = vals.Encode()
+
 	return func() (eosdb.Driver, eosdbtest.DriverCleanupFunc) {
-		return newTestSqlFileDriver(t, testDbFilename), func() {
-			err := os.Remove(testDbFilename)
+		driver, err := New(u.String())
+		require.NoError(t, err)
+
+		return driver, func() {
+			db := driver.(*DB)
+			_, err := db.db.Exec("DROP TABLE IF EXISTS trxs, implicittrxs, trxtraces, dtrxs, blks, irrblks, accts, accttrxs, schema_version")
 			require.NoError(t, err)
+			require.NoError(t, db.Close())
+		}
+	}
+}
+
+// newTestSqlFactory gives each test a fresh SQLite database file, removed with its
+// directory once the test is done
+func newTestSqlFactory(t *testing.T) eosdbtest.DriverFactory {
+	return func() (eosdb.Driver, eosdbtest.DriverCleanupFunc) {
+		dir, err := ioutil.TempDir("", "eosdb-sql")
+		require.NoError(t, err)
+
+		driver := newTestSqlFileDriver(t, filepath.Join(dir, "eosdb.db"))
+		return driver, func() {
+			require.NoError(t, driver.(*DB).Close())
+			require.NoError(t, os.RemoveAll(dir))
 		}
 	}
 }
 
 func newTestSqlFileDriver(t *testing.T, testDbFilename string) eosdb.Driver {
-	dns := fmt.Sprintf("sqlite3://%s?cache=shared&mode=memory&createTables=true", testDbFilename)
+	dns := fmt.Sprintf("sqlite3://%s?createTables=true", testDbFilename)
 	rawdb, err := New(dns)
 	require.NoError(t, err)
 	return rawdb
@@ -16,6 +16,7 @@ package sql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math"
 
@@ -40,40 +41,48 @@ func (db *DB) GetLastWrittenIrreversibleBlockRef(ctx context.Context) (ref bstre
 }
 
 func (db *DB) Close() error {
-	// TODO: close something..
-	return nil
+	return db.db.Close()
 }
 
 func (db *DB) PutBlock(ctx context.Context, blk *pbcodec.Block) error {
-	defer db.db.ExecContext(ctx, "SET autocommit=1")
-
-	_, err := db.db.ExecContext(ctx, "SET autocommit=0")
-	_, err = db.db.ExecContext(ctx, BEGIN_TRANSACTION)
-
+	tx, err := db.db.BeginTx(ctx, nil)
 	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	if err := db.putBlock(ctx, tx, blk); err != nil {
 		zlog.Error("Rollback", zap.Error(err))
-		_, err = db.db.ExecContext(ctx, "ROLLBACK")
+		if err := tx.Rollback(); err != nil {
+			zlog.Error("rollback failed", zap.Error(err))
+		}
+
 		return err
 	}
 
-	if err := db.putTransactions(ctx, blk); err != nil {
-		zlog.Error("Rollback", zap.Error(err))
-		_, err = db.db.ExecContext(ctx, "ROLLBACK")
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+
+	return nil
+}
+
+func (db *DB) putBlock(ctx context.Context, tx *sql.Tx, blk *pbcodec.Block) error {
+	if err := db.putTransactions(ctx, tx, blk); err != nil {
 		return fmt.Errorf("put transactions: %w", err)
 	}
 
-	if err := db.putTransactionTraces(ctx, blk); err != nil {
-		zlog.Error("Rollback", zap.Error(err))
-		_, err = db.db.ExecContext(ctx, "ROLLBACK")
+	if err := db.putTransactionTraces(ctx, tx, blk); err != nil {
 		return fmt.Errorf("put transaction traces: %w", err)
 	}
 
-	if err := db.putImplicitTransactions(ctx, blk); err != nil {
-		zlog.Error("Rollback", zap.Error(err))
-		_, err = db.db.ExecContext(ctx, "ROLLBACK")
+	if err := db.putImplicitTransactions(ctx, tx, blk); err != nil {
 		return fmt.Errorf("put implicit transactions: %w", err)
 	}
 
+	if err := db.putAccountTransactions(ctx, tx, blk); err != nil {
+		return fmt.Errorf("put account transactions: %w", err)
+	}
+
 	implicitTrxRefs, trxRefs, tracesRefs := db.getRefs(blk)
 
 	holdTransactions := blk.Transactions
@@ -84,27 +93,21 @@ func (db *DB) PutBlock(ctx context.Context, blk *pbcodec.Block) error {
 	blk.Transactions = nil
 	blk.TransactionTraces = nil
 
-	_, err = db.db.ExecContext(ctx, INSERT_IGNORE+"INTO blks (id, number, previousId, irrBlockNum, blockProducer, blockTime, block, blockHeader, trxRefs, traceRefs, implicitTrxRefs) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
-		blk.ID(), blk.Num(), blk.Header.Previous, blk.DposIrreversibleBlocknum, blk.Header.Producer, blk.MustTime(), db.enc.MustProto(blk), db.enc.MustProto(blk.Header), db.enc.MustProto(trxRefs), db.enc.MustProto(tracesRefs), db.enc.MustProto(implicitTrxRefs))
-	if err != nil {
-		zlog.Error("Rollback", zap.Error(err))
-		_, err = db.db.ExecContext(ctx, "ROLLBACK")
-		return err
-	}
-	_, err = db.db.ExecContext(ctx, "COMMIT")
-
-	blk.ImplicitTransactionOps = holdImplicitTransactionOps
-	blk.Transactions = holdTransactions
-	blk.TransactionTraces = holdTransactionTraces
+	defer func() {
+		blk.ImplicitTransactionOps = holdImplicitTransactionOps
+		blk.Transactions = holdTransactions
+		blk.TransactionTraces = holdTransactionTraces
+	}()
 
-	return nil
+	return db.insertIgnore(ctx, tx, "INSERT INTO blks (id, number, previousId, irrBlockNum, blockProducer, blockTime, block, blockHeader, trxRefs, traceRefs, implicitTrxRefs) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		blk.ID(), blk.Num(), blk.Header.Previous, blk.DposIrreversibleBlocknum, blk.Header.Producer, blk.MustTime(), db.enc.MustProto(blk), db.enc.MustProto(blk.Header), db.enc.MustProto(trxRefs), db.enc.MustProto(tracesRefs), db.enc.MustProto(implicitTrxRefs))
 }
 
 func (db *DB) Flush(ctx context.Context) error {
 	return nil
 }
 
-func (db *DB) putTransactions(ctx context.Context, blk *pbcodec.Block) error {
+func (db *DB) putTransactions(ctx context.Context, tx *sql.Tx, blk *pbcodec.Block) error {
 	for _, trxReceipt := range blk.Transactions {
 		if trxReceipt.PackedTransaction == nil {
 			// This means we deal with a deferred transaction receipt, and that it has been handled through DtrxOps already
@@ -125,7 +128,7 @@ func (db *DB) putTransactions(ctx context.Context, blk *pbcodec.Block) error {
 		// db.Transactions.PutTrx(trxKey, signedTrx)
 		// db.Transactions.PutPublicKeys(trxKey, pubKeys)
 
-		_, err = db.db.ExecContext(ctx, INSERT_IGNORE+"INTO trxs (id, blockId, receipt, signedTrx, publicKeys) VALUES (?, ?, ?, ?, ?)", trxReceipt.Id, blk.ID(), db.enc.MustProto(trxReceipt), db.enc.MustProto(signedTrx), db.enc.MustProto(pubKeyProto))
+		err = db.insertIgnore(ctx, tx, "INSERT INTO trxs (id, blockId, receipt, signedTrx, publicKeys) VALUES (?, ?, ?, ?, ?)", trxReceipt.Id, blk.ID(), db.enc.MustProto(trxReceipt), db.enc.MustProto(signedTrx), db.enc.MustProto(pubKeyProto))
 		if err != nil {
 			return err
 		}
@@ -134,7 +137,7 @@ func (db *DB) putTransactions(ctx context.Context, blk *pbcodec.Block) error {
 	return nil
 }
 
-func (db *DB) putTransactionTraces(ctx context.Context, blk *pbcodec.Block) error {
+func (db *DB) putTransactionTraces(ctx context.Context, tx *sql.Tx, blk *pbcodec.Block) error {
 	//marshaledHeader := db.enc.MustProto(blk.Header)
 
 	for _, trxTrace := range blk.TransactionTraces {
@@ -152,7 +155,7 @@ func (db *DB) putTransactionTraces(ctx context.Context, blk *pbcodec.Block) erro
 				canceled = db.enc.MustProto(extDtrxOp)
 			}
 
-			_, err := db.db.ExecContext(ctx, INSERT_IGNORE+"INTO dtrxs (id, blockId, signedTrx, createdBy, canceledBy) VALUES (?, ?, ?, ?, ?)",
+			err := db.insertIgnore(ctx, tx, "INSERT INTO dtrxs (id, blockId, signedTrx, createdBy, canceledBy) VALUES (?, ?, ?, ?, ?)",
 				dtrxOp.TransactionId, blk.ID(), signedTrx, created, canceled)
 			if err != nil {
 				return err
@@ -162,7 +165,7 @@ func (db *DB) putTransactionTraces(ctx context.Context, blk *pbcodec.Block) erro
 		// Specialized indexing for `newaccount` on the chain.
 		for _, act := range trxTrace.ActionTraces {
 			if act.Account() == "eosio" && act.Receiver == "eosio" && act.Name() == "newaccount" {
-				err := db.putNewAccount(blk, trxTrace, act)
+				err := db.putNewAccount(ctx, tx, blk, trxTrace, act)
 				if err != nil {
 					return fmt.Errorf("failed to put new account: %w", err)
 				}
@@ -172,7 +175,7 @@ func (db *DB) putTransactionTraces(ctx context.Context, blk *pbcodec.Block) erro
 		// trxTraceKey := Keys.Transaction(trxTrace.Id, blockID)
 		// db.Transactions.PutTrace(trxTraceKey, trxTrace)
 		// db.Transactions.PutBlockHeader(trxTraceKey, blk.Header)
-		_, err := db.db.Exec(INSERT_IGNORE+"INTO trxtraces (id, blockId, trace) VALUES (?, ?, ?)", trxTrace.Id, blk.ID(), db.enc.MustProto(trxTrace))
+		err := db.insertIgnore(ctx, tx, "INSERT INTO trxtraces (id, blockId, trace) VALUES (?, ?, ?)", trxTrace.Id, blk.ID(), db.enc.MustProto(trxTrace))
 		if err != nil {
 			return err
 		}
@@ -181,10 +184,10 @@ func (db *DB) putTransactionTraces(ctx context.Context, blk *pbcodec.Block) erro
 	return nil
 }
 
-func (db *DB) putImplicitTransactions(ctx context.Context, blk *pbcodec.Block) error {
+func (db *DB) putImplicitTransactions(ctx context.Context, tx *sql.Tx, blk *pbcodec.Block) error {
 	// name is "onblock" or "onerror"
 	for _, trxOp := range blk.ImplicitTransactionOps {
-		_, err := db.db.ExecContext(ctx, INSERT_IGNORE+"INTO implicittrxs (id, blockId, name, signedTrx) VALUES (?, ?, ?, ?)", trxOp.TransactionId, blk.ID(), trxOp.Name, db.enc.MustProto(trxOp.Transaction))
+		err := db.insertIgnore(ctx, tx, "INSERT INTO implicittrxs (id, blockId, name, signedTrx) VALUES (?, ?, ?, ?)", trxOp.TransactionId, blk.ID(), trxOp.Name, db.enc.MustProto(trxOp.Transaction))
 		if err != nil {
 			return err
 		}
@@ -193,6 +196,19 @@ func (db *DB) putImplicitTransactions(ctx context.Context, blk *pbcodec.Block) e
 	return nil
 }
 
+func (db *DB) putAccountTransactions(ctx context.Context, tx *sql.Tx, blk *pbcodec.Block) error {
+	for _, trxTrace := range blk.TransactionTraces {
+		for _, account := range eosdb.AccountsTouchedByTransaction(trxTrace) {
+			err := db.insertIgnore(ctx, tx, "INSERT INTO accttrxs (account, blockNum, blockId, trxId) VALUES (?, ?, ?, ?)", account, blk.Num(), blk.ID(), trxTrace.Id)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (db *DB) getRefs(blk *pbcodec.Block) (implicitTrxRefs, trxRefs, tracesRefs *pbcodec.TransactionRefs) {
 	implicitTrxRefs = &pbcodec.TransactionRefs{}
 	for _, trxOp := range blk.ImplicitTransactionOps {
@@ -213,7 +229,7 @@ func (db *DB) getRefs(blk *pbcodec.Block) (implicitTrxRefs, trxRefs, tracesRefs
 }
 
 func (db *DB) UpdateNowIrreversibleBlock(ctx context.Context, blk *pbcodec.Block) error {
-	_, err := db.db.ExecContext(ctx, INSERT_IGNORE+"INTO irrblks (id, irreversible) VALUES (?, ?)", blk.ID(), true)
+	err := db.insertIgnore(ctx, db.db, "INSERT INTO irrblks (id, irreversible) VALUES (?, ?)", blk.ID(), true)
 	if err != nil {
 		return err
 	}
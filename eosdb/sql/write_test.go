@@ -18,7 +18,9 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -36,24 +38,16 @@ import (
 var ctx = context.Background()
 
 func newWriter(t *testing.T) (*DB, func()) {
-	rawdb, err := New("sqlite3:///tmp/mama.db?cache=shared&mode=memory")
+	// Each test gets its own database file, other packages' tests run concurrently
+	dir, err := ioutil.TempDir("", "eosdb-sql")
 	require.NoError(t, err)
 
-	db := rawdb.(*DB)
-
-	//_, _ = db.db.Exec("DROP DATABASE unittests")
-	//_, err = db.db.Exec("CREATE DATABASE unittests")
-	//require.NoError(t, err)
-
-	rawdb, err = New("sqlite3:///tmp/mama.db?cache=shared&mode=memory&createTables=true")
-	require.NoError(t, err)
-
-	db = rawdb.(*DB)
+	db := newTestSqlFileDriver(t, filepath.Join(dir, "eosdb.db")).(*DB)
 	// db.SetWriterChainID(hexChainID)
 
 	cleanup := func() {
 		db.Close()
-		err := os.Remove("/tmp/mama.db")
+		err := os.RemoveAll(dir)
 		require.NoError(t, err)
 	}
 
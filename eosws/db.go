@@ -58,20 +58,20 @@ type DB interface {
 
 type EOSDB struct {
 	eosdb.DBReader
-
-	chainDiscriminator func(blockID string) bool
 }
 
 func NewEOSDB(dbReader eosdb.DBReader) *EOSDB {
 	return &EOSDB{
 		DBReader: dbReader,
-		// TODO: implement real discriminator
-		chainDiscriminator: func(blockID string) bool {
-			return true
-		},
 	}
 }
 
+// chainDiscriminator returns the discriminator of a single request, remembering the
+// irreversible blocks it fetched with `ctx` until the request is done
+func (db *EOSDB) chainDiscriminator(ctx context.Context) eosdb.ChainDiscriminator {
+	return eosdb.NewIrreversibleChainDiscriminator(ctx, db.DBReader)
+}
+
 func (db *EOSDB) GetTransaction(ctx context.Context, id string) (out *pbcodec.TransactionLifecycle, err error) {
 	evs, err := db.GetTransactionEvents(ctx, id)
 	if err == kvdb.ErrNotFound {
@@ -81,7 +81,7 @@ func (db *EOSDB) GetTransaction(ctx context.Context, id string) (out *pbcodec.Tr
 		return nil, err
 	}
 
-	out = pbcodec.MergeTransactionEvents(evs, db.chainDiscriminator)
+	out = pbcodec.MergeTransactionEvents(evs, db.chainDiscriminator(ctx))
 
 	return
 }
@@ -92,13 +92,14 @@ func (db *EOSDB) GetTransactions(ctx context.Context, ids []string) (out []*pbco
 		return nil, err
 	}
 
+	inCanonicalChain := db.chainDiscriminator(ctx)
 	for idx, ev := range evs {
 		trxID := ids[idx]
 		if len(ev) == 0 {
 			return nil, DBTrxNotFoundError(ctx, trxID)
 		}
 
-		out = append(out, pbcodec.MergeTransactionEvents(ev, db.chainDiscriminator))
+		out = append(out, pbcodec.MergeTransactionEvents(ev, inCanonicalChain))
 	}
 
 	return
@@ -152,9 +153,10 @@ func (db *EOSDB) ListTransactionsForBlockID(ctx context.Context, blockID string,
 		return nil, err
 	}
 
+	inCanonicalChain := db.chainDiscriminator(ctx)
 	var lifecycles []*v1.TransactionLifecycle
 	for _, evs := range trxList {
-		lc, err := mdl.ToV1TransactionLifecycle(pbcodec.MergeTransactionEvents(evs, db.chainDiscriminator))
+		lc, err := mdl.ToV1TransactionLifecycle(pbcodec.MergeTransactionEvents(evs, inCanonicalChain))
 		if err != nil {
 			return nil, fmt.Errorf("transactions list for block ID: %w", err)
 		}
@@ -226,6 +228,7 @@ func (db *EOSDB) ListMostRecentTransactions(ctx context.Context, startKey string
 	}
 	seenTrx := make(map[string]*seenData)
 	seenBlock := make(map[string]bool)
+	inCanonicalChain := db.chainDiscriminator(ctx)
 
 	for {
 		if len(list) >= limit+1 /* +1 for the next cursor */ {
@@ -258,7 +261,7 @@ func (db *EOSDB) ListMostRecentTransactions(ctx context.Context, startKey string
 
 			seenBlock[blk.Id] = true
 
-			if !db.chainDiscriminator(blk.Id) {
+			if !inCanonicalChain(blk.Id) {
 				continue
 			}
 
@@ -327,7 +330,7 @@ func (db *EOSDB) ListMostRecentTransactions(ctx context.Context, startKey string
 	}
 
 	for _, events := range keep {
-		lc, err := mdl.ToV1TransactionLifecycle(pbcodec.MergeTransactionEvents(events, db.chainDiscriminator))
+		lc, err := mdl.ToV1TransactionLifecycle(pbcodec.MergeTransactionEvents(events, inCanonicalChain))
 		if err != nil {
 			return nil, fmt.Errorf("most recent transactions: %w", err)
 		}
@@ -1,12 +1,15 @@
 package fluxdb
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/dfuse-io/derr"
 	"github.com/eoscanada/eos-go"
@@ -18,20 +21,46 @@ type Client interface {
 	GetTableScopes(ctx context.Context, startBlock uint32, request *GetTableScopesRequest) (*GetTableScopesResponse, error)
 	GetTablesMultiScopes(ctx context.Context, startBlock uint32, request *GetTablesMultiScopesRequest) (*GetTablesMultiScopesResponse, error)
 	GetAccountByPubKey(ctx context.Context, startBlock uint32, pubKey string) (*GetAccountByPubKeyResponses, error)
+
+	ListTableRows(ctx context.Context, account, scope, table string, opts ...RequestOption) (*TableRowsResponse, error)
+	StreamTableRows(ctx context.Context, account, scope, table string, onRow func(row *TableRow) error, opts ...RequestOption) (*TableRowsResponse, error)
+	GetTableRow(ctx context.Context, account, scope, table, primaryKey string, opts ...RequestOption) (*TableRowResponse, error)
+	GetTableRowHistory(ctx context.Context, account, scope, table, primaryKey string, fromBlock, toBlock uint32, opts ...RequestOption) (*TableRowHistoryResponse, error)
+	GetTableDiff(ctx context.Context, account, scope, table string, fromBlock, toBlock uint32, opts ...RequestOption) (*TableDiffResponse, error)
+	ListTablesRowsForAccounts(ctx context.Context, accounts []string, scope, table string, opts ...RequestOption) (*TablesRowsResponse, error)
+	ListTablesRowsForScopes(ctx context.Context, account string, scopes []string, table string, opts ...RequestOption) (*TablesRowsResponse, error)
+	ListTablesRowsBatch(ctx context.Context, tables []*BatchTable, opts ...RequestOption) (*TablesRowsResponse, error)
+	ListLinkedPermissions(ctx context.Context, account string, opts ...RequestOption) (*LinkedPermissionsResponse, error)
+	ListPermissions(ctx context.Context, account string, opts ...RequestOption) (*PermissionsResponse, error)
+	GetResources(ctx context.Context, account string, opts ...RequestOption) (*ResourcesResponse, error)
+	DecodeTableRows(ctx context.Context, account, table string, hexRows []string, opts ...RequestOption) (*DecodedTableRowsResponse, error)
+	GetABIHistory(ctx context.Context, account string, withDiff bool, opts ...RequestOption) (*ABIHistoryResponse, error)
+	GetTableStats(ctx context.Context, account, scope, table string, opts ...RequestOption) (*TableStatsResponse, error)
+	GetAccountStats(ctx context.Context, account string, opts ...RequestOption) (*AccountStatsResponse, error)
+	ListRAMPayerRows(ctx context.Context, payer string, opts ...RequestOption) (*RAMPayerRowsResponse, error)
 }
 
 type DefaultClient struct {
 	addr       string
 	httpClient *http.Client
+
+	retryCount   int
+	retryBackoff time.Duration
 }
 
-func NewClient(addr string, transport http.RoundTripper) *DefaultClient {
-	return &DefaultClient{
+func NewClient(addr string, transport http.RoundTripper, opts ...ClientOption) *DefaultClient {
+	client := &DefaultClient{
 		addr: addr,
 		httpClient: &http.Client{
 			Transport: transport,
 		},
 	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
 }
 
 type GetAccountByPubKeyResponses struct {
@@ -212,30 +241,103 @@ func (c *DefaultClient) GetTableScopes(ctx context.Context, startBlock uint32, r
 }
 
 func (c *DefaultClient) performFormRequest(ctx context.Context, path string, form url.Values) (body []byte, err error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s?%s", c.addr, path, form.Encode()), nil)
+	resp, err := c.performRequest(ctx, "GET", path, form, nil)
 	if err != nil {
-		return nil, fmt.Errorf("unable to create new request: %s", err)
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	req = req.WithContext(ctx)
+	body, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read HTTP response body: %s", err)
+	}
 
-	resp, err := c.httpClient.Do(req)
+	return
+}
+
+// performRequest performs the request, retrying it according to the client's retry
+// options, and returns the response only when it succeeded, the caller being then
+// responsible of closing its body.
+func (c *DefaultClient) performRequest(ctx context.Context, method string, path string, form url.Values, body []byte) (*http.Response, error) {
+	backoff := c.retryBackoff
+	for attempt := 0; ; attempt++ {
+		resp, err := c.performRequestOnce(ctx, method, path, form, body)
+		if err == nil {
+			return resp, nil
+		}
+
+		retryable, ok := err.(*retryableError)
+		if !ok {
+			return nil, err
+		}
+
+		if attempt >= c.retryCount {
+			return nil, retryable.err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+	}
+}
+
+func (c *DefaultClient) performRequestOnce(ctx context.Context, method string, path string, form url.Values, body []byte) (*http.Response, error) {
+	requestURL := c.addr + path
+	if len(form) > 0 {
+		requestURL += "?" + form.Encode()
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, requestURL, bodyReader)
 	if err != nil {
-		return nil, fmt.Errorf("unable to perform HTTP request: %s", err)
+		return nil, fmt.Errorf("unable to create new request: %s", err)
 	}
 
-	body, err = ioutil.ReadAll(resp.Body)
+	req = req.WithContext(ctx)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("unable to read HTTP response body: %s", err)
+		return nil, &retryableError{fmt.Errorf("unable to perform HTTP request: %s", err)}
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, bodyToError(resp.StatusCode, body)
+		defer resp.Body.Close()
+
+		responseBody, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, &retryableError{fmt.Errorf("unable to read HTTP response body: %s", err)}
+		}
+
+		err = bodyToError(resp.StatusCode, responseBody)
+		if resp.StatusCode >= 500 {
+			return nil, &retryableError{err}
+		}
+
+		return nil, err
 	}
 
-	return
+	return resp, nil
 }
 
+// retryableError wraps the errors of requests that failed in a transient way, the
+// wrapped error being the one returned once all retries are exhausted.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+
 func bodyToError(statusCode int, body []byte) error {
 	var errorResponse *derr.ErrorResponse
 	if err := json.Unmarshal(body, &errorResponse); err == nil {
@@ -0,0 +1,141 @@
+package fluxdb
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/dfuse-io/derr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTableRows(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v0/state/table", r.URL.Path)
+		query = r.URL.Query()
+
+		w.Write([]byte(`{"up_to_block_id":"0000000aaa","up_to_block_num":10,"last_irreversible_block_id":"00000008aa","last_irreversible_block_num":8,"rows":[{"key":"eosio","payer":"eosio","json":{"balance":"1.0000 EOS"},"block":9}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, nil)
+	response, err := client.ListTableRows(context.Background(), "eosio.token", "eosio", "accounts", AtBlockNum(10), IrreversibleOnly(), WithKeyType("symbol_code"), WithJSON(), WithRowBlockNum())
+	require.NoError(t, err)
+
+	assert.Equal(t, url.Values{
+		"account":           {"eosio.token"},
+		"scope":             {"eosio"},
+		"table":             {"accounts"},
+		"block_num":         {"10"},
+		"irreversible_only": {"true"},
+		"key_type":          {"symbol_code"},
+		"json":              {"true"},
+		"with_block_num":    {"true"},
+	}, query)
+
+	assert.Equal(t, uint32(10), response.UpToBlockNum)
+	assert.Equal(t, "00000008aa", response.LastIrreversibleBlockID)
+	require.Len(t, response.Rows, 1)
+	assert.Equal(t, "eosio", response.Rows[0].Key)
+	assert.JSONEq(t, `{"balance":"1.0000 EOS"}`, string(response.Rows[0].JSON))
+	assert.Equal(t, uint32(9), response.Rows[0].BlockNum)
+}
+
+func TestStreamTableRows(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"up_to_block_id":"0000000aaa","up_to_block_num":10,"abi":{"version":"eosio::abi/1.1"},"rows":[{"key":"a","hex":"01"},{"key":"b","hex":"02"},{"key":"c","hex":"03"}],"extra":[1,{"a":2}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, nil)
+
+	var keys []string
+	response, err := client.StreamTableRows(context.Background(), "eosio.token", "eosio", "accounts", func(row *TableRow) error {
+		keys = append(keys, row.Key)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, uint32(10), response.UpToBlockNum)
+	assert.Nil(t, response.Rows)
+	require.NotNil(t, response.ABI)
+	assert.Equal(t, "eosio::abi/1.1", response.ABI.Version)
+
+	stopErr := errors.New("stop")
+	_, err = client.StreamTableRows(context.Background(), "eosio.token", "eosio", "accounts", func(row *TableRow) error {
+		return stopErr
+	})
+	assert.Equal(t, stopErr, err)
+}
+
+func TestDecodeTableRows(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v0/state/abi/bin_to_json", r.URL.Path)
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"account":"eosio.token","table":"accounts","hex_rows":["01"],"block_num":10}`, string(body))
+
+		w.Write([]byte(`{"block_num":9,"account":"eosio.token","table":"accounts","rows":[{"balance":"1.0000 EOS"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, nil)
+	response, err := client.DecodeTableRows(context.Background(), "eosio.token", "accounts", []string{"01"}, AtBlockNum(10))
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(9), response.BlockNum)
+	require.Len(t, response.Rows, 1)
+	assert.JSONEq(t, `{"balance":"1.0000 EOS"}`, string(response.Rows[0]))
+}
+
+func TestRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Write([]byte(`{"account":"eosio","linked_permissions":[{"contract":"eosio.token","action":"transfer","permission_name":"active"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, nil, WithRetries(2, time.Millisecond))
+	response, err := client.ListLinkedPermissions(context.Background(), "eosio")
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, []*LinkedPermission{{Contract: "eosio.token", Action: "transfer", PermissionName: "active"}}, response.LinkedPermissions)
+}
+
+func TestRetries_ClientErrorNotRetried(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":"request_validation_error","message":"The request is invalid."}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, nil, WithRetries(2, time.Millisecond))
+	_, err := client.GetTableRow(context.Background(), "eosio", "eosio", "global", "global")
+	require.Error(t, err)
+
+	assert.Equal(t, 1, attempts)
+
+	var errorResponse *derr.ErrorResponse
+	require.True(t, errors.As(err, &errorResponse), "expected a derr.ErrorResponse, got %T", err)
+	assert.Equal(t, derr.ErrorCode("request_validation_error"), errorResponse.Code)
+	assert.Equal(t, http.StatusBadRequest, errorResponse.Status)
+}
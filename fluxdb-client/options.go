@@ -0,0 +1,159 @@
+package fluxdb
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type ClientOption func(c *DefaultClient)
+
+// WithHTTPClient makes the client perform its requests through `httpClient`
+// instead of the one built from the transport given to `NewClient`.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *DefaultClient) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithRetries makes the client retry a request up to `count` times, waiting
+// `backoff` (doubled at each attempt) in between, when it failed on a network
+// error or when the server answered with a 5xx status code. Other failures,
+// like validation errors, are never retried.
+func WithRetries(count int, backoff time.Duration) ClientOption {
+	return func(c *DefaultClient) {
+		c.retryCount = count
+		c.retryBackoff = backoff
+	}
+}
+
+// RequestOption tunes a single request, each option mapping to one of the query
+// parameters (or body fields) accepted by the `/v0/state` endpoints. Options not
+// supported by an endpoint are ignored by the server.
+type RequestOption func(o *requestOptions)
+
+type requestOptions struct {
+	blockNum         uint32
+	irreversibleOnly bool
+	keyType          string
+	toJSON           bool
+	withABI          bool
+	withBlockNum     bool
+	offset           int
+	limit            int
+	cursor           string
+
+	indexPosition string
+	indexKeyType  string
+	lowerBound    string
+	upperBound    string
+	reverse       bool
+}
+
+// AtBlockNum reads the state at `blockNum` instead of the head block.
+func AtBlockNum(blockNum uint32) RequestOption {
+	return func(o *requestOptions) { o.blockNum = blockNum }
+}
+
+// IrreversibleOnly reads the state at the last irreversible block, ignoring
+// reversible blocks.
+func IrreversibleOnly() RequestOption {
+	return func(o *requestOptions) { o.irreversibleOnly = true }
+}
+
+// WithKeyType controls how row keys are converted to and from strings, one of `name`
+// (the default), `hex`, `hex_be`, `uint64`, `symbol` or `symbol_code`.
+func WithKeyType(keyType string) RequestOption {
+	return func(o *requestOptions) { o.keyType = keyType }
+}
+
+// WithJSON returns the rows decoded to JSON using the contract's ABI instead of
+// their hexadecimal binary form.
+func WithJSON() RequestOption {
+	return func(o *requestOptions) { o.toJSON = true }
+}
+
+// WithABI returns the ABI used to decode the rows along with them.
+func WithABI() RequestOption {
+	return func(o *requestOptions) { o.withABI = true }
+}
+
+// WithRowBlockNum returns, for each row, the block at which it was last written.
+func WithRowBlockNum() RequestOption {
+	return func(o *requestOptions) { o.withBlockNum = true }
+}
+
+func WithOffset(offset int) RequestOption {
+	return func(o *requestOptions) { o.offset = offset }
+}
+
+func WithLimit(limit int) RequestOption {
+	return func(o *requestOptions) { o.limit = limit }
+}
+
+// WithCursor resumes a paginated listing at `cursor`, the `NextCursor` of the
+// previous page.
+func WithCursor(cursor string) RequestOption {
+	return func(o *requestOptions) { o.cursor = cursor }
+}
+
+// WithIndex reads the table through its secondary index at `position`, its keys
+// being converted according to `keyType`.
+func WithIndex(position string, keyType string) RequestOption {
+	return func(o *requestOptions) {
+		o.indexPosition = position
+		o.indexKeyType = keyType
+	}
+}
+
+// WithBounds restricts the rows returned to keys within `[lowerBound, upperBound]`, an
+// empty bound meaning no restriction on that side.
+func WithBounds(lowerBound, upperBound string) RequestOption {
+	return func(o *requestOptions) {
+		o.lowerBound = lowerBound
+		o.upperBound = upperBound
+	}
+}
+
+// Reversed returns the rows in descending key order.
+func Reversed() RequestOption {
+	return func(o *requestOptions) { o.reverse = true }
+}
+
+func newRequestOptions(opts []RequestOption) *requestOptions {
+	options := &requestOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return options
+}
+
+// values returns the query parameters of the options, only the ones that differ
+// from the server's defaults are set.
+func (o *requestOptions) values() url.Values {
+	val := url.Values{}
+	setIf := func(condition bool, key, value string) {
+		if condition {
+			val.Set(key, value)
+		}
+	}
+
+	setIf(o.blockNum != 0, "block_num", strconv.FormatUint(uint64(o.blockNum), 10))
+	setIf(o.irreversibleOnly, "irreversible_only", "true")
+	setIf(o.keyType != "", "key_type", o.keyType)
+	setIf(o.toJSON, "json", "true")
+	setIf(o.withABI, "with_abi", "true")
+	setIf(o.withBlockNum, "with_block_num", "true")
+	setIf(o.offset != 0, "offset", strconv.Itoa(o.offset))
+	setIf(o.limit != 0, "limit", strconv.Itoa(o.limit))
+	setIf(o.cursor != "", "cursor", o.cursor)
+	setIf(o.indexPosition != "", "index_position", o.indexPosition)
+	setIf(o.indexKeyType != "", "index_key_type", o.indexKeyType)
+	setIf(o.lowerBound != "", "lower_bound", o.lowerBound)
+	setIf(o.upperBound != "", "upper_bound", o.upperBound)
+	setIf(o.reverse, "reverse", "true")
+
+	return val
+}
@@ -0,0 +1,567 @@
+package fluxdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dfuse-io/derr"
+	"github.com/eoscanada/eos-go"
+)
+
+// StateResponse holds the block references returned by every `/v0/state` endpoint
+// reading at a given block.
+type StateResponse struct {
+	UpToBlockID              string `json:"up_to_block_id"`
+	UpToBlockNum             uint32 `json:"up_to_block_num"`
+	LastIrreversibleBlockID  string `json:"last_irreversible_block_id"`
+	LastIrreversibleBlockNum uint32 `json:"last_irreversible_block_num"`
+}
+
+// TableRow is a single contract table row, `Hex` being set when the row was requested
+// in binary form, `JSON` when it was requested decoded (`WithJSON`). When the row could
+// not be decoded, `Error` explains why and `Hex` holds the row's data instead.
+type TableRow struct {
+	Key      string          `json:"key"`
+	Payer    string          `json:"payer"`
+	Hex      eos.HexBytes    `json:"hex"`
+	JSON     json.RawMessage `json:"json"`
+	Error    string          `json:"error"`
+	BlockNum uint32          `json:"block"`
+}
+
+type TableRowsResponse struct {
+	StateResponse
+
+	ABI  *eos.ABI    `json:"abi"`
+	Rows []*TableRow `json:"rows"`
+}
+
+type TableRowResponse struct {
+	StateResponse
+
+	ABI *eos.ABI  `json:"abi"`
+	Row *TableRow `json:"row"`
+}
+
+// TableRowMutation is one change of a table row, only the row's key being set
+// when the mutation is a deletion.
+type TableRowMutation struct {
+	TableRow
+
+	BlockNum uint32 `json:"block_num"`
+	Deleted  bool   `json:"deleted"`
+}
+
+type TableRowHistoryResponse struct {
+	StateResponse
+
+	Mutations []*TableRowMutation `json:"mutations"`
+}
+
+type TableRowUpdate struct {
+	Old *TableRow `json:"old"`
+	New *TableRow `json:"new"`
+}
+
+type TableDiffResponse struct {
+	StateResponse
+
+	ABI      *eos.ABI          `json:"abi"`
+	Inserted []*TableRow       `json:"inserted"`
+	Updated  []*TableRowUpdate `json:"updated"`
+	Removed  []*TableRow       `json:"removed"`
+}
+
+type TableRows struct {
+	Account string      `json:"account"`
+	Scope   string      `json:"scope"`
+	Table   string      `json:"table"`
+	ABI     *eos.ABI    `json:"abi"`
+	Rows    []*TableRow `json:"rows"`
+}
+
+type TablesRowsResponse struct {
+	StateResponse
+
+	Tables []*TableRows `json:"tables"`
+}
+
+// BatchTable is one of the tables read by `ListTablesRowsBatch`, the whole table is
+// read when no `PrimaryKeys` are given.
+type BatchTable struct {
+	Account     string   `json:"account"`
+	Scope       string   `json:"scope"`
+	Table       string   `json:"table"`
+	PrimaryKeys []string `json:"primary_keys,omitempty"`
+}
+
+type LinkedPermission struct {
+	Contract       string `json:"contract"`
+	Action         string `json:"action"`
+	PermissionName string `json:"permission_name"`
+}
+
+type LinkedPermissionsResponse struct {
+	StateResponse
+
+	LinkedPermissions []*LinkedPermission `json:"linked_permissions"`
+}
+
+// Permission follows the format of the permissions returned by nodeos `get_account`,
+// `BlockNum` being the block at which the permission last changed.
+type Permission struct {
+	PermName     string     `json:"perm_name"`
+	LastUpdated  *time.Time `json:"last_updated"`
+	BlockNum     uint32     `json:"block_num"`
+	RequiredAuth *Authority `json:"required_auth"`
+}
+
+type Authority struct {
+	Threshold uint32                   `json:"threshold"`
+	Keys      []*KeyWeight             `json:"keys"`
+	Accounts  []*PermissionLevelWeight `json:"accounts"`
+	Waits     []*WaitWeight            `json:"waits"`
+}
+
+type KeyWeight struct {
+	Key    string `json:"key"`
+	Weight uint32 `json:"weight"`
+}
+
+type PermissionLevelWeight struct {
+	Permission *PermissionLevel `json:"permission"`
+	Weight     uint32           `json:"weight"`
+}
+
+type PermissionLevel struct {
+	Actor      string `json:"actor"`
+	Permission string `json:"permission"`
+}
+
+type WaitWeight struct {
+	WaitSec uint32 `json:"wait_sec"`
+	Weight  uint32 `json:"weight"`
+}
+
+type PermissionsResponse struct {
+	StateResponse
+
+	Account     eos.AccountName `json:"account"`
+	Permissions []*Permission   `json:"permissions"`
+}
+
+// ResourceLimits are the limits of an account, a limit of `-1` meaning the resource
+// is unlimited.
+type ResourceLimits struct {
+	NetWeight int64  `json:"net_weight"`
+	CPUWeight int64  `json:"cpu_weight"`
+	RAMBytes  int64  `json:"ram_bytes"`
+	BlockNum  uint32 `json:"block_num"`
+}
+
+type UsageAccumulator struct {
+	LastOrdinal uint32 `json:"last_ordinal"`
+	ValueEx     uint64 `json:"value_ex"`
+	Consumed    uint64 `json:"consumed"`
+}
+
+type ResourceUsage struct {
+	NetUsage *UsageAccumulator `json:"net_usage"`
+	CPUUsage *UsageAccumulator `json:"cpu_usage"`
+	RAMUsage uint64            `json:"ram_usage"`
+	BlockNum uint32            `json:"block_num"`
+}
+
+// ResourcesResponse holds the account's resource limits and usage, each being nil
+// when fluxdb never saw it.
+type ResourcesResponse struct {
+	StateResponse
+
+	Account eos.AccountName `json:"account"`
+	Limits  *ResourceLimits `json:"limits"`
+	Usage   *ResourceUsage  `json:"usage"`
+}
+
+// ABIRevision is one `setabi` of an account, `Hash` being the sha256 of the packed ABI.
+// When requested, `Diff` lists what changed compared to the previous revision.
+type ABIRevision struct {
+	BlockNum uint32   `json:"block_num"`
+	Hash     string   `json:"hash"`
+	Diff     *ABIDiff `json:"diff"`
+}
+
+type ABIDiff struct {
+	Actions *ABIDefinitionsDiff `json:"actions"`
+	Tables  *ABIDefinitionsDiff `json:"tables"`
+	Structs *ABIDefinitionsDiff `json:"structs"`
+}
+
+type ABIDefinitionsDiff struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	Changed []string `json:"changed"`
+}
+
+type ABIHistoryResponse struct {
+	StateResponse
+
+	Account   eos.AccountName `json:"account"`
+	Revisions []*ABIRevision  `json:"revisions"`
+}
+
+type TableStatsResponse struct {
+	StateResponse
+
+	Account      eos.AccountName `json:"account"`
+	Scope        eos.Name        `json:"scope"`
+	Table        eos.TableName   `json:"table"`
+	RowCount     uint64          `json:"row_count"`
+	PayloadBytes uint64          `json:"payload_bytes"`
+}
+
+type AccountTableStats struct {
+	Table        eos.TableName `json:"table"`
+	Scope        eos.Name      `json:"scope"`
+	RowCount     uint64        `json:"row_count"`
+	PayloadBytes uint64        `json:"payload_bytes"`
+}
+
+type AccountStatsResponse struct {
+	StateResponse
+
+	Account      eos.AccountName      `json:"account"`
+	TableCount   int                  `json:"table_count"`
+	RowCount     uint64               `json:"row_count"`
+	PayloadBytes uint64               `json:"payload_bytes"`
+	Tables       []*AccountTableStats `json:"tables"`
+}
+
+type RAMPayerTable struct {
+	Account      eos.AccountName `json:"account"`
+	Table        eos.TableName   `json:"table"`
+	Scope        eos.Name        `json:"scope"`
+	RowCount     uint64          `json:"row_count"`
+	PayloadBytes uint64          `json:"payload_bytes"`
+	PrimaryKeys  []string        `json:"primary_keys"`
+}
+
+type RAMPayerRowsResponse struct {
+	StateResponse
+
+	Payer        eos.AccountName  `json:"payer"`
+	RowCount     uint64           `json:"row_count"`
+	PayloadBytes uint64           `json:"payload_bytes"`
+	Tables       []*RAMPayerTable `json:"tables"`
+	NextCursor   string           `json:"next_cursor"`
+}
+
+type DecodedTableRowsResponse struct {
+	BlockNum uint32            `json:"block_num"`
+	Account  eos.AccountName   `json:"account"`
+	Table    eos.TableName     `json:"table"`
+	Rows     []json.RawMessage `json:"rows"`
+}
+
+func (c *DefaultClient) ListTableRows(ctx context.Context, account, scope, table string, opts ...RequestOption) (*TableRowsResponse, error) {
+	val := tableValues(account, scope, table, opts)
+
+	var response *TableRowsResponse
+	if err := c.getJSON(ctx, "/v0/state/table", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to list table rows")
+	}
+
+	return response, nil
+}
+
+// StreamTableRows is `ListTableRows` calling `onRow` for each row as it's decoded
+// from the response instead of accumulating them, so very large tables can be read
+// in constant memory. The returned response holds everything but the rows. When
+// `onRow` returns an error, reading stops and the error is returned as is.
+func (c *DefaultClient) StreamTableRows(ctx context.Context, account, scope, table string, onRow func(row *TableRow) error, opts ...RequestOption) (*TableRowsResponse, error) {
+	val := tableValues(account, scope, table, opts)
+
+	resp, err := c.performRequest(ctx, "GET", "/v0/state/table", val, nil)
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to stream table rows")
+	}
+	defer resp.Body.Close()
+
+	response := &TableRowsResponse{}
+	if err := decodeTableRowsStream(resp.Body, response, onRow); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (c *DefaultClient) GetTableRow(ctx context.Context, account, scope, table, primaryKey string, opts ...RequestOption) (*TableRowResponse, error) {
+	val := tableValues(account, scope, table, opts)
+	val.Set("primary_key", primaryKey)
+
+	var response *TableRowResponse
+	if err := c.getJSON(ctx, "/v0/state/table/row", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to get table row")
+	}
+
+	return response, nil
+}
+
+func (c *DefaultClient) GetTableRowHistory(ctx context.Context, account, scope, table, primaryKey string, fromBlock, toBlock uint32, opts ...RequestOption) (*TableRowHistoryResponse, error) {
+	val := tableValues(account, scope, table, opts)
+	val.Set("primary_key", primaryKey)
+	setBlockRange(val, fromBlock, toBlock)
+
+	var response *TableRowHistoryResponse
+	if err := c.getJSON(ctx, "/v0/state/table/row/history", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to get table row history")
+	}
+
+	return response, nil
+}
+
+func (c *DefaultClient) GetTableDiff(ctx context.Context, account, scope, table string, fromBlock, toBlock uint32, opts ...RequestOption) (*TableDiffResponse, error) {
+	val := tableValues(account, scope, table, opts)
+	setBlockRange(val, fromBlock, toBlock)
+
+	var response *TableDiffResponse
+	if err := c.getJSON(ctx, "/v0/state/table/diff", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to get table diff")
+	}
+
+	return response, nil
+}
+
+func (c *DefaultClient) ListTablesRowsForAccounts(ctx context.Context, accounts []string, scope, table string, opts ...RequestOption) (*TablesRowsResponse, error) {
+	val := newRequestOptions(opts).values()
+	val.Set("accounts", strings.Join(accounts, "|"))
+	val.Set("scope", scope)
+	val.Set("table", table)
+
+	var response *TablesRowsResponse
+	if err := c.getJSON(ctx, "/v0/state/tables/accounts", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to list tables rows for accounts")
+	}
+
+	return response, nil
+}
+
+func (c *DefaultClient) ListTablesRowsForScopes(ctx context.Context, account string, scopes []string, table string, opts ...RequestOption) (*TablesRowsResponse, error) {
+	val := newRequestOptions(opts).values()
+	val.Set("account", account)
+	val.Set("scopes", strings.Join(scopes, "|"))
+	val.Set("table", table)
+
+	var response *TablesRowsResponse
+	if err := c.getJSON(ctx, "/v0/state/tables/scopes", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to list tables rows for scopes")
+	}
+
+	return response, nil
+}
+
+// ListTablesRowsBatch reads all the tables at the same block, the tables of the
+// response being in the same order as the requested ones.
+func (c *DefaultClient) ListTablesRowsBatch(ctx context.Context, tables []*BatchTable, opts ...RequestOption) (*TablesRowsResponse, error) {
+	options := newRequestOptions(opts)
+	request := &struct {
+		BlockNum         uint32        `json:"block_num,omitempty"`
+		IrreversibleOnly bool          `json:"irreversible_only,omitempty"`
+		KeyType          string        `json:"key_type,omitempty"`
+		ToJSON           bool          `json:"json,omitempty"`
+		WithABI          bool          `json:"with_abi,omitempty"`
+		WithBlockNum     bool          `json:"with_block_num,omitempty"`
+		Tables           []*BatchTable `json:"tables"`
+	}{
+		BlockNum:         options.blockNum,
+		IrreversibleOnly: options.irreversibleOnly,
+		KeyType:          options.keyType,
+		ToJSON:           options.toJSON,
+		WithABI:          options.withABI,
+		WithBlockNum:     options.withBlockNum,
+		Tables:           tables,
+	}
+
+	var response *TablesRowsResponse
+	if err := c.postJSON(ctx, "/v0/state/tables/batch", request, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to list tables rows batch")
+	}
+
+	return response, nil
+}
+
+func (c *DefaultClient) ListLinkedPermissions(ctx context.Context, account string, opts ...RequestOption) (*LinkedPermissionsResponse, error) {
+	val := newRequestOptions(opts).values()
+	val.Set("account", account)
+
+	var response *LinkedPermissionsResponse
+	if err := c.getJSON(ctx, "/v0/state/permission_links", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to list linked permissions")
+	}
+
+	return response, nil
+}
+
+func (c *DefaultClient) ListPermissions(ctx context.Context, account string, opts ...RequestOption) (*PermissionsResponse, error) {
+	val := newRequestOptions(opts).values()
+	val.Set("account", account)
+
+	var response *PermissionsResponse
+	if err := c.getJSON(ctx, "/v0/state/permissions", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to list permissions")
+	}
+
+	return response, nil
+}
+
+func (c *DefaultClient) GetResources(ctx context.Context, account string, opts ...RequestOption) (*ResourcesResponse, error) {
+	val := newRequestOptions(opts).values()
+	val.Set("account", account)
+
+	var response *ResourcesResponse
+	if err := c.getJSON(ctx, "/v0/state/resources", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to get resources")
+	}
+
+	return response, nil
+}
+
+// GetABIHistory lists every ABI revision of the account up to the requested block
+// (`AtBlockNum`, head block by default), with what changed between revisions when
+// `withDiff` is set.
+func (c *DefaultClient) GetABIHistory(ctx context.Context, account string, withDiff bool, opts ...RequestOption) (*ABIHistoryResponse, error) {
+	val := newRequestOptions(opts).values()
+	val.Set("account", account)
+	if withDiff {
+		val.Set("diff", "true")
+	}
+
+	var response *ABIHistoryResponse
+	if err := c.getJSON(ctx, "/v0/state/abi/history", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to get abi history")
+	}
+
+	return response, nil
+}
+
+// DecodeTableRows decodes the hexadecimal `hexRows` of the table using the contract's
+// ABI active at the requested block (`AtBlockNum`, head block by default).
+func (c *DefaultClient) DecodeTableRows(ctx context.Context, account, table string, hexRows []string, opts ...RequestOption) (*DecodedTableRowsResponse, error) {
+	request := &struct {
+		Account  string   `json:"account"`
+		Table    string   `json:"table"`
+		HexRows  []string `json:"hex_rows"`
+		BlockNum uint32   `json:"block_num,omitempty"`
+	}{
+		Account:  account,
+		Table:    table,
+		HexRows:  hexRows,
+		BlockNum: newRequestOptions(opts).blockNum,
+	}
+
+	var response *DecodedTableRowsResponse
+	if err := c.postJSON(ctx, "/v0/state/abi/bin_to_json", request, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to decode table rows")
+	}
+
+	return response, nil
+}
+
+// getJSON performs the request and decodes the response directly from the HTTP
+// body, without buffering it first.
+func (c *DefaultClient) getJSON(ctx context.Context, path string, form url.Values, response interface{}) error {
+	resp, err := c.performRequest(ctx, "GET", path, form, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return fmt.Errorf("unable to decode response: %s", err)
+	}
+
+	return nil
+}
+
+func (c *DefaultClient) postJSON(ctx context.Context, path string, request interface{}, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("unable to encode request: %s", err)
+	}
+
+	resp, err := c.performRequest(ctx, "POST", path, nil, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return fmt.Errorf("unable to decode response: %s", err)
+	}
+
+	return nil
+}
+
+func tableValues(account, scope, table string, opts []RequestOption) url.Values {
+	val := newRequestOptions(opts).values()
+	val.Set("account", account)
+	val.Set("scope", scope)
+	val.Set("table", table)
+
+	return val
+}
+
+func setBlockRange(val url.Values, fromBlock, toBlock uint32) {
+	val.Set("from_block", strconv.FormatUint(uint64(fromBlock), 10))
+	val.Set("to_block", strconv.FormatUint(uint64(toBlock), 10))
+}
+
+// GetTableStats returns the row count and the payload byte total of the table, the
+// payload of a row being its payer followed by its data.
+func (c *DefaultClient) GetTableStats(ctx context.Context, account, scope, table string, opts ...RequestOption) (*TableStatsResponse, error) {
+	val := newRequestOptions(opts).values()
+	val.Set("account", account)
+	val.Set("scope", scope)
+	val.Set("table", table)
+
+	var response *TableStatsResponse
+	if err := c.getJSON(ctx, "/v0/state/table/stats", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to get table stats")
+	}
+
+	return response, nil
+}
+
+// GetAccountStats returns the stats of every table of the account holding rows,
+// along with their totals.
+func (c *DefaultClient) GetAccountStats(ctx context.Context, account string, opts ...RequestOption) (*AccountStatsResponse, error) {
+	val := newRequestOptions(opts).values()
+	val.Set("account", account)
+
+	var response *AccountStatsResponse
+	if err := c.getJSON(ctx, "/v0/state/account_stats", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to get account stats")
+	}
+
+	return response, nil
+}
+
+// ListRAMPayerRows lists the table rows, across all contracts, the payer pays RAM
+// for, grouped by contract, table and scope. Primary keys are rendered according
+// to `WithKeyType`. With `WithLimit`, rows are paginated, the following page being
+// read with `WithCursor(response.NextCursor)` until it is empty.
+func (c *DefaultClient) ListRAMPayerRows(ctx context.Context, payer string, opts ...RequestOption) (*RAMPayerRowsResponse, error) {
+	val := newRequestOptions(opts).values()
+	val.Set("payer", payer)
+
+	var response *RAMPayerRowsResponse
+	if err := c.getJSON(ctx, "/v0/state/ram_payer_rows", val, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to list ram payer rows")
+	}
+
+	return response, nil
+}
@@ -0,0 +1,98 @@
+package fluxdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// decodeTableRowsStream decodes a table rows response token by token, handing each
+// row to `onRow` as soon as it's decoded, all other fields being decoded in `response`.
+func decodeTableRowsStream(reader io.Reader, response *TableRowsResponse, onRow func(row *TableRow) error) error {
+	decoder := json.NewDecoder(reader)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return fmt.Errorf("unable to read response key: %s", err)
+		}
+
+		key, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("expected response key, got %v", token)
+		}
+
+		switch key {
+		case "rows":
+			// Errors of `onRow` must reach the caller untouched
+			if err := decodeRowsStream(decoder, onRow); err != nil {
+				return err
+			}
+
+			continue
+		case "abi":
+			err = decoder.Decode(&response.ABI)
+		case "up_to_block_id":
+			err = decoder.Decode(&response.UpToBlockID)
+		case "up_to_block_num":
+			err = decoder.Decode(&response.UpToBlockNum)
+		case "last_irreversible_block_id":
+			err = decoder.Decode(&response.LastIrreversibleBlockID)
+		case "last_irreversible_block_num":
+			err = decoder.Decode(&response.LastIrreversibleBlockNum)
+		default:
+			// Unknown fields are skipped, like a regular decode would do
+			err = decoder.Decode(&json.RawMessage{})
+		}
+
+		if err != nil {
+			return fmt.Errorf("unable to decode response field %q: %w", key, err)
+		}
+	}
+
+	return expectDelim(decoder, '}')
+}
+
+func decodeRowsStream(decoder *json.Decoder, onRow func(row *TableRow) error) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("unable to read rows: %s", err)
+	}
+
+	if token == nil {
+		return nil
+	}
+
+	if token != json.Delim('[') {
+		return fmt.Errorf("expected rows array, got %v", token)
+	}
+
+	for decoder.More() {
+		row := &TableRow{}
+		if err := decoder.Decode(row); err != nil {
+			return fmt.Errorf("unable to decode row: %s", err)
+		}
+
+		if err := onRow(row); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(decoder, ']')
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("unable to read response: %s", err)
+	}
+
+	if token != delim {
+		return fmt.Errorf("expected %q in response, got %v", delim, token)
+	}
+
+	return nil
+}
@@ -34,6 +34,70 @@ func (c *TestClient) GetAccountByPubKey(ctx context.Context, startBlock uint32,
 	panic("implement me")
 }
 
+func (c *TestClient) ListTableRows(ctx context.Context, account, scope, table string, opts ...RequestOption) (*TableRowsResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) StreamTableRows(ctx context.Context, account, scope, table string, onRow func(row *TableRow) error, opts ...RequestOption) (*TableRowsResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) GetTableRow(ctx context.Context, account, scope, table, primaryKey string, opts ...RequestOption) (*TableRowResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) GetTableRowHistory(ctx context.Context, account, scope, table, primaryKey string, fromBlock, toBlock uint32, opts ...RequestOption) (*TableRowHistoryResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) GetTableDiff(ctx context.Context, account, scope, table string, fromBlock, toBlock uint32, opts ...RequestOption) (*TableDiffResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) ListTablesRowsForAccounts(ctx context.Context, accounts []string, scope, table string, opts ...RequestOption) (*TablesRowsResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) ListTablesRowsForScopes(ctx context.Context, account string, scopes []string, table string, opts ...RequestOption) (*TablesRowsResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) ListTablesRowsBatch(ctx context.Context, tables []*BatchTable, opts ...RequestOption) (*TablesRowsResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) ListLinkedPermissions(ctx context.Context, account string, opts ...RequestOption) (*LinkedPermissionsResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) ListPermissions(ctx context.Context, account string, opts ...RequestOption) (*PermissionsResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) GetResources(ctx context.Context, account string, opts ...RequestOption) (*ResourcesResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) DecodeTableRows(ctx context.Context, account, table string, hexRows []string, opts ...RequestOption) (*DecodedTableRowsResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) GetABIHistory(ctx context.Context, account string, withDiff bool, opts ...RequestOption) (*ABIHistoryResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) GetTableStats(ctx context.Context, account, scope, table string, opts ...RequestOption) (*TableStatsResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) GetAccountStats(ctx context.Context, account string, opts ...RequestOption) (*AccountStatsResponse, error) {
+	panic("implement me")
+}
+
+func (c *TestClient) ListRAMPayerRows(ctx context.Context, payer string, opts ...RequestOption) (*RAMPayerRowsResponse, error) {
+	panic("implement me")
+}
+
 func NewTestFluxClient() *TestClient {
 	return &TestClient{}
 }
@@ -36,8 +36,28 @@ type Config struct {
 	EnableServerMode   bool   // Enables flux server mode, launch a server
 	EnableInjectMode   bool   // Enables flux inject mode, writes into kvd
 	HTTPListenAddr     string // Address to server FluxDB queries on
+	GRPCListenAddr     string // Address to serve FluxDB streaming gRPC endpoints on
 	EnableDevMode      bool   // Set to true to have a fluxdb not syncing with an actual live block source (**never** use this in prod)
 	BlockStoreURL      string // dbin blocks store
+
+	ABIDecoderCacheSize  int // Number of decoded ABIs kept in memory to decode rows to JSON, 0 disables the cache
+	DecodedRowCacheBytes int // Bytes of rows decoded to JSON kept in memory, 0 disables the cache
+
+	EnableSecondaryIndexReads bool // Serves table reads through secondary indexes, requires blocks from a nodeos emitting `SEC_IDX_OP` deep-mind lines
+
+	SnapshotsStoreURL      string // Store where state snapshots are written to and imported from
+	SnapshotIntervalBlocks uint32 // Export a snapshot each time this many blocks have been written, 0 disables the export
+	EnableSnapshotImport   bool   // Imports the latest snapshot of the snapshots store when the database is empty
+
+	PruneBeforeBlockNum uint32 // When non-zero, runs the pruning of all history before this block then exits instead of running the pipeline
+
+	BackfillRAMPayerRows bool // Runs the backfill of the RAM payer mappings of a database written before they were introduced then exits instead of running the pipeline
+
+	CatchUpShardsStoreURL     string // Store where the catch-up writes its shards, catching up before running the pipeline is disabled when empty
+	CatchUpShardCount         int    // Number of shards, each one being injected by its own worker
+	CatchUpRangeBlockCount    uint32 // Number of blocks in each range sharded by a single worker
+	CatchUpParallelRangeCount int    // Number of ranges sharded in parallel
+	CatchUpStopBlockNum       uint32 // Block up to which to catch up, 0 means up to the last irreversible merged blocks
 }
 
 type App struct {
@@ -62,6 +82,38 @@ func (a *App) Run() error {
 
 	db := fluxdb.New(kvStore)
 
+	if a.config.PruneBeforeBlockNum != 0 {
+		if !kvStore.SupportsDeletions() {
+			return fmt.Errorf("unable to prune history, store %q cannot delete keys", a.config.StoreDSN)
+		}
+
+		zlog.Info("running fluxdb in prune mode", zap.Uint32("prune_before_block_num", a.config.PruneBeforeBlockNum))
+		go func() {
+			err := db.Prune(context.Background(), a.config.PruneBeforeBlockNum)
+			if err != nil {
+				err = fmt.Errorf("unable to prune history: %w", err)
+			}
+
+			a.Shutdown(err)
+		}()
+
+		return nil
+	}
+
+	if a.config.BackfillRAMPayerRows {
+		zlog.Info("running fluxdb in ram payer rows backfill mode")
+		go func() {
+			_, err := db.BackfillRAMPayerRows(context.Background())
+			if err != nil {
+				err = fmt.Errorf("unable to backfill ram payer rows: %w", err)
+			}
+
+			a.Shutdown(err)
+		}()
+
+		return nil
+	}
+
 	zlog.Info("initiating fluxdb pipeline")
 	fluxDBHandler := fluxdb.NewHandler(db)
 
@@ -73,6 +125,26 @@ func (a *App) Run() error {
 		return fmt.Errorf("setting up source blocks store: %w", err)
 	}
 
+	var snapshotter *fluxdb.Snapshotter
+	if a.config.SnapshotsStoreURL != "" {
+		snapshotsStore, err := dstore.NewStore(a.config.SnapshotsStoreURL, "dbin", "zstd", false)
+		if err != nil {
+			return fmt.Errorf("setting up snapshots store: %w", err)
+		}
+
+		snapshotter = fluxdb.NewSnapshotter(snapshotsStore, db, a.config.SnapshotIntervalBlocks)
+	}
+
+	var catchUp *fluxdb.CatchUp
+	if a.config.CatchUpShardsStoreURL != "" && a.config.EnableInjectMode {
+		shardsStore, err := dstore.NewSimpleStore(a.config.CatchUpShardsStoreURL)
+		if err != nil {
+			return fmt.Errorf("setting up catch-up shards store: %w", err)
+		}
+
+		catchUp = fluxdb.NewCatchUp(db, blocksStore, shardsStore, a.config.CatchUpShardCount, a.config.CatchUpRangeBlockCount, a.config.CatchUpParallelRangeCount, a.config.ThreadsNum)
+	}
+
 	db.BuildPipeline(fluxDBHandler.InitializeStartBlockID, fluxDBHandler, a.config.EnableLivePipeline, blocksStore, a.config.BlockStreamAddr, a.config.ThreadsNum)
 
 	a.OnTerminating(func(e error) {
@@ -84,19 +156,85 @@ func (a *App) Run() error {
 	if a.config.EnableInjectMode {
 		zlog.Info("setting up injector mode write")
 		fluxDBHandler.EnableWrites()
+
+		if snapshotter != nil && a.config.SnapshotIntervalBlocks > 0 {
+			zlog.Info("enabling periodic snapshots", zap.Uint32("interval_blocks", a.config.SnapshotIntervalBlocks))
+			fluxDBHandler.EnableSnapshots(snapshotter)
+		}
 	}
 
 	if a.config.EnableServerMode {
 		zlog.Info("setting up server")
 		srv := server.New(a.config.HTTPListenAddr, db)
+		srv.SetDecodingCacheSizes(a.config.ABIDecoderCacheSize, a.config.DecodedRowCacheBytes)
+		if a.config.EnableSecondaryIndexReads {
+			srv.EnableSecondaryIndexReads()
+		}
+
 		go srv.Serve()
+
+		grpcSrv := server.NewGRPC(a.config.GRPCListenAddr, db, fluxDBHandler)
+		go grpcSrv.Serve()
 	} else {
 		zlog.Info("setting injecter mode health check")
 		go startHealthCheckServer(db, a.config.HTTPListenAddr)
 	}
 
-	go db.Launch(a.config.EnableDevMode, a.config.HTTPListenAddr)
+	go func() {
+		// A write interrupted by a crash leaves rows above the last written block, they are
+		// removed before anything is written again
+		if a.config.EnableInjectMode {
+			if _, err := db.RepairPartialWrites(context.Background()); err != nil {
+				db.Shutdown(fmt.Errorf("unable to repair partial writes: %w", err))
+				return
+			}
+		}
+
+		if snapshotter != nil && a.config.EnableSnapshotImport && a.config.EnableInjectMode {
+			if err := importLatestSnapshot(db, snapshotter); err != nil {
+				db.Shutdown(err)
+				return
+			}
+		}
+
+		// Catching up happens before the pipeline starts, which then continues from the caught up block
+		if catchUp != nil {
+			if err := catchUp.Run(context.Background(), a.config.CatchUpStopBlockNum); err != nil {
+				db.Shutdown(fmt.Errorf("unable to catch up: %w", err))
+				return
+			}
+		}
+
+		db.Launch(a.config.EnableDevMode, a.config.HTTPListenAddr)
+	}()
+
+	return nil
+}
+
+func importLatestSnapshot(db *fluxdb.FluxDB, snapshotter *fluxdb.Snapshotter) error {
+	ctx := context.Background()
+
+	lastWrittenBlock, err := db.FetchLastWrittenBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to check if database is empty: %w", err)
+	}
+
+	if lastWrittenBlock.Num() != 0 {
+		zlog.Info("database already contains data, not importing snapshot", zap.Stringer("last_written_block", lastWrittenBlock))
+		return nil
+	}
+
+	block, err := snapshotter.ImportLatest(ctx)
+	if err == fluxdb.ErrNoSnapshot {
+		zlog.Info("no snapshot to import, starting from an empty database")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to import latest snapshot: %w", err)
+	}
 
+	zlog.Info("imported latest snapshot, pipeline will start from it", zap.Stringer("block", block))
 	return nil
 }
 
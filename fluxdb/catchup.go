@@ -0,0 +1,427 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/abourget/llerrgroup"
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/dfuse-io/dstore"
+	"go.uber.org/zap"
+)
+
+// catchUpBlocksBeforeStart is the number of blocks read before the start of a
+// range so the forkable has seen enough blocks to emit them as irreversible.
+const catchUpBlocksBeforeStart = 400
+
+// catchUpIrreversibleMargin is the number of blocks kept between the automatically
+// chosen stop block and the last merged block, so the stop block becomes irreversible
+// within the merged blocks files.
+const catchUpIrreversibleMargin = 1000
+
+// CatchUp brings a database that is behind up to a given block by reprocessing the
+// missing blocks from the merged blocks files. The missing range is split in smaller
+// ranges that are sharded concurrently by `Sharder`s, then each shard is injected by
+// its own worker, shards being independent from each other. Once all shards reached
+// the stop block, the last written block marker is moved so the live pipeline takes
+// over from there.
+//
+// Progress is persisted, sharded ranges are kept in the shards store and each shard
+// records its last written block, so an interrupted catch-up is resumed on next run.
+// The shard count must not change while a catch-up is in progress.
+type CatchUp struct {
+	db          *FluxDB
+	blocksStore dstore.Store
+	shardsStore dstore.Store
+
+	shardCount            int
+	rangeBlockCount       uint32
+	parallelRangeCount    int
+	parallelDownloadCount int
+}
+
+func NewCatchUp(db *FluxDB, blocksStore, shardsStore dstore.Store, shardCount int, rangeBlockCount uint32, parallelRangeCount, parallelDownloadCount int) *CatchUp {
+	return &CatchUp{
+		db:                    db,
+		blocksStore:           blocksStore,
+		shardsStore:           shardsStore,
+		shardCount:            shardCount,
+		rangeBlockCount:       rangeBlockCount,
+		parallelRangeCount:    parallelRangeCount,
+		parallelDownloadCount: parallelDownloadCount,
+	}
+}
+
+// Run catches up the database to `stopBlockNum`. When it's 0, the stop block is
+// chosen from the merged blocks files available and nothing is done if the database
+// is less than a range behind, the live pipeline being better suited for small gaps.
+// An interrupted catch-up is always resumed up to its original stop block.
+func (c *CatchUp) Run(ctx context.Context, stopBlockNum uint32) error {
+	lastWrittenBlock, err := c.db.FetchLastWrittenBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	startBlockNum := uint32(lastWrittenBlock.Num()) + 1
+
+	inProgressStopBlockNum, err := c.fetchInProgressStopBlockNum(ctx)
+	if err != nil {
+		return err
+	}
+
+	if inProgressStopBlockNum > uint32(lastWrittenBlock.Num()) {
+		zlog.Info("resuming interrupted catch-up", zap.Uint32("start_block_num", startBlockNum), zap.Uint32("stop_block_num", inProgressStopBlockNum))
+		stopBlockNum = inProgressStopBlockNum
+
+		if err := c.checkShardsStarted(ctx); err != nil {
+			return err
+		}
+	} else {
+		minimumBlockCount := uint32(0)
+		if stopBlockNum == 0 {
+			stopBlockNum, err = c.lastIrreversibleMergedBlockNum()
+			if err != nil {
+				return derr.Wrap(err, "unable to determine catch-up stop block")
+			}
+
+			minimumBlockCount = c.rangeBlockCount
+		}
+
+		if stopBlockNum < startBlockNum || stopBlockNum-startBlockNum+1 < minimumBlockCount {
+			zlog.Info("database is not behind enough, nothing to catch up", zap.Uint32("start_block_num", startBlockNum), zap.Uint32("stop_block_num", stopBlockNum))
+			return nil
+		}
+
+		zlog.Info("starting catch-up", zap.Uint32("start_block_num", startBlockNum), zap.Uint32("stop_block_num", stopBlockNum), zap.Int("shard_count", c.shardCount))
+		if err := c.start(ctx, lastWrittenBlock, stopBlockNum); err != nil {
+			return err
+		}
+	}
+
+	ranges := splitBlockRange(startBlockNum, stopBlockNum, c.rangeBlockCount)
+	if err := c.shardRanges(ctx, ranges); err != nil {
+		return derr.Wrap(err, "sharding ranges")
+	}
+
+	if err := c.injectShards(ctx, ranges); err != nil {
+		return derr.Wrap(err, "injecting shards")
+	}
+
+	return c.complete(ctx, stopBlockNum)
+}
+
+func (c *CatchUp) fetchInProgressStopBlockNum(ctx context.Context) (uint32, error) {
+	blockNum, err := c.db.fetchBlockNumMarker(ctx, catchUpStopBlockMarkerKey)
+	if err != nil {
+		return 0, derr.Wrap(err, "fetching catch-up stop block marker")
+	}
+
+	return blockNum, nil
+}
+
+// start records the stop block and resets the shards' last written block to the
+// database's one, shards written by an earlier reprocessing being long gone.
+func (c *CatchUp) start(ctx context.Context, lastWrittenBlock bstream.BlockRef, stopBlockNum uint32) error {
+	batch := c.db.store.NewBatch(zlog)
+	for shardIndex := 0; shardIndex < c.shardCount; shardIndex++ {
+		batch.SetLast(shardLastBlockRowKey(shardIndex), []byte(lastWrittenBlock.ID()))
+	}
+
+	batch.SetMarker(catchUpStopBlockMarkerKey, []byte(HexBlockNum(stopBlockNum)))
+	if err := batch.Flush(ctx); err != nil {
+		return derr.Wrap(err, "flushing catch-up markers")
+	}
+
+	return nil
+}
+
+func (c *CatchUp) checkShardsStarted(ctx context.Context) error {
+	for shardIndex := 0; shardIndex < c.shardCount; shardIndex++ {
+		_, err := c.db.store.FetchLastWrittenBlock(ctx, shardLastBlockRowKey(shardIndex))
+		if err == store.ErrNotFound {
+			return fmt.Errorf("shard %d was not part of the interrupted catch-up, the shard count cannot change while resuming", shardIndex)
+		}
+
+		if err != nil {
+			return derr.Wrapf(err, "fetching shard %d last written block", shardIndex)
+		}
+	}
+
+	return nil
+}
+
+func (c *CatchUp) shardRanges(ctx context.Context, ranges []blockRange) error {
+	group := llerrgroup.New(c.parallelRangeCount)
+	for _, blkRange := range ranges {
+		if group.Stop() {
+			break
+		}
+
+		blkRange := blkRange
+		group.Go(func() error {
+			return c.shardRange(ctx, blkRange)
+		})
+	}
+
+	return group.Wait()
+}
+
+func (c *CatchUp) shardRange(ctx context.Context, blkRange blockRange) error {
+	done, err := c.isRangeSharded(ctx, blkRange)
+	if err != nil {
+		return err
+	}
+
+	if done {
+		zlog.Info("range already sharded, skipping", zap.Stringer("range", blkRange))
+		return nil
+	}
+
+	zlog.Info("sharding range", zap.Stringer("range", blkRange))
+	sharder, err := NewSharder(c.shardsStore, c.shardCount, blkRange.start, blkRange.stop)
+	if err != nil {
+		return derr.Wrapf(err, "creating sharder for range %s", blkRange)
+	}
+
+	source := BuildReprocessingPipeline(sharder, c.blocksStore, uint64(blkRange.start), catchUpBlocksBeforeStart, c.parallelDownloadCount)
+
+	source.Run()
+	<-source.Terminating()
+
+	if err := source.Err(); err != nil && !errors.Is(err, ErrCleanSourceStop) {
+		return derr.Wrapf(err, "sharding range %s", blkRange)
+	}
+
+	zlog.Info("range sharded", zap.Stringer("range", blkRange))
+	return nil
+}
+
+// isRangeSharded returns true when the shards of the range don't need to be produced,
+// either because all of them are in the shards store or because all shards were already
+// injected past the range.
+func (c *CatchUp) isRangeSharded(ctx context.Context, blkRange blockRange) (bool, error) {
+	injected := true
+	for shardIndex := 0; shardIndex < c.shardCount; shardIndex++ {
+		shardLastBlock, err := c.db.store.FetchLastWrittenBlock(ctx, shardLastBlockRowKey(shardIndex))
+		if err != nil {
+			return false, derr.Wrapf(err, "fetching shard %d last written block", shardIndex)
+		}
+
+		if uint32(shardLastBlock.Num()) < blkRange.stop {
+			injected = false
+			break
+		}
+	}
+
+	if injected {
+		return true, nil
+	}
+
+	for shardIndex := 0; shardIndex < c.shardCount; shardIndex++ {
+		exists, err := c.shardsStore.FileExists(shardFilename(shardIndex, blkRange.start, blkRange.stop))
+		if err != nil {
+			return false, derr.Wrapf(err, "checking shard %d of range %s", shardIndex, blkRange)
+		}
+
+		if !exists {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (c *CatchUp) injectShards(ctx context.Context, ranges []blockRange) error {
+	group := llerrgroup.New(c.shardCount)
+	for shardIndex := 0; shardIndex < c.shardCount; shardIndex++ {
+		if group.Stop() {
+			break
+		}
+
+		shardIndex := shardIndex
+		group.Go(func() error {
+			return c.injectShard(ctx, shardIndex, ranges)
+		})
+	}
+
+	return group.Wait()
+}
+
+func (c *CatchUp) injectShard(ctx context.Context, shardIndex int, ranges []blockRange) error {
+	shardDB := New(c.db.store)
+	shardDB.SetSharding(shardIndex, c.shardCount)
+
+	if _, err := shardDB.RepairPartialWrites(ctx); err != nil {
+		return derr.Wrapf(err, "repairing shard %d partial writes", shardIndex)
+	}
+
+	for _, blkRange := range ranges {
+		lastWrittenBlock, err := shardDB.FetchLastWrittenBlock(ctx)
+		if err != nil {
+			return err
+		}
+
+		if uint32(lastWrittenBlock.Num()) >= blkRange.stop {
+			continue
+		}
+
+		filename := shardFilename(shardIndex, blkRange.start, blkRange.stop)
+		requests, err := c.readShard(filename)
+		if err != nil {
+			return err
+		}
+
+		// Blocks written before an interruption are skipped, the shard resumes right after them
+		var pendingRequests []*WriteRequest
+		for _, request := range requests {
+			if request.BlockNum > uint32(lastWrittenBlock.Num()) {
+				pendingRequests = append(pendingRequests, request)
+			}
+		}
+
+		if len(pendingRequests) == 0 {
+			continue
+		}
+
+		zlog.Info("injecting shard", zap.String("filename", filename), zap.Int("write_request_count", len(pendingRequests)))
+		if err := shardDB.WriteBatch(ctx, pendingRequests); err != nil {
+			return derr.Wrapf(err, "write batch %q", filename)
+		}
+	}
+
+	return nil
+}
+
+func (c *CatchUp) readShard(filename string) ([]*WriteRequest, error) {
+	reader, err := c.shardsStore.OpenObject(filename)
+	if err != nil {
+		return nil, fmt.Errorf("opening object from shards store %q: %w", filename, err)
+	}
+	defer reader.Close()
+
+	requests, err := readWriteRequestsForBatch(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read all write requests in batch %q: %w", filename, err)
+	}
+
+	return requests, nil
+}
+
+// complete moves the last written block marker to the stop block, which hands over
+// to the live pipeline.
+func (c *CatchUp) complete(ctx context.Context, stopBlockNum uint32) error {
+	verifier := New(c.db.store)
+	verifier.SetSharding(0, c.shardCount)
+	if err := verifier.VerifyAllShardsWritten(); err != nil {
+		return derr.Wrap(err, "verifying all shards written")
+	}
+
+	lastWrittenBlock, err := c.db.FetchLastWrittenBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	if uint32(lastWrittenBlock.Num()) != stopBlockNum {
+		return fmt.Errorf("shards did not all reach stop block %d, last written block is %d", stopBlockNum, lastWrittenBlock.Num())
+	}
+
+	zlog.Info("catch-up completed", zap.Stringer("last_written_block", lastWrittenBlock))
+	return nil
+}
+
+// lastIrreversibleMergedBlockNum finds the last merged blocks file through a binary search
+// on the 100 blocks bundles and returns the block `catchUpIrreversibleMargin` before its end.
+func (c *CatchUp) lastIrreversibleMergedBlockNum() (uint32, error) {
+	bundleExists := func(bundleIndex uint64) (bool, error) {
+		return c.blocksStore.FileExists(fmt.Sprintf("%010d", bundleIndex*100))
+	}
+
+	exists, err := bundleExists(0)
+	if err != nil {
+		return 0, err
+	}
+
+	if !exists {
+		return 0, errors.New("no merged blocks files found")
+	}
+
+	low, high := uint64(0), uint64(1)
+	for {
+		exists, err := bundleExists(high)
+		if err != nil {
+			return 0, err
+		}
+
+		if !exists {
+			break
+		}
+
+		low, high = high, high*2
+	}
+
+	for high-low > 1 {
+		middle := low + (high-low)/2
+		exists, err := bundleExists(middle)
+		if err != nil {
+			return 0, err
+		}
+
+		if exists {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+
+	lastMergedBlockNum := low*100 + 99
+	if lastMergedBlockNum <= catchUpIrreversibleMargin {
+		return 0, nil
+	}
+
+	return uint32(lastMergedBlockNum - catchUpIrreversibleMargin), nil
+}
+
+type blockRange struct {
+	start, stop uint32
+}
+
+func (r blockRange) String() string {
+	return fmt.Sprintf("[%d, %d]", r.start, r.stop)
+}
+
+// splitBlockRange splits the inclusive `[startBlockNum, stopBlockNum]` range in ranges
+// aligned on multiples of `size`, only the first and last ones can be smaller.
+func splitBlockRange(startBlockNum, stopBlockNum, size uint32) (out []blockRange) {
+	for start := startBlockNum; start <= stopBlockNum; {
+		stop := start - start%size + size - 1
+		if stop > stopBlockNum || stop < start {
+			stop = stopBlockNum
+		}
+
+		out = append(out, blockRange{start, stop})
+		if stop == stopBlockNum {
+			break
+		}
+
+		start = stop + 1
+	}
+
+	return
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/dfuse-io/dstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitBlockRange(t *testing.T) {
+	tests := []struct {
+		name                        string
+		startBlockNum, stopBlockNum uint32
+		size                        uint32
+		expected                    []blockRange
+	}{
+		{"single block", 5, 5, 10, []blockRange{{5, 5}}},
+		{"within a range", 2, 8, 10, []blockRange{{2, 8}}},
+		{"aligned", 10, 29, 10, []blockRange{{10, 19}, {20, 29}}},
+		{"unaligned", 5, 25, 10, []blockRange{{5, 9}, {10, 19}, {20, 25}}},
+		{"empty", 6, 5, 10, nil},
+		{"up to max", 4294967290, 4294967295, 10, []blockRange{{4294967290, 4294967295}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, splitBlockRange(test.startBlockNum, test.stopBlockNum, test.size))
+		})
+	}
+}
+
+func TestCatchUpInjectShards(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	dir, err := ioutil.TempDir("", "fluxdb-catch-up")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	shardsStore, err := dstore.NewSimpleStore("file://" + dir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	catchUp := NewCatchUp(db, nil, shardsStore, 2, 10, 1, 1)
+	ranges := splitBlockRange(1, 15, 10)
+
+	for _, blkRange := range ranges {
+		for shardIndex := 0; shardIndex < 2; shardIndex++ {
+			buffer := bytes.NewBuffer(nil)
+			encoder := gob.NewEncoder(buffer)
+			for blockNum := blkRange.start; blockNum <= blkRange.stop; blockNum++ {
+				req := tableDataRows(blockNum, &TableDataRow{uint64(shardIndex), 1, 2, uint64(blockNum), 5, 0, false, []byte{0x01}})
+				req.BlockID, _ = hex.DecodeString(fmt.Sprintf("%08xaa", blockNum))
+				if shardIndex == 0 && blockNum == 1 {
+					req.ABIs = []*ABIRow{{0, blockNum, []byte("abi")}, {1, blockNum, []byte("abi")}}
+				}
+
+				require.NoError(t, encoder.Encode(req))
+			}
+
+			require.NoError(t, shardsStore.WriteObject(shardFilename(shardIndex, blkRange.start, blkRange.stop), buffer))
+		}
+	}
+
+	lastWrittenBlock, err := db.FetchLastWrittenBlock(ctx)
+	require.NoError(t, err)
+	require.NoError(t, catchUp.start(ctx, lastWrittenBlock, 15))
+
+	stopBlockNum, err := catchUp.fetchInProgressStopBlockNum(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(15), stopBlockNum)
+
+	sharded, err := catchUp.isRangeSharded(ctx, ranges[0])
+	require.NoError(t, err)
+	assert.True(t, sharded)
+
+	// Simulates an interruption after shard #1 injected the first range only
+	require.NoError(t, catchUp.injectShard(ctx, 1, ranges[:1]))
+	require.Error(t, catchUp.complete(ctx, 15), "completing before all shards reached the stop block should fail")
+
+	require.NoError(t, catchUp.injectShards(ctx, ranges))
+	require.NoError(t, catchUp.complete(ctx, 15))
+
+	lastWrittenBlock, err = db.FetchLastWrittenBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), lastWrittenBlock.Num())
+
+	for account := uint64(0); account < 2; account++ {
+		resp, err := db.ReadTable(ctx, &ReadTableRequest{Account: account, Scope: 1, Table: 2, BlockNum: 15})
+		require.NoError(t, err)
+		assert.Len(t, resp.Rows, 15)
+	}
+}
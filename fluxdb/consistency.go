@@ -0,0 +1,368 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dfuse-io/derr"
+	eos "github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+// ConsistencyDump is the reference chain state at `BlockNum` that FluxDB is checked
+// against. It stands in for a nodeos state snapshot, each section mirroring the output
+// of a nodeos chain API call made against a node stopped at `BlockNum`, so the dump can
+// be assembled from those responses as is.
+//
+// Only what's in the dump is checked, tables, accounts and contracts that are not part
+// of it are not looked at in FluxDB.
+type ConsistencyDump struct {
+	BlockNum uint32 `json:"block_num"`
+
+	// ABIs are `get_raw_abi` responses
+	ABIs []*ConsistencyDumpABI `json:"abis"`
+
+	// Tables are `get_table_rows` requests (`code`, `scope`, `table`) along with the
+	// `rows` and `more` fields of their response, rows being requested with
+	// `"json": false` and `"show_payer": true`.
+	Tables []*ConsistencyDumpTable `json:"tables"`
+
+	// TableScopes are the rows of `get_table_by_scope` responses
+	TableScopes []*ConsistencyDumpTableScope `json:"table_scopes"`
+
+	// PermissionLinks are the `permission_link_object` entries of a nodeos snapshot
+	PermissionLinks []*ConsistencyDumpPermissionLink `json:"permission_links"`
+}
+
+type ConsistencyDumpABI struct {
+	AccountName string `json:"account_name"`
+	ABI         string `json:"abi"`
+}
+
+type ConsistencyDumpTable struct {
+	Code  string                     `json:"code"`
+	Scope string                     `json:"scope"`
+	Table string                     `json:"table"`
+	Rows  []*ConsistencyDumpTableRow `json:"rows"`
+	More  bool                       `json:"more"`
+}
+
+type ConsistencyDumpTableRow struct {
+	Data  string `json:"data"`
+	Payer string `json:"payer"`
+}
+
+type ConsistencyDumpTableScope struct {
+	Code  string `json:"code"`
+	Scope string `json:"scope"`
+	Table string `json:"table"`
+}
+
+type ConsistencyDumpPermissionLink struct {
+	Account            string `json:"account"`
+	Code               string `json:"code"`
+	MessageType        string `json:"message_type"`
+	RequiredPermission string `json:"required_permission"`
+}
+
+func ReadConsistencyDump(reader io.Reader) (*ConsistencyDump, error) {
+	dump := &ConsistencyDump{}
+	if err := json.NewDecoder(reader).Decode(dump); err != nil {
+		return nil, fmt.Errorf("unable to decode consistency dump: %w", err)
+	}
+
+	return dump, nil
+}
+
+type ConsistencyDifferenceKind string
+
+const (
+	ConsistencyDifferenceKindABI            ConsistencyDifferenceKind = "abi"
+	ConsistencyDifferenceKindTableRow       ConsistencyDifferenceKind = "table_row"
+	ConsistencyDifferenceKindTableScope     ConsistencyDifferenceKind = "table_scope"
+	ConsistencyDifferenceKindPermissionLink ConsistencyDifferenceKind = "permission_link"
+)
+
+// ConsistencyDifference is a single divergence between the dump and FluxDB, `Expected`
+// being the dump's value and `Actual` the FluxDB one. Either is empty when the element
+// is missing on that side.
+type ConsistencyDifference struct {
+	Kind     ConsistencyDifferenceKind `json:"kind"`
+	Location string                    `json:"location"`
+	Expected string                    `json:"expected"`
+	Actual   string                    `json:"actual"`
+}
+
+func (d *ConsistencyDifference) String() string {
+	return fmt.Sprintf("%s %s: expected %q, got %q", d.Kind, d.Location, d.Expected, d.Actual)
+}
+
+type ConsistencyReport struct {
+	BlockNum uint32 `json:"block_num"`
+
+	CheckedABIs                   int `json:"checked_abis"`
+	CheckedTables                 int `json:"checked_tables"`
+	CheckedTableRows              int `json:"checked_table_rows"`
+	CheckedTableScopeTables       int `json:"checked_table_scope_tables"`
+	CheckedPermissionLinkAccounts int `json:"checked_permission_link_accounts"`
+
+	Differences []*ConsistencyDifference `json:"differences"`
+}
+
+func (r *ConsistencyReport) addDifference(kind ConsistencyDifferenceKind, location, expected, actual string) {
+	r.Differences = append(r.Differences, &ConsistencyDifference{kind, location, expected, actual})
+}
+
+// CheckConsistency compares the ABIs, table rows, table scopes and permission links of
+// the dump against what FluxDB returns at the dump's block, reporting every difference
+// found. An error is returned only when the check itself cannot be performed.
+func (fdb *FluxDB) CheckConsistency(ctx context.Context, dump *ConsistencyDump) (*ConsistencyReport, error) {
+	lastWrittenBlock, err := fdb.FetchLastWrittenBlock(ctx)
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to fetch last written block")
+	}
+
+	if uint64(dump.BlockNum) > lastWrittenBlock.Num() {
+		return nil, fmt.Errorf("dump block %d is not written yet, last written block is %d", dump.BlockNum, lastWrittenBlock.Num())
+	}
+
+	report := &ConsistencyReport{BlockNum: dump.BlockNum}
+	zlog.Info("checking consistency", zap.Uint32("block_num", dump.BlockNum))
+
+	if err := fdb.checkABIsConsistency(ctx, dump, report); err != nil {
+		return nil, err
+	}
+
+	if err := fdb.checkTablesConsistency(ctx, dump, report); err != nil {
+		return nil, err
+	}
+
+	if err := fdb.checkTableScopesConsistency(ctx, dump, report); err != nil {
+		return nil, err
+	}
+
+	if err := fdb.checkPermissionLinksConsistency(ctx, dump, report); err != nil {
+		return nil, err
+	}
+
+	zlog.Info("consistency check completed", zap.Uint32("block_num", dump.BlockNum), zap.Int("difference_count", len(report.Differences)))
+	return report, nil
+}
+
+func (fdb *FluxDB) checkABIsConsistency(ctx context.Context, dump *ConsistencyDump, report *ConsistencyReport) error {
+	for _, dumpABI := range dump.ABIs {
+		report.CheckedABIs++
+
+		expected, err := decodeDumpABI(dumpABI.ABI)
+		if err != nil {
+			return fmt.Errorf("invalid abi for account %q in dump: %w", dumpABI.AccountName, err)
+		}
+
+		actual, err := fdb.readConsistencyABI(ctx, dump.BlockNum, dumpABI.AccountName)
+		if err != nil {
+			return err
+		}
+
+		if expected != actual {
+			report.addDifference(ConsistencyDifferenceKindABI, dumpABI.AccountName, expected, actual)
+		}
+	}
+
+	return nil
+}
+
+// readConsistencyABI returns the hex encoded packed ABI of the account, the empty
+// string meaning there is no ABI for it at this block.
+func (fdb *FluxDB) readConsistencyABI(ctx context.Context, blockNum uint32, account string) (string, error) {
+	abi, err := fdb.GetABI(ctx, blockNum, N(account), nil)
+	if err != nil {
+		if derr.ToErrorResponse(ctx, err).Code == "data_abi_not_found_error" {
+			return "", nil
+		}
+
+		return "", derr.Wrapf(err, "unable to get abi for account %q", account)
+	}
+
+	return hex.EncodeToString(abi.PackedABI), nil
+}
+
+func (fdb *FluxDB) checkTablesConsistency(ctx context.Context, dump *ConsistencyDump, report *ConsistencyReport) error {
+	for _, table := range dump.Tables {
+		report.CheckedTables++
+		tableLocation := fmt.Sprintf("%s/%s/%s", table.Code, table.Scope, table.Table)
+
+		resp, err := fdb.ReadTable(ctx, &ReadTableRequest{
+			Account:  N(table.Code),
+			Scope:    EN(table.Scope),
+			Table:    N(table.Table),
+			BlockNum: dump.BlockNum,
+		})
+		if err != nil {
+			if derr.ToErrorResponse(ctx, err).Code != "data_abi_not_found_error" {
+				return derr.Wrapf(err, "unable to read table %s", tableLocation)
+			}
+
+			// Rows can't be read without an ABI, they are all reported as missing
+			resp = &ReadTableResponse{}
+		}
+
+		// The dump holds a single page of rows when `more` is set, only that page is compared
+		actualRows := resp.Rows
+		if table.More && len(actualRows) > len(table.Rows) {
+			actualRows = actualRows[:len(table.Rows)]
+		}
+
+		// Neither nodeos nor the dump give the primary key of rows, they are compared in
+		// primary key order instead.
+		for i := 0; i < len(table.Rows) || i < len(actualRows); i++ {
+			report.CheckedTableRows++
+
+			location := fmt.Sprintf("%s row #%d", tableLocation, i)
+			expected, actual := "", ""
+			if i < len(table.Rows) {
+				expected = fmt.Sprintf("payer=%s data=%s", table.Rows[i].Payer, strings.ToLower(table.Rows[i].Data))
+			}
+
+			if i < len(actualRows) {
+				row := actualRows[i]
+				location = fmt.Sprintf("%s (primary key %d)", location, row.Key)
+				actual = fmt.Sprintf("payer=%s data=%s", eos.NameToString(row.Payer), hex.EncodeToString(row.Data))
+			}
+
+			if expected != actual {
+				report.addDifference(ConsistencyDifferenceKindTableRow, location, expected, actual)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (fdb *FluxDB) checkTableScopesConsistency(ctx context.Context, dump *ConsistencyDump, report *ConsistencyReport) error {
+	type tableID struct{ code, table string }
+
+	expectedScopesByTable := map[tableID]map[string]bool{}
+	var tables []tableID
+	for _, tableScope := range dump.TableScopes {
+		id := tableID{tableScope.Code, tableScope.Table}
+		if _, found := expectedScopesByTable[id]; !found {
+			expectedScopesByTable[id] = map[string]bool{}
+			tables = append(tables, id)
+		}
+
+		expectedScopesByTable[id][tableScope.Scope] = true
+	}
+
+	for _, id := range tables {
+		report.CheckedTableScopeTables++
+
+		scopes, err := fdb.ReadTableScopes(ctx, dump.BlockNum, eos.AccountName(id.code), eos.TableName(id.table), nil)
+		if err != nil {
+			return derr.Wrapf(err, "unable to read table scopes of %s/%s", id.code, id.table)
+		}
+
+		actualScopes := map[string]bool{}
+		for _, scope := range scopes {
+			actualScopes[string(scope)] = true
+		}
+
+		location := fmt.Sprintf("%s/%s", id.code, id.table)
+		for _, scope := range sortedSetKeys(expectedScopesByTable[id]) {
+			if !actualScopes[scope] {
+				report.addDifference(ConsistencyDifferenceKindTableScope, location, scope, "")
+			}
+		}
+
+		for _, scope := range sortedSetKeys(actualScopes) {
+			if !expectedScopesByTable[id][scope] {
+				report.addDifference(ConsistencyDifferenceKindTableScope, location, "", scope)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (fdb *FluxDB) checkPermissionLinksConsistency(ctx context.Context, dump *ConsistencyDump, report *ConsistencyReport) error {
+	expectedLinksByAccount := map[string]map[string]string{}
+	var accounts []string
+	for _, link := range dump.PermissionLinks {
+		if _, found := expectedLinksByAccount[link.Account]; !found {
+			expectedLinksByAccount[link.Account] = map[string]string{}
+			accounts = append(accounts, link.Account)
+		}
+
+		expectedLinksByAccount[link.Account][link.Code+"::"+link.MessageType] = link.RequiredPermission
+	}
+
+	for _, account := range accounts {
+		report.CheckedPermissionLinkAccounts++
+
+		links, err := fdb.ReadLinkedPermissions(ctx, dump.BlockNum, eos.AccountName(account), nil)
+		if err != nil {
+			return derr.Wrapf(err, "unable to read linked permissions of %q", account)
+		}
+
+		actualLinks := map[string]string{}
+		for _, link := range links {
+			actualLinks[link.Contract+"::"+link.Action] = link.PermissionName
+		}
+
+		expectedLinks := expectedLinksByAccount[account]
+		actions := map[string]bool{}
+		for action := range expectedLinks {
+			actions[action] = true
+		}
+		for action := range actualLinks {
+			actions[action] = true
+		}
+
+		for _, action := range sortedSetKeys(actions) {
+			if expectedLinks[action] != actualLinks[action] {
+				report.addDifference(ConsistencyDifferenceKindPermissionLink, account+"@"+action, expectedLinks[action], actualLinks[action])
+			}
+		}
+	}
+
+	return nil
+}
+
+// decodeDumpABI turns the base64 packed ABI of a `get_raw_abi` response into its hex
+// form, nodeos omits the base64 padding so both padded and unpadded forms are accepted.
+func decodeDumpABI(abi string) (string, error) {
+	packedABI, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(abi, "="))
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(packedABI), nil
+}
+
+func sortedSetKeys(set map[string]bool) (out []string) {
+	for key := range set {
+		out = append(out, key)
+	}
+
+	sort.Strings(out)
+	return
+}
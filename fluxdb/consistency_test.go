@@ -0,0 +1,107 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckConsistency(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	ctx := context.Background()
+	token, accounts := N("eosio.token"), N("accounts")
+
+	block := func(req *WriteRequest) *WriteRequest {
+		req.BlockID, _ = hex.DecodeString(fmt.Sprintf("%08xaa", req.BlockNum))
+		return req
+	}
+
+	executeWriteRequests(t, db,
+		block(&WriteRequest{BlockNum: 1, ABIs: []*ABIRow{{token, 1, []byte("abi")}}}),
+		block(&WriteRequest{
+			BlockNum: 2,
+			TableDatas: []*TableDataRow{
+				{token, N("alice"), accounts, 1, N("alice"), 0, false, []byte{0x01}},
+				{token, N("alice"), accounts, 2, N("alice"), 0, false, []byte{0x02}},
+			},
+			TableScopes: []*TableScopeRow{
+				{token, N("alice"), accounts, false, N("alice")},
+				{token, N("carol"), accounts, false, N("carol")},
+			},
+			AuthLinks: []*AuthLinkRow{
+				{false, N("alice"), token, N("transfer"), N("active")},
+				{false, N("alice"), N("eosio"), N("buyram"), N("owner")},
+			},
+		}),
+		block(&WriteRequest{
+			BlockNum:   3,
+			TableDatas: []*TableDataRow{{token, N("alice"), accounts, 2, N("alice"), 0, false, []byte{0xff}}},
+		}),
+	)
+
+	dump, err := ReadConsistencyDump(strings.NewReader(`{
+		"block_num": 2,
+		"abis": [
+			{"account_name": "eosio.token", "abi": "YWJp"},
+			{"account_name": "alice", "abi": "YWJp"}
+		],
+		"tables": [
+			{"code": "eosio.token", "scope": "alice", "table": "accounts", "rows": [
+				{"data": "01", "payer": "alice"},
+				{"data": "ff", "payer": "alice"}
+			], "more": false}
+		],
+		"table_scopes": [
+			{"code": "eosio.token", "scope": "alice", "table": "accounts"},
+			{"code": "eosio.token", "scope": "bob", "table": "accounts"}
+		],
+		"permission_links": [
+			{"account": "alice", "code": "eosio.token", "message_type": "transfer", "required_permission": "active"}
+		]
+	}`))
+	require.NoError(t, err)
+
+	report, err := db.CheckConsistency(ctx, dump)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, report.CheckedABIs)
+	assert.Equal(t, 1, report.CheckedTables)
+	assert.Equal(t, 2, report.CheckedTableRows)
+	assert.Equal(t, 1, report.CheckedTableScopeTables)
+	assert.Equal(t, 1, report.CheckedPermissionLinkAccounts)
+	assert.Equal(t, []*ConsistencyDifference{
+		{ConsistencyDifferenceKindABI, "alice", "616269", ""},
+		{ConsistencyDifferenceKindTableRow, "eosio.token/alice/accounts row #1 (primary key 2)", "payer=alice data=ff", "payer=alice data=02"},
+		{ConsistencyDifferenceKindTableScope, "eosio.token/accounts", "bob", ""},
+		{ConsistencyDifferenceKindTableScope, "eosio.token/accounts", "", "carol"},
+		{ConsistencyDifferenceKindPermissionLink, "alice@eosio::buyram", "", "owner"},
+	}, report.Differences)
+
+	report, err = db.CheckConsistency(ctx, &ConsistencyDump{BlockNum: 3, Tables: dump.Tables})
+	require.NoError(t, err)
+	assert.Empty(t, report.Differences)
+
+	_, err = db.CheckConsistency(ctx, &ConsistencyDump{BlockNum: 4})
+	assert.EqualError(t, err, "dump block 4 is not written yet, last written block is 3")
+}
@@ -14,4 +14,32 @@
 
 package fluxdb
 
+import "fmt"
+
 const lastBlockRowKey = "block"
+
+// prunedBlockMarkerKey is the marker holding the block before which the history
+// of the rows was pruned.
+const prunedBlockMarkerKey = "pruned"
+
+// ramPayerStartBlockMarkerKey is the marker holding the block from which the RAM payer
+// mappings cover all table rows. It's written along the first block of an empty database,
+// or once a backfill completes, see `BackfillRAMPayerRows`.
+const ramPayerStartBlockMarkerKey = "ram-payer-start"
+
+// catchUpStopBlockMarkerKey is the marker holding the block up to which a catch-up
+// is running. It's kept once the catch-up completes, a catch-up is in progress only
+// while it's above the last written block.
+const catchUpStopBlockMarkerKey = "catch-up-stop"
+
+// shardLastBlockRowKey is the key, in the same table as the last written block,
+// holding the last block written by shard `shardIndex` while reprocessing.
+func shardLastBlockRowKey(shardIndex int) string {
+	return fmt.Sprintf("shard-%03d", shardIndex)
+}
+
+// pendingWriteMarkerKey is the marker holding the blocks and tables of the batch
+// being written after `lastBlockKey`, see `RepairPartialWrites`.
+func pendingWriteMarkerKey(lastBlockKey string) string {
+	return "pending-" + lastBlockKey
+}
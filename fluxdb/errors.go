@@ -38,6 +38,20 @@ func AppBlockNumHigherThanLIBError(ctx context.Context, chosenBlockNum, lastWrit
 	)
 }
 
+func AppBlockNumPrunedError(ctx context.Context, chosenBlockNum, prunedBlockNum uint32) *derr.ErrorResponse {
+	return derr.HTTPBadRequestError(ctx, nil, derr.C("app_block_num_pruned_error"), "The requested block num is older than the retained history, it has been pruned.",
+		"request_block_num", chosenBlockNum,
+		"first_retained_block_num", prunedBlockNum,
+	)
+}
+
+func AppRAMPayerRowsUnavailableError(ctx context.Context, chosenBlockNum, startBlockNum uint32) *derr.ErrorResponse {
+	return derr.HTTPBadRequestError(ctx, nil, derr.C("app_ram_payer_rows_unavailable_error"), "The RAM payer mappings do not cover all rows at the requested block num, they are only complete from the RAM payer start block on (none when 0, the database must be backfilled).",
+		"request_block_num", chosenBlockNum,
+		"ram_payer_start_block_num", startBlockNum,
+	)
+}
+
 // Data Errors
 
 func DataABINotFoundError(ctx context.Context, account string, blockNum uint32) *derr.ErrorResponse {
@@ -40,6 +40,8 @@ type FluxDB struct {
 	shardCount int
 	stopBlock  uint32
 
+	ramPayerStartChecked bool
+
 	ready bool
 }
 
@@ -16,6 +16,7 @@ package fluxdb
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"sort"
 	"strconv"
@@ -82,6 +83,8 @@ func (fdb *FluxDB) IndexTables(ctx context.Context) error {
 
 		zlog.Debug("reading table rows", zap.String("first_row_key", firstRowKey), zap.String("last_row_key", lastRowKey))
 
+		stats := newIndexStatsUpdater(fdb, tableKey, index)
+
 		count := 0
 		err := fdb.store.ScanTabletRows(ctx, firstRowKey, lastRowKey, func(key string, value []byte) error {
 			_, blockNum, primKey, err := explodeWritableRowKey(key)
@@ -90,12 +93,7 @@ func (fdb *FluxDB) IndexTables(ctx context.Context) error {
 			}
 
 			count++
-
-			if len(value) == 0 {
-				delete(index.Map, primKey)
-			} else {
-				index.Map[primKey] = blockNum
-			}
+			stats.apply(blockNum, primKey, value)
 
 			return nil
 		})
@@ -104,6 +102,10 @@ func (fdb *FluxDB) IndexTables(ctx context.Context) error {
 			return derr.Wrap(err, "read rows")
 		}
 
+		if err := stats.finish(ctx); err != nil {
+			return derr.Wrap(err, "update index stats")
+		}
+
 		index.AtBlockNum = blockNum
 		index.Squelched = uint32(count)
 
@@ -111,7 +113,8 @@ func (fdb *FluxDB) IndexTables(ctx context.Context) error {
 			zap.String("table_key", tableKey),
 			zap.Uint32("at_block_num", index.AtBlockNum),
 			zap.Uint32("squelched_count", index.Squelched),
-			zap.Int("row_count", len(index.Map)),
+			zap.Uint32("row_count", index.RowCount),
+			zap.Uint64("payload_byte_count", index.PayloadByteCount),
 		)
 
 		snapshot, err := index.MarshalBinary(ctx, tableKey)
@@ -245,6 +248,12 @@ type TableIndex struct {
 	AtBlockNum uint32
 	Squelched  uint32
 	Map        map[string]uint32 // Map[primaryKey] => blockNum
+
+	// RowCount and PayloadByteCount are the number of rows in the table and the
+	// sum of their stored value sizes. Indexes written before those were tracked
+	// have them at 0, see `hasStats`.
+	RowCount         uint32
+	PayloadByteCount uint64
 }
 
 func NewTableIndex() *TableIndex {
@@ -263,7 +272,7 @@ func NewTableIndexFromBinary(ctx context.Context, tableKey string, atBlockNum ui
 	// Byte count for primary key + 4 bytes for block num value
 	entryByteCount := primaryKeyByteCount + 4
 
-	// First 16 bytes are reserved to keep stats in there..
+	// First 16 bytes keep the stats: squelched count, row count and payload byte count
 	byteCount := len(buffer)
 	if (byteCount-16) < 0 || (byteCount-16)%entryByteCount != 0 {
 		return nil, fmt.Errorf("unable to unmarshal table index: %d bytes alignment + 16 bytes metadata is off (has %d bytes)", entryByteCount, byteCount)
@@ -286,12 +295,21 @@ func NewTableIndexFromBinary(ctx context.Context, tableKey string, atBlockNum ui
 	}
 
 	return &TableIndex{
-		AtBlockNum: atBlockNum,
-		Squelched:  big.Uint32(buffer[:4]),
-		Map:        mapping,
+		AtBlockNum:       atBlockNum,
+		Squelched:        big.Uint32(buffer[:4]),
+		Map:              mapping,
+		RowCount:         big.Uint32(buffer[4:8]),
+		PayloadByteCount: big.Uint64(buffer[8:16]),
 	}, nil
 }
 
+// hasStats returns whether `RowCount` and `PayloadByteCount` can be trusted, which
+// is not the case for indexes written before stats were tracked, those having a 0
+// row count whatever the number of rows they map.
+func (index *TableIndex) hasStats() bool {
+	return int(index.RowCount) == len(index.Map)
+}
+
 func (index *TableIndex) MarshalBinary(ctx context.Context, tableKey string) ([]byte, error) {
 	ctx, span := dtracing.StartSpan(ctx, "marshal table index to binary", "table_key", tableKey)
 	defer span.End()
@@ -310,6 +328,8 @@ func (index *TableIndex) MarshalBinary(ctx context.Context, tableKey string) ([]
 
 	snapshot := make([]byte, entryByteCount*len(index.Map)+16)
 	big.PutUint32(snapshot, index.Squelched)
+	big.PutUint32(snapshot[4:], index.RowCount)
+	big.PutUint64(snapshot[8:], index.PayloadByteCount)
 
 	pos := 16
 	for primaryKey, blockNum := range index.Map {
@@ -331,6 +351,8 @@ func (index *TableIndex) String() string {
 
 	fmt.Fprintln(builder, "  * At block num:", index.AtBlockNum)
 	fmt.Fprintln(builder, "  * Squelches:", index.Squelched)
+	fmt.Fprintln(builder, "  * Row count:", index.RowCount)
+	fmt.Fprintln(builder, "  * Payload byte count:", index.PayloadByteCount)
 	var keys []string
 	for primKey := range index.Map {
 		keys = append(keys, primKey)
@@ -353,6 +375,8 @@ func indexPrimaryKeyByteCountByTableKey(tableKey string) int {
 	switch {
 	case strings.HasPrefix(tableKey, "al:"):
 		return 16
+	case strings.HasPrefix(tableKey, "ap:"):
+		return 8
 	case strings.HasPrefix(tableKey, "arl:"):
 		return 1
 	// Block resource limit has no fields after prefix, so we must match without the :
@@ -360,6 +384,10 @@ func indexPrimaryKeyByteCountByTableKey(tableKey string) int {
 		return 1
 	case strings.HasPrefix(tableKey, "ka2:"):
 		return 16
+	case strings.HasPrefix(tableKey, "rp:"):
+		return 32
+	case strings.HasPrefix(tableKey, "si:"):
+		return secondaryIndexEntryKeyByteCount
 	case strings.HasPrefix(tableKey, "td:"):
 		return 8
 	case strings.HasPrefix(tableKey, "ts:"):
@@ -373,6 +401,8 @@ func indexPrimaryKeyReaderByTableKey(tableKey string) indexPrimaryKeyReader {
 	switch {
 	case strings.HasPrefix(tableKey, "al:"):
 		return authLinkIndexPrimaryKeyReader
+	case strings.HasPrefix(tableKey, "ap:"):
+		return accountPermissionIndexPrimaryKeyReader
 	case strings.HasPrefix(tableKey, "arl:"):
 		return accountResourceLimitIndexPrimaryKeyReader
 	// Block resource limit has no fields after prefix, so we must match without the :
@@ -380,6 +410,10 @@ func indexPrimaryKeyReaderByTableKey(tableKey string) indexPrimaryKeyReader {
 		return blockResourceLimitIndexPrimaryKeyReader
 	case strings.HasPrefix(tableKey, "ka2:"):
 		return keyAccountIndexPrimaryKeyReader
+	case strings.HasPrefix(tableKey, "rp:"):
+		return ramPayerIndexPrimaryKeyReader
+	case strings.HasPrefix(tableKey, "si:"):
+		return secondaryIndexIndexPrimaryKeyReader
 	case strings.HasPrefix(tableKey, "td:"):
 		return tableDataIndexPrimaryKeyReader
 	case strings.HasPrefix(tableKey, "ts:"):
@@ -393,6 +427,8 @@ func indexPrimaryKeyWriterByTableKey(tableKey string) indexPrimaryKeyWriter {
 	switch {
 	case strings.HasPrefix(tableKey, "al:"):
 		return authLinkIndexPrimaryKeyWriter
+	case strings.HasPrefix(tableKey, "ap:"):
+		return accountPermissionIndexPrimaryKeyWriter
 	case strings.HasPrefix(tableKey, "arl:"):
 		return accountResourceLimitIndexPrimaryKeyWriter
 	// Block resource limit has no fields after prefix, so we must match without the :
@@ -400,6 +436,10 @@ func indexPrimaryKeyWriterByTableKey(tableKey string) indexPrimaryKeyWriter {
 		return blockResourceLimitIndexPrimaryKeyWriter
 	case strings.HasPrefix(tableKey, "ka2:"):
 		return keyAccountIndexPrimaryKeyWriter
+	case strings.HasPrefix(tableKey, "rp:"):
+		return ramPayerIndexPrimaryKeyWriter
+	case strings.HasPrefix(tableKey, "si:"):
+		return secondaryIndexIndexPrimaryKeyWriter
 	case strings.HasPrefix(tableKey, "td:"):
 		return tableDataIndexPrimaryKeyWriter
 	case strings.HasPrefix(tableKey, "ts:"):
@@ -410,9 +450,12 @@ func indexPrimaryKeyWriterByTableKey(tableKey string) indexPrimaryKeyWriter {
 }
 
 var authLinkIndexPrimaryKeyReader = twoUint64PrimaryKeyReaderFactory("auth link")
+var accountPermissionIndexPrimaryKeyReader = oneUint64PrimaryKeyReaderFactory("account permission")
 var accountResourceLimitIndexPrimaryKeyReader = oneBytePrimaryKeyReaderFactory("account resource limit")
 var blockResourceLimitIndexPrimaryKeyReader = oneBytePrimaryKeyReaderFactory("block resource limit")
 var keyAccountIndexPrimaryKeyReader = twoUint64PrimaryKeyReaderFactory("key account")
+var ramPayerIndexPrimaryKeyReader = fourUint64PrimaryKeyReaderFactory("ram payer")
+var secondaryIndexIndexPrimaryKeyReader = bytesPrimaryKeyReaderFactory("secondary index", secondaryIndexEntryKeyByteCount)
 var tableDataIndexPrimaryKeyReader = oneUint64PrimaryKeyReaderFactory("table data")
 var tableScopeIndexPrimaryKeyReader = oneUint64PrimaryKeyReaderFactory("table scope")
 
@@ -457,6 +500,36 @@ func twoUint64PrimaryKeyReaderFactory(tag string) indexPrimaryKeyReader {
 	}
 }
 
+func fourUint64PrimaryKeyReaderFactory(tag string) indexPrimaryKeyReader {
+	return func(buffer []byte) (string, error) {
+		if len(buffer) < 32 {
+			return "", fmt.Errorf("%s primary key reader: not enough bytes to read, %d bytes left, wants %d", tag, len(buffer), 32)
+		}
+
+		chunks := make([]string, 4)
+		for i := range chunks {
+			chunk, err := readOneUint64(buffer[i*8:])
+			if err != nil {
+				return "", derr.Wrapf(err, "%s primary key reader, chunk #%d", tag, i+1)
+			}
+
+			chunks[i] = chunk
+		}
+
+		return strings.Join(chunks, ":"), nil
+	}
+}
+
+func bytesPrimaryKeyReaderFactory(tag string, byteCount int) indexPrimaryKeyReader {
+	return func(buffer []byte) (string, error) {
+		if len(buffer) < byteCount {
+			return "", fmt.Errorf("%s primary key reader: not enough bytes to read, %d bytes left, wants %d", tag, len(buffer), byteCount)
+		}
+
+		return hex.EncodeToString(buffer[:byteCount]), nil
+	}
+}
+
 func readOneUint64(buffer []byte) (string, error) {
 	if len(buffer) < 8 {
 		return "", fmt.Errorf("not enough bytes to read uint64, %d bytes left, wants %d", len(buffer), 8)
@@ -466,9 +539,12 @@ func readOneUint64(buffer []byte) (string, error) {
 }
 
 var authLinkIndexPrimaryKeyWriter = twoUint64PrimaryKeyWriterFactory("auth link")
+var accountPermissionIndexPrimaryKeyWriter = oneUint64PrimaryKeyWriterFactory("account permission")
 var accountResourceLimitIndexPrimaryKeyWriter = oneBytePrimaryKeyWriterFactory("account resource limit")
 var blockResourceLimitIndexPrimaryKeyWriter = oneBytePrimaryKeyWriterFactory("block resource limit")
 var keyAccountIndexPrimaryKeyWriter = twoUint64PrimaryKeyWriterFactory("key account")
+var ramPayerIndexPrimaryKeyWriter = fourUint64PrimaryKeyWriterFactory("ram payer")
+var secondaryIndexIndexPrimaryKeyWriter = bytesPrimaryKeyWriterFactory("secondary index", secondaryIndexEntryKeyByteCount)
 var tableDataIndexPrimaryKeyWriter = oneUint64PrimaryKeyWriterFactory("table data")
 var tableScopeIndexPrimaryKeyWriter = oneUint64PrimaryKeyWriterFactory("table scope")
 
@@ -517,6 +593,39 @@ func twoUint64PrimaryKeyWriterFactory(tag string) indexPrimaryKeyWriter {
 	}
 }
 
+func fourUint64PrimaryKeyWriterFactory(tag string) indexPrimaryKeyWriter {
+	return func(primaryKey string, buffer []byte) error {
+		chunks := strings.Split(primaryKey, ":")
+		if len(chunks) != 4 {
+			return fmt.Errorf("%s primary key should have 4 chunks, got %d", tag, len(chunks))
+		}
+
+		for i, chunk := range chunks {
+			if err := writeOneUint64(chunk, buffer[i*8:]); err != nil {
+				return derr.Wrapf(err, "%s primary key writer, chunk #%d", tag, i+1)
+			}
+		}
+
+		return nil
+	}
+}
+
+func bytesPrimaryKeyWriterFactory(tag string, byteCount int) indexPrimaryKeyWriter {
+	return func(primaryKey string, buffer []byte) error {
+		value, err := hex.DecodeString(primaryKey)
+		if err != nil {
+			return derr.Wrapf(err, "%s primary key writer: unable to decode primary key", tag)
+		}
+
+		if len(value) != byteCount {
+			return fmt.Errorf("%s primary key should have %d bytes, got %d", tag, byteCount, len(value))
+		}
+
+		copy(buffer, value)
+		return nil
+	}
+}
+
 func writeOneUint64(primaryKey string, buffer []byte) error {
 	value, err := strconv.ParseUint(primaryKey, 16, 64)
 	if err != nil {
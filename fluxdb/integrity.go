@@ -0,0 +1,277 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package fluxdb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"go.uber.org/zap"
+)
+
+// pendingWrite describes a batch of blocks being written, enough to find back all the
+// rows and ABIs it wrote without reading the blocks again.
+type pendingWrite struct {
+	startBlockNum uint32
+	endBlockNum   uint32
+	tableKeys     []string
+	abiAccounts   []uint64
+}
+
+func newPendingWrite(requests []*WriteRequest) *pendingWrite {
+	tableKeys := map[string]bool{}
+	abiAccounts := map[uint64]bool{}
+	for _, request := range requests {
+		for _, row := range request.AllWritableRows() {
+			tableKeys[row.tableKey()] = true
+		}
+
+		for _, row := range request.ramPayerRows() {
+			tableKeys[row.tableKey()] = true
+		}
+
+		for _, abi := range request.ABIs {
+			abiAccounts[abi.Account] = true
+		}
+	}
+
+	out := &pendingWrite{
+		startBlockNum: requests[0].BlockNum,
+		endBlockNum:   requests[len(requests)-1].BlockNum,
+	}
+
+	for tableKey := range tableKeys {
+		out.tableKeys = append(out.tableKeys, tableKey)
+	}
+
+	for account := range abiAccounts {
+		out.abiAccounts = append(out.abiAccounts, account)
+	}
+
+	sort.Strings(out.tableKeys)
+	sort.Slice(out.abiAccounts, func(i, j int) bool { return out.abiAccounts[i] < out.abiAccounts[j] })
+
+	return out
+}
+
+// marshal encodes the pending write as `<startBlockNum><endBlockNum>` followed by one line
+// per table key, then one `abi:<account>` line per account. It starts with the start block
+// so the value reads as a block reference, like the other keys of the last written block table.
+func (p *pendingWrite) marshal() []byte {
+	lines := []string{HexBlockNum(p.startBlockNum) + HexBlockNum(p.endBlockNum)}
+	lines = append(lines, p.tableKeys...)
+	for _, account := range p.abiAccounts {
+		lines = append(lines, "abi:"+HexName(account))
+	}
+
+	return []byte(strings.Join(lines, "\n"))
+}
+
+func unmarshalPendingWrite(value string) (*pendingWrite, error) {
+	lines := strings.Split(value, "\n")
+	if len(lines[0]) != 16 {
+		return nil, fmt.Errorf("pending write block range %q should have length of 16", lines[0])
+	}
+
+	startBlockNum, err := keyChunkToBlockNum(lines[0][0:8])
+	if err != nil {
+		return nil, fmt.Errorf("start block: %w", err)
+	}
+
+	endBlockNum, err := keyChunkToBlockNum(lines[0][8:16])
+	if err != nil {
+		return nil, fmt.Errorf("end block: %w", err)
+	}
+
+	out := &pendingWrite{startBlockNum: startBlockNum, endBlockNum: endBlockNum}
+	for _, line := range lines[1:] {
+		if !strings.HasPrefix(line, "abi:") {
+			out.tableKeys = append(out.tableKeys, line)
+			continue
+		}
+
+		account, valid := chunkKeyUint64(line, 1)
+		if !valid {
+			return nil, fmt.Errorf("pending write abi account %q is not a valid hex name", line)
+		}
+
+		out.abiAccounts = append(out.abiAccounts, account)
+	}
+
+	return out, nil
+}
+
+func (fdb *FluxDB) writePendingWrite(ctx context.Context, pending *pendingWrite) error {
+	batch := fdb.store.NewBatch(zlog)
+	batch.SetMarker(pendingWriteMarkerKey(fdb.lastBlockKey()), pending.marshal())
+
+	return batch.Flush(ctx)
+}
+
+func (fdb *FluxDB) fetchPendingWrite(ctx context.Context) (*pendingWrite, error) {
+	marker, err := fdb.store.FetchMarker(ctx, pendingWriteMarkerKey(fdb.lastBlockKey()))
+	if err == store.ErrNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, derr.Wrap(err, "fetching pending write marker")
+	}
+
+	return unmarshalPendingWrite(string(marker))
+}
+
+// RepairPartialWrites removes the rows and ABIs of blocks above the last written block,
+// left behind when the process stopped in the middle of a `WriteBatch`. The last written
+// block only moves once all rows of a batch are committed, so those rows are never read
+// at or below it, but they are removed before the blocks are written again.
+//
+// When the store cannot delete keys, they are left in place instead. The blocks written
+// are irreversible, writing them again writes the same rows and ABIs under the same keys,
+// overwriting each of the ones left behind. Until then, only reads above the last written
+// block reach them, along with the speculative writes of the same blocks holding the same
+// rows.
+//
+// It must be called before writing, while no other process writes to the same shard.
+func (fdb *FluxDB) RepairPartialWrites(ctx context.Context) (removedCount int, err error) {
+	pending, err := fdb.fetchPendingWrite(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	lastWrittenBlock, err := fdb.FetchLastWrittenBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	lastBlockNum := uint32(lastWrittenBlock.Num())
+	if pending == nil || pending.endBlockNum <= lastBlockNum {
+		zlog.Debug("no partial write to repair", zap.Stringer("last_written_block", lastWrittenBlock))
+		return 0, nil
+	}
+
+	startBlockNum := pending.startBlockNum
+	if startBlockNum <= lastBlockNum {
+		startBlockNum = lastBlockNum + 1
+	}
+
+	if !fdb.store.SupportsDeletions() {
+		zlog.Info("store cannot delete keys, leaving partial write above last written block to be overwritten when its blocks are written again",
+			zap.Stringer("last_written_block", lastWrittenBlock),
+			zap.Uint32("start_block_num", startBlockNum),
+			zap.Uint32("end_block_num", pending.endBlockNum),
+		)
+		return 0, nil
+	}
+
+	zlog.Info("repairing partial write above last written block",
+		zap.Stringer("last_written_block", lastWrittenBlock),
+		zap.Uint32("start_block_num", startBlockNum),
+		zap.Uint32("end_block_num", pending.endBlockNum),
+		zap.Int("table_count", len(pending.tableKeys)),
+		zap.Int("abi_account_count", len(pending.abiAccounts)),
+	)
+
+	batch := fdb.store.NewBatch(zlog)
+	for _, tableKey := range pending.tableKeys {
+		keys, err := fdb.partialWriteRowKeys(ctx, tableKey, startBlockNum, pending.endBlockNum)
+		if err != nil {
+			return 0, derr.Wrapf(err, "scanning table %q", tableKey)
+		}
+
+		for _, key := range keys {
+			batch.DeleteRow(key)
+			if err := batch.FlushIfFull(ctx); err != nil {
+				return 0, derr.Wrap(err, "flushing if full")
+			}
+		}
+
+		removedCount += len(keys)
+	}
+
+	for _, account := range pending.abiAccounts {
+		var keys []string
+		keyStart := HexName(account) + ":" + HexRevBlockNum(pending.endBlockNum)
+		keyEnd := HexName(account) + ":" + HexRevBlockNum(startBlockNum-1)
+		err := fdb.store.ScanABIs(ctx, keyStart, keyEnd, func(key string, _ []byte) error {
+			keys = append(keys, key)
+			return nil
+		})
+		if err != nil {
+			return 0, derr.Wrapf(err, "scanning abis of account %q", NameToString(account))
+		}
+
+		for _, key := range keys {
+			batch.DeleteABI(key)
+			if err := batch.FlushIfFull(ctx); err != nil {
+				return 0, derr.Wrap(err, "flushing if full")
+			}
+		}
+
+		removedCount += len(keys)
+	}
+
+	if err := batch.Flush(ctx); err != nil {
+		return 0, derr.Wrap(err, "final flush")
+	}
+
+	zlog.Info("repaired partial write", zap.Int("removed_count", removedCount))
+	return removedCount, nil
+}
+
+// partialWriteRowKeys returns the keys of the rows of `tableKey` written between
+// `startBlockNum` and `endBlockNum` inclusively.
+//
+// RAM payer tables are shared by all shards, so when sharding, only the mappings of
+// the contract tables going to this shard are returned.
+func (fdb *FluxDB) partialWriteRowKeys(ctx context.Context, tableKey string, startBlockNum, endBlockNum uint32) (out []string, err error) {
+	keyStart := tableKey + ":" + HexBlockNum(startBlockNum)
+	keyEnd := tableKey + ":" + HexBlockNum(endBlockNum+1)
+	err = fdb.store.ScanTabletRows(ctx, keyStart, keyEnd, func(key string, _ []byte) error {
+		if fdb.IsSharding() && strings.HasPrefix(tableKey, "rp:") {
+			inShard, err := fdb.isRAMPayerRowInShard(key)
+			if err != nil {
+				return err
+			}
+
+			if !inShard {
+				return nil
+			}
+		}
+
+		out = append(out, key)
+		return nil
+	})
+
+	return
+}
+
+func (fdb *FluxDB) isRAMPayerRowInShard(key string) (bool, error) {
+	_, _, primaryKey, err := explodeWritableRowKey(key)
+	if err != nil {
+		return false, fmt.Errorf("couldn't parse row key %q: %w", key, err)
+	}
+
+	row, err := ramPayerRowFromPrimaryKey(0, primaryKey)
+	if err != nil {
+		return false, err
+	}
+
+	tableKey := (&TableDataRow{Account: row.Account, Table: row.Table, Scope: row.Scope}).tableKey()
+	return tableKeyShard(tableKey, uint32(fdb.shardCount)) == uint32(fdb.shardIndex), nil
+}
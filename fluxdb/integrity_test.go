@@ -0,0 +1,337 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package fluxdb
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPendingWriteMarshal(t *testing.T) {
+	pending := newPendingWrite([]*WriteRequest{
+		{BlockNum: 4, ABIs: []*ABIRow{{N("eosio"), 4, []byte("abi")}}, TableDatas: []*TableDataRow{{N("eosio"), N("eosio"), N("global"), 1, N("eosio"), 0, false, []byte{0x01}}}},
+		{BlockNum: 5, TableDatas: []*TableDataRow{{N("eosio"), N("eosio"), N("global"), 1, 0, 0, true, nil}}},
+	})
+
+	assert.Equal(t, uint32(4), pending.startBlockNum)
+	assert.Equal(t, uint32(5), pending.endBlockNum)
+	assert.Equal(t, []string{
+		"rp:" + HexName(N("eosio")),
+		"td:" + HexName(N("eosio")) + ":" + HexName(N("global")) + ":" + HexName(N("eosio")),
+	}, pending.tableKeys)
+	assert.Equal(t, []uint64{N("eosio")}, pending.abiAccounts)
+
+	actual, err := unmarshalPendingWrite(string(pending.marshal()))
+	require.NoError(t, err)
+	assert.Equal(t, pending, actual)
+
+	_, err = unmarshalPendingWrite("0000000400000005")
+	require.NoError(t, err)
+
+	_, err = unmarshalPendingWrite("00000004")
+	assert.Error(t, err)
+}
+
+// TestWriteBatchCrashRecovery crashes a write batch after each of its store mutations
+// in turn, then checks nothing of the crashed batch is visible, that the integrity
+// scan removes all of it, when the store can delete keys, and that the blocks can be
+// written again, leading to the same stored rows and ABIs as without crashing.
+func TestWriteBatchCrashRecovery(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		testWriteBatchCrashRecovery(t, newTestMemoryKVStore)
+	})
+
+	t.Run("badger", func(t *testing.T) {
+		testWriteBatchCrashRecovery(t, newTestBadgerKVStore)
+	})
+}
+
+func testWriteBatchCrashRecovery(t *testing.T, newKVStore func(t *testing.T) (store.KVStore, func())) {
+	ctx := context.Background()
+	account, scope, table := N("eosio.token"), N("alice"), N("accounts")
+	payer, otherPayer := N("alice"), N("bob")
+
+	block := func(blockNum uint32, abi []byte, rows ...*TableDataRow) *WriteRequest {
+		req := tableDataRows(blockNum, rows...)
+		req.BlockID, _ = hex.DecodeString(fmt.Sprintf("%08xaa", blockNum))
+		if abi != nil {
+			req.ABIs = []*ABIRow{{account, blockNum, abi}}
+		}
+
+		return req
+	}
+
+	committed := func() []*WriteRequest {
+		return []*WriteRequest{
+			block(2, []byte("first"),
+				&TableDataRow{account, scope, table, 1, payer, 0, false, []byte{0x01}},
+				&TableDataRow{account, scope, table, 2, payer, 0, false, []byte{0x02}},
+			),
+			block(3, nil,
+				&TableDataRow{account, scope, table, 3, otherPayer, 0, false, []byte{0x03}},
+			),
+		}
+	}
+
+	crashed := func() []*WriteRequest {
+		return []*WriteRequest{
+			block(4, []byte("second"),
+				&TableDataRow{account, scope, table, 1, otherPayer, payer, false, []byte{0x11}},
+				&TableDataRow{account, scope, table, 4, payer, 0, false, []byte{0x04}},
+			),
+			block(5, nil,
+				&TableDataRow{account, scope, table, 2, 0, payer, true, nil},
+				&TableDataRow{account, scope, table, 5, otherPayer, 0, false, []byte{0x05}},
+			),
+			block(6, []byte("third"),
+				&TableDataRow{account, scope, table, 3, payer, otherPayer, false, []byte{0x13}},
+			),
+		}
+	}
+
+	type state struct {
+		rows                []*TableRow
+		abi                 []byte
+		payerRows           []*RAMPayerRow
+		otherPayerRows      []*RAMPayerRow
+		lastWrittenBlockNum uint64
+	}
+
+	readState := func(db *FluxDB, blockNum uint32) *state {
+		lastWrittenBlock, err := db.FetchLastWrittenBlock(ctx)
+		require.NoError(t, err)
+
+		resp, err := db.ReadTable(ctx, &ReadTableRequest{Account: account, Scope: scope, Table: table, BlockNum: blockNum})
+		require.NoError(t, err)
+
+		payerRows, _, err := db.ReadRAMPayerRows(ctx, blockNum, payer, "", 0, nil)
+		require.NoError(t, err)
+
+		otherPayerRows, _, err := db.ReadRAMPayerRows(ctx, blockNum, otherPayer, "", 0, nil)
+		require.NoError(t, err)
+
+		return &state{resp.Rows, resp.ABI.PackedABI, payerRows, otherPayerRows, lastWrittenBlock.Num()}
+	}
+
+	// A first run without crashing gives the expected states and the mutation count of the crashed batch
+	var expectedBefore, expectedAfter *state
+	var expectedStored []storedValue
+	mutationCount := 0
+	withStore(t, newKVStore, func(kvStore store.KVStore) {
+		db := New(kvStore)
+		executeWriteRequests(t, db, committed()...)
+		expectedBefore = readState(db, 3)
+
+		counting := newCrashingKVStore(kvStore, -1)
+		executeWriteRequests(t, New(counting), crashed()...)
+		mutationCount = counting.appliedCount
+		expectedAfter = readState(db, 6)
+		expectedStored = storedRowsAndABIs(t, kvStore)
+	})
+
+	require.Equal(t, uint64(3), expectedBefore.lastWrittenBlockNum)
+	require.Equal(t, uint64(6), expectedAfter.lastWrittenBlockNum)
+	require.True(t, mutationCount > 2, "the batch should be written in more than the pending write and last written block mutations")
+
+	for crashAfter := 0; crashAfter < mutationCount; crashAfter++ {
+		t.Run(fmt.Sprintf("crash after %d mutations", crashAfter), func(t *testing.T) {
+			withStore(t, newKVStore, func(kvStore store.KVStore) {
+				executeWriteRequests(t, New(kvStore), committed()...)
+
+				crashing := newCrashingKVStore(kvStore, crashAfter)
+				require.Error(t, New(crashing).WriteBatch(ctx, crashed()))
+				require.True(t, crashing.crashed, "the write batch should have crashed")
+
+				// The process restarts on the same store
+				db := New(kvStore)
+				assert.Equal(t, expectedBefore, readState(db, 3))
+
+				removedCount, err := db.RepairPartialWrites(ctx)
+				require.NoError(t, err)
+
+				if kvStore.SupportsDeletions() {
+					assertNothingWrittenAbove(t, kvStore, 3)
+				} else {
+					assert.Equal(t, 0, removedCount, "nothing can be removed from a store unable to delete keys")
+				}
+				assert.Equal(t, expectedBefore, readState(db, 3))
+
+				executeWriteRequests(t, db, crashed()...)
+				assert.Equal(t, expectedAfter, readState(db, 6))
+				assert.Equal(t, expectedStored, storedRowsAndABIs(t, kvStore), "rows left behind should all be overwritten")
+			})
+		})
+	}
+}
+
+func assertNothingWrittenAbove(t *testing.T, kvStore store.KVStore, blockNum uint32) {
+	ctx := context.Background()
+
+	require.NoError(t, kvStore.ScanTabletRows(ctx, "", "", func(key string, _ []byte) error {
+		_, rowBlockNum, _, err := explodeWritableRowKey(key)
+		require.NoError(t, err)
+		assert.True(t, rowBlockNum <= blockNum, "row %q should have been removed", key)
+		return nil
+	}))
+
+	require.NoError(t, kvStore.ScanABIs(ctx, "", "", func(key string, _ []byte) error {
+		abiBlockNum, err := chunkKeyRevBlockNum(key, key[:17])
+		require.NoError(t, err)
+		assert.True(t, abiBlockNum <= blockNum, "abi %q should have been removed", key)
+		return nil
+	}))
+}
+
+type storedValue struct {
+	key   string
+	value string
+}
+
+// storedRowsAndABIs returns all the rows and ABIs of the store, in key order
+func storedRowsAndABIs(t *testing.T, kvStore store.KVStore) (out []storedValue) {
+	ctx := context.Background()
+
+	require.NoError(t, kvStore.ScanTabletRows(ctx, "", "", func(key string, value []byte) error {
+		out = append(out, storedValue{key, string(value)})
+		return nil
+	}))
+
+	require.NoError(t, kvStore.ScanABIs(ctx, "", "", func(key string, value []byte) error {
+		out = append(out, storedValue{"abi:" + key, string(value)})
+		return nil
+	}))
+
+	return out
+}
+
+func withStore(t *testing.T, newKVStore func(t *testing.T) (store.KVStore, func()), f func(kvStore store.KVStore)) {
+	kvStore, closer := newKVStore(t)
+	defer closer()
+
+	f(kvStore)
+}
+
+func newTestMemoryKVStore(t *testing.T) (store.KVStore, func()) {
+	kvStore, err := NewKVStore("memory://")
+	require.NoError(t, err)
+
+	return kvStore, func() { kvStore.Close() }
+}
+
+var errCrashed = errors.New("crashed")
+
+// crashingKVStore simulates a process dying while flushing: once `remaining` mutations
+// were applied, the mutations left, in the flushing batch and all later ones, are lost.
+// Flushes are applied one mutation at a time, like a store without atomic batches would.
+// A negative `remaining` never crashes, counting the mutations applied instead.
+type crashingKVStore struct {
+	store.KVStore
+
+	remaining    int
+	appliedCount int
+	crashed      bool
+}
+
+func newCrashingKVStore(kvStore store.KVStore, remaining int) *crashingKVStore {
+	return &crashingKVStore{KVStore: kvStore, remaining: remaining}
+}
+
+func (s *crashingKVStore) NewBatch(logger *zap.Logger) store.Batch {
+	return &crashingBatch{store: s, logger: logger}
+}
+
+type crashingBatch struct {
+	store     *crashingKVStore
+	logger    *zap.Logger
+	mutations []func(batch store.Batch)
+}
+
+func (b *crashingBatch) Flush(ctx context.Context) error {
+	defer b.Reset()
+
+	for _, mutation := range b.mutations {
+		if b.store.remaining == 0 {
+			b.store.crashed = true
+			return errCrashed
+		}
+
+		batch := b.store.KVStore.NewBatch(b.logger)
+		mutation(batch)
+		if err := batch.Flush(ctx); err != nil {
+			return err
+		}
+
+		b.store.remaining--
+		b.store.appliedCount++
+	}
+
+	return nil
+}
+
+// FlushIfFull flushes often, so a batch is written in many flushes
+func (b *crashingBatch) FlushIfFull(ctx context.Context) error {
+	if len(b.mutations) < 3 {
+		return nil
+	}
+
+	return b.Flush(ctx)
+}
+
+func (b *crashingBatch) Reset() {
+	b.mutations = nil
+}
+
+func (b *crashingBatch) add(mutation func(batch store.Batch)) {
+	b.mutations = append(b.mutations, mutation)
+}
+
+func (b *crashingBatch) SetABI(key string, value []byte) {
+	b.add(func(batch store.Batch) { batch.SetABI(key, value) })
+}
+
+func (b *crashingBatch) SetRow(key string, value []byte) {
+	b.add(func(batch store.Batch) { batch.SetRow(key, value) })
+}
+
+func (b *crashingBatch) SetLast(key string, value []byte) {
+	b.add(func(batch store.Batch) { batch.SetLast(key, value) })
+}
+
+func (b *crashingBatch) SetIndex(key string, value []byte) {
+	b.add(func(batch store.Batch) { batch.SetIndex(key, value) })
+}
+
+func (b *crashingBatch) SetMarker(key string, value []byte) {
+	b.add(func(batch store.Batch) { batch.SetMarker(key, value) })
+}
+
+func (b *crashingBatch) DeleteABI(key string) {
+	b.add(func(batch store.Batch) { batch.DeleteABI(key) })
+}
+
+func (b *crashingBatch) DeleteRow(key string) {
+	b.add(func(batch store.Batch) { batch.DeleteRow(key) })
+}
+
+func (b *crashingBatch) DeleteIndex(key string) {
+	b.add(func(batch store.Batch) { batch.DeleteIndex(key) })
+}
@@ -110,12 +110,24 @@ type FluxDBHandler struct {
 	speculativeWrites    []*WriteRequest
 	headBlock            bstream.BlockRef
 
+	// Guards the subscriptions as well as the pending writes and the server fork
+	// database, so subscribers get a consistent view of them. It's never held
+	// while writing to the database.
+	subscriptionsLock    sync.Mutex
+	subscriptions        []*WriteSubscription
+	irreversibleBlockNum uint32
+
+	// Irreversible writes handed to `WriteBatch`, until the LIB moves past them
+	flushingWrites []*WriteRequest
+
 	batchWrites       []*WriteRequest
 	batchOpen         time.Time
 	batchClose        time.Time
 	batchWritableRows int
 	abisWritten       int
 
+	snapshotter *Snapshotter
+
 	lastBlockIDCheck time.Time
 }
 
@@ -134,6 +146,12 @@ func (p *FluxDBHandler) EnableWriteOnEachIrreversibleStep() {
 	p.writeOnEachIrreversibleStep = true
 }
 
+// EnableSnapshots makes the handler notify the snapshotter of each written
+// batch, so it can export snapshots at its configured interval.
+func (p *FluxDBHandler) EnableSnapshots(snapshotter *Snapshotter) {
+	p.snapshotter = snapshotter
+}
+
 func (p *FluxDBHandler) InitializeStartBlockID() (startBlock bstream.BlockRef, err error) {
 	startBlock, err = p.db.FetchLastWrittenBlock(p.ctx)
 	if err != nil {
@@ -175,14 +193,35 @@ func (p *FluxDBHandler) updateSpeculativeWrites(newHeadBlock bstream.BlockRef) {
 	}
 
 	var newWrites []*WriteRequest
+	newBlockIDs := map[string]bool{}
 	for _, blk := range blocks {
 		req := blk.Object.(*WriteRequest)
 		newWrites = append(newWrites, req)
+		newBlockIDs[string(req.BlockID)] = true
 	}
 
 	p.speculativeReadsLock.RLock()
 	defer p.speculativeReadsLock.RUnlock()
 
+	// Speculative writes gone from the new segment without having become irreversible
+	// are on a forked branch, they are undone from the highest block down.
+	libNum := p.serverForkDB.LIBNum()
+	oldBlockIDs := map[string]bool{}
+	for i := len(p.speculativeWrites) - 1; i >= 0; i-- {
+		write := p.speculativeWrites[i]
+		oldBlockIDs[string(write.BlockID)] = true
+
+		if !newBlockIDs[string(write.BlockID)] && uint64(write.BlockNum) > libNum {
+			p.broadcast(newWriteStep(forkable.StepUndo, write))
+		}
+	}
+
+	for _, write := range newWrites {
+		if !oldBlockIDs[string(write.BlockID)] {
+			p.broadcast(newWriteStep(forkable.StepNew, write))
+		}
+	}
+
 	p.speculativeWrites = newWrites
 	p.headBlock = newHeadBlock
 }
@@ -208,6 +247,9 @@ func (p *FluxDBHandler) ProcessBlock(rawBlk *bstream.Block, rawObj interface{})
 			}
 		}
 
+		p.subscriptionsLock.Lock()
+		defer p.subscriptionsLock.Unlock()
+
 		p.serverForkDB.AddLink(
 			blkRef,
 			bstream.BlockRefFromID(rawBlk.PreviousID()),
@@ -221,73 +263,24 @@ func (p *FluxDBHandler) ProcessBlock(rawBlk *bstream.Block, rawObj interface{})
 			return nil
 		}
 
-		now := time.Now()
-		if p.writeEnabled {
-			if len(p.batchWrites) == 0 {
-				p.batchOpen = now
-				p.batchClose = now.Add(1 * time.Second) // Always flush at least the previous LIB
-			}
+		if !p.writeEnabled {
+			return p.syncLIBWithWriter()
+		}
 
-			zlog.Debug("accumulating write request from irreversible blocks", zap.Stringer("block", rawBlk), zap.Int("block_count", len(fObj.StepBlocks)))
-			for _, newIrrBlk := range fObj.StepBlocks {
-				req := newIrrBlk.Obj.(*WriteRequest)
-
-				p.batchWrites = append(p.batchWrites, req)
-				p.batchWritableRows += len(req.AuthLinks) +
-					len(req.AuthLinks) +
-					len(req.AuthLinks) +
-					len(req.KeyAccounts) +
-					len(req.TableDatas) +
-					len(req.TableScopes)
-				p.abisWritten += len(req.ABIs)
+		// The lock is released before writing, the batch being flushed stays
+		// visible to new subscribers through `flushingWrites` until the LIB moves.
+		flush := p.accumulateIrreversibleWrites(rawBlk, fObj)
+		if flush != nil {
+			if err := p.writeBatch(rawBlk, flush); err != nil {
+				return err
 			}
+		}
 
-			if p.batchWritableRows > 5000 || now.After(p.batchClose) || p.writeOnEachIrreversibleStep {
-				defer func() {
-					p.batchWrites = nil
-					p.batchWritableRows = 0
-					p.abisWritten = 0
-				}()
-
-				err := p.db.WriteBatch(p.ctx, p.batchWrites)
-				if err != nil {
-					return err
-				}
-
-				timePerBlock := time.Now().Sub(p.batchOpen) / time.Duration(len(p.batchWrites))
-				zlog.Info("wrote irreversible segment of blocks starting here",
-					zap.String("block_id", rawBlk.ID()),
-					zap.Uint64("block_num", rawBlk.Num()),
-					zap.Duration("batch_elapsed", time.Now().Sub(p.batchOpen)),
-					zap.Duration("batch_elapsed_per_block", timePerBlock),
-					zap.Int("batch_write_count", len(p.batchWrites)),
-					zap.Int("batch_writable_row_count", p.batchWritableRows),
-					zap.Int("batch_writable_abi_count", p.abisWritten),
-				)
-			}
+		p.subscriptionsLock.Lock()
+		defer p.subscriptionsLock.Unlock()
 
-			p.serverForkDB.MoveLIB(blkRef)
-		} else {
-			// Fetch from database, and sync with the writer before truncating the LIB here.
-			// Don't ask more than once each 2 seconds..
-			if p.lastBlockIDCheck.Before(time.Now().Add(-2 * time.Second)) {
-				lastWrittenBlock, err := p.db.FetchLastWrittenBlock(p.ctx)
-				if err != nil {
-					return err
-				}
-
-				if lastWrittenBlock.ID() != p.serverForkDB.LIBID() {
-					zlog.Info("writer's LIB updated, advancing server forkDB in return",
-						zap.String("block_id", lastWrittenBlock.ID()),
-						zap.Uint64("block_num", lastWrittenBlock.Num()),
-					)
-
-					p.serverForkDB.MoveLIB(lastWrittenBlock)
-				}
-
-				p.lastBlockIDCheck = time.Now()
-			}
-		}
+		p.flushingWrites = nil
+		p.serverForkDB.MoveLIB(blkRef)
 
 	default:
 		panic(fmt.Errorf("unsupported forkable step %q", fObj.Step))
@@ -296,6 +289,118 @@ func (p *FluxDBHandler) ProcessBlock(rawBlk *bstream.Block, rawObj interface{})
 	return nil
 }
 
+type pendingBatch struct {
+	writes       []*WriteRequest
+	opened       time.Time
+	writableRows int
+	abisWritten  int
+}
+
+// accumulateIrreversibleWrites broadcasts the irreversible step to the
+// subscriptions and adds its blocks to the current batch, returning the batch
+// when it's time to flush it.
+func (p *FluxDBHandler) accumulateIrreversibleWrites(rawBlk *bstream.Block, fObj *forkable.ForkableObject) *pendingBatch {
+	p.subscriptionsLock.Lock()
+	defer p.subscriptionsLock.Unlock()
+
+	for _, newIrrBlk := range fObj.StepBlocks {
+		p.broadcast(newWriteStep(forkable.StepIrreversible, newIrrBlk.Obj.(*WriteRequest)))
+	}
+	p.irreversibleBlockNum = uint32(rawBlk.Num())
+
+	now := time.Now()
+	if len(p.batchWrites) == 0 {
+		p.batchOpen = now
+		p.batchClose = now.Add(1 * time.Second) // Always flush at least the previous LIB
+	}
+
+	zlog.Debug("accumulating write request from irreversible blocks", zap.Stringer("block", rawBlk), zap.Int("block_count", len(fObj.StepBlocks)))
+	for _, newIrrBlk := range fObj.StepBlocks {
+		req := newIrrBlk.Obj.(*WriteRequest)
+
+		p.batchWrites = append(p.batchWrites, req)
+		p.batchWritableRows += len(req.AccountPermissions) +
+			len(req.AccountResourceLimits) +
+			len(req.AuthLinks) +
+			len(req.KeyAccounts) +
+			len(req.TableDatas) +
+			len(req.TableScopes) +
+			len(req.SecondaryIndexes)
+		p.abisWritten += len(req.ABIs)
+	}
+
+	if p.batchWritableRows <= 5000 && !now.After(p.batchClose) && !p.writeOnEachIrreversibleStep {
+		return nil
+	}
+
+	flush := &pendingBatch{
+		writes:       p.batchWrites,
+		opened:       p.batchOpen,
+		writableRows: p.batchWritableRows,
+		abisWritten:  p.abisWritten,
+	}
+
+	p.flushingWrites = p.batchWrites
+	p.batchWrites = nil
+	p.batchWritableRows = 0
+	p.abisWritten = 0
+
+	return flush
+}
+
+func (p *FluxDBHandler) writeBatch(rawBlk *bstream.Block, batch *pendingBatch) error {
+	err := p.db.WriteBatch(p.ctx, batch.writes)
+	if err != nil {
+		return err
+	}
+
+	if p.snapshotter != nil {
+		p.snapshotter.OnBlocksWritten(batch.writes[0].BlockNum, bstream.BlockRefFromID(rawBlk.ID()))
+	}
+
+	timePerBlock := time.Now().Sub(batch.opened) / time.Duration(len(batch.writes))
+	zlog.Info("wrote irreversible segment of blocks starting here",
+		zap.String("block_id", rawBlk.ID()),
+		zap.Uint64("block_num", rawBlk.Num()),
+		zap.Duration("batch_elapsed", time.Now().Sub(batch.opened)),
+		zap.Duration("batch_elapsed_per_block", timePerBlock),
+		zap.Int("batch_write_count", len(batch.writes)),
+		zap.Int("batch_writable_row_count", batch.writableRows),
+		zap.Int("batch_writable_abi_count", batch.abisWritten),
+	)
+
+	return nil
+}
+
+// syncLIBWithWriter is used when not writing, it fetches the last block written
+// by the writer and advances the server fork database's LIB up to it.
+func (p *FluxDBHandler) syncLIBWithWriter() error {
+	// Don't ask more than once each 2 seconds..
+	if !p.lastBlockIDCheck.Before(time.Now().Add(-2 * time.Second)) {
+		return nil
+	}
+
+	lastWrittenBlock, err := p.db.FetchLastWrittenBlock(p.ctx)
+	if err != nil {
+		return err
+	}
+
+	p.subscriptionsLock.Lock()
+	defer p.subscriptionsLock.Unlock()
+
+	if lastWrittenBlock.ID() != p.serverForkDB.LIBID() {
+		zlog.Info("writer's LIB updated, advancing server forkDB in return",
+			zap.String("block_id", lastWrittenBlock.ID()),
+			zap.Uint64("block_num", lastWrittenBlock.Num()),
+		)
+
+		p.serverForkDB.MoveLIB(lastWrittenBlock)
+	}
+
+	p.lastBlockIDCheck = time.Now()
+	return nil
+}
+
 func isNearRealtime(blk *pbcodec.Block, now time.Time) bool {
 	tm, _ := ptypes.Timestamp(blk.Header.Timestamp)
 	return now.Add(-15 * time.Second).Before(tm)
@@ -23,6 +23,7 @@ import (
 	"github.com/dfuse-io/derr"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	"github.com/eoscanada/eos-go/system"
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 )
 
@@ -40,7 +41,13 @@ func PreprocessBlock(rawBlk *bstream.Block) (interface{}, error) {
 
 	lastDbOpForRowPath := map[string]*pbcodec.DBOp{}
 	firstDbOpWasInsert := map[string]bool{}
+	payerBeforeBlockForRowPath := map[string]string{}
+	lastSecondaryIndexOpForRowPath := map[string]*pbcodec.DBSecondaryIndexOp{}
+	firstSecondaryIndexOpWasInsert := map[string]bool{}
+	secondaryKeyBeforeBlockForRowPath := map[string][]byte{}
 	lastKeyAccountOpForRowPath := map[string]*keyAccountOp{}
+	lastPermOpForPermissionPath := map[string]*pbcodec.PermOp{}
+	lastRlimitOpForAccountPath := map[string]*pbcodec.RlimitOp{}
 	lastTableOpForTablePath := map[string]*pbcodec.TableOp{}
 
 	req := &WriteRequest{
@@ -57,22 +64,60 @@ func PreprocessBlock(rawBlk *bstream.Block) (interface{}, error) {
 			path := tableDataRowPath(dbOp)
 
 			lastOp := lastDbOpForRowPath[path]
-			if lastOp == nil && dbOp.Operation == pbcodec.DBOp_OPERATION_INSERT {
-				firstDbOpWasInsert[path] = true
+			if lastOp == nil {
+				// The RAM payer mapping of the payer before the block must be removed when it changes
+				payerBeforeBlockForRowPath[path] = dbOp.OldPayer
+				if dbOp.Operation == pbcodec.DBOp_OPERATION_INSERT {
+					firstDbOpWasInsert[path] = true
+				}
 			}
 
 			if dbOp.Operation == pbcodec.DBOp_OPERATION_REMOVE && firstDbOpWasInsert[path] {
 				delete(firstDbOpWasInsert, path)
 				delete(lastDbOpForRowPath, path)
+				delete(payerBeforeBlockForRowPath, path)
 			} else {
 				lastDbOpForRowPath[path] = dbOp
 			}
 		}
 
+		for _, secIdxOp := range trx.DbSecondaryIndexOps {
+			if secIdxOp.Operation == pbcodec.DBOp_OPERATION_UPDATE && bytes.Equal(secIdxOp.OldSecondaryKey, secIdxOp.NewSecondaryKey) && secIdxOp.OldPayer == secIdxOp.NewPayer {
+				continue
+			}
+
+			path := secondaryIndexRowPath(secIdxOp)
+
+			lastOp := lastSecondaryIndexOpForRowPath[path]
+			if lastOp == nil {
+				// Entries are keyed by secondary key, the one stored before the block must be removed when it changes
+				secondaryKeyBeforeBlockForRowPath[path] = secIdxOp.OldSecondaryKey
+				if secIdxOp.Operation == pbcodec.DBOp_OPERATION_INSERT {
+					firstSecondaryIndexOpWasInsert[path] = true
+				}
+			}
+
+			if secIdxOp.Operation == pbcodec.DBOp_OPERATION_REMOVE && firstSecondaryIndexOpWasInsert[path] {
+				delete(firstSecondaryIndexOpWasInsert, path)
+				delete(lastSecondaryIndexOpForRowPath, path)
+				delete(secondaryKeyBeforeBlockForRowPath, path)
+			} else {
+				lastSecondaryIndexOpForRowPath[path] = secIdxOp
+			}
+		}
+
 		for _, permOp := range trx.PermOps {
 			for _, keyAccountOp := range permOpToKeyAccountOps(permOp) {
 				lastKeyAccountOpForRowPath[keyAccountOp.rowPath] = keyAccountOp
 			}
+
+			lastPermOpForPermissionPath[permissionPath(permOp)] = permOp
+		}
+
+		for _, rlimitOp := range trx.RlimitOps {
+			if path := accountResourceLimitPath(rlimitOp); path != "" {
+				lastRlimitOpForAccountPath[path] = rlimitOp
+			}
 		}
 
 		for _, tableOp := range trx.TableOps {
@@ -108,14 +153,36 @@ func PreprocessBlock(rawBlk *bstream.Block) (interface{}, error) {
 		}
 	}
 
+	// Block level operations happen when nodeos finalizes the block, so after all transactions
+	for _, rlimitOp := range blk.RlimitOps {
+		if path := accountResourceLimitPath(rlimitOp); path != "" {
+			lastRlimitOpForAccountPath[path] = rlimitOp
+		}
+	}
+
+	req.AccountPermissions, err = permOpsToWritableRows(lastPermOpForPermissionPath)
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to convert perm ops to account permission row")
+	}
+
+	req.AccountResourceLimits, err = rlimitOpsToWritableRows(lastRlimitOpForAccountPath)
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to convert rlimit ops to account resource limit row")
+	}
+
 	req.KeyAccounts = keyAccountOpsToWritableRows(lastKeyAccountOpForRowPath)
 	req.TableScopes = tableOpsToWritableRows(lastTableOpForTablePath)
 
-	req.TableDatas, err = dbOpsToWritableRows(lastDbOpForRowPath)
+	req.TableDatas, err = dbOpsToWritableRows(lastDbOpForRowPath, payerBeforeBlockForRowPath)
 	if err != nil {
 		return nil, derr.Wrap(err, "unable to convert db ops to table data row")
 	}
 
+	req.SecondaryIndexes, err = secondaryIndexOpsToWritableRows(lastSecondaryIndexOpForRowPath, secondaryKeyBeforeBlockForRowPath)
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to convert secondary index ops to secondary index row")
+	}
+
 	return req, nil
 }
 
@@ -163,14 +230,15 @@ func permOpDataToKeyAccountOps(operation keyAccountOperation, perm *pbcodec.Perm
 	return ops
 }
 
-func dbOpsToWritableRows(latestDbOps map[string]*pbcodec.DBOp) (rows []*TableDataRow, err error) {
-	for _, op := range latestDbOps {
+func dbOpsToWritableRows(latestDbOps map[string]*pbcodec.DBOp, payerBeforeBlock map[string]string) (rows []*TableDataRow, err error) {
+	for path, op := range latestDbOps {
 		rows = append(rows, &TableDataRow{
 			Account:  N(op.Code),
 			Scope:    N(op.Scope),
 			Table:    N(op.TableName),
 			PrimKey:  N(op.PrimaryKey),
 			Payer:    N(op.NewPayer),
+			OldPayer: N(payerBeforeBlock[path]),
 			Deletion: op.Operation == pbcodec.DBOp_OPERATION_REMOVE,
 			Data:     op.NewData,
 		})
@@ -179,6 +247,114 @@ func dbOpsToWritableRows(latestDbOps map[string]*pbcodec.DBOp) (rows []*TableDat
 	return
 }
 
+// secondaryIndexOpsToWritableRows turns the last operation of each secondary index entry
+// into rows, a deletion of the entry as it was before the block when its secondary key
+// changed or the entry was removed, and an insertion of the entry under its new key
+// unless it was removed.
+func secondaryIndexOpsToWritableRows(latestSecondaryIndexOps map[string]*pbcodec.DBSecondaryIndexOp, secondaryKeyBeforeBlock map[string][]byte) (rows []*SecondaryIndexRow, err error) {
+	for path, op := range latestSecondaryIndexOps {
+		kind, err := SecondaryIndexKindFromString(op.IndexKind)
+		if err != nil {
+			return nil, err
+		}
+
+		newRow := func(rawKey []byte, deletion bool) (*SecondaryIndexRow, error) {
+			key, err := sortableSecondaryKey(kind, rawKey)
+			if err != nil {
+				return nil, fmt.Errorf("secondary index %s/%s/%s/%d, primary key %s: %w", op.Code, op.Scope, op.TableName, op.IndexPosition, op.PrimaryKey, err)
+			}
+
+			return &SecondaryIndexRow{
+				Account:       N(op.Code),
+				Scope:         N(op.Scope),
+				Table:         N(op.TableName),
+				PrimKey:       N(op.PrimaryKey),
+				IndexPosition: uint8(op.IndexPosition),
+				Kind:          kind,
+				Deletion:      deletion,
+				Key:           key,
+			}, nil
+		}
+
+		removed := op.Operation == pbcodec.DBOp_OPERATION_REMOVE
+		if keyBefore := secondaryKeyBeforeBlock[path]; len(keyBefore) != 0 && (removed || !bytes.Equal(keyBefore, op.NewSecondaryKey)) {
+			row, err := newRow(keyBefore, true)
+			if err != nil {
+				return nil, err
+			}
+
+			rows = append(rows, row)
+		}
+
+		if !removed {
+			row, err := newRow(op.NewSecondaryKey, false)
+			if err != nil {
+				return nil, err
+			}
+
+			rows = append(rows, row)
+		}
+	}
+
+	return
+}
+
+func permOpsToWritableRows(latestPermOps map[string]*pbcodec.PermOp) (rows []*AccountPermissionRow, err error) {
+	for _, op := range latestPermOps {
+		if op.Operation == pbcodec.PermOp_OPERATION_REMOVE {
+			rows = append(rows, &AccountPermissionRow{
+				Account:    N(op.OldPerm.Owner),
+				Permission: N(op.OldPerm.Name),
+				Deletion:   true,
+			})
+			continue
+		}
+
+		data, err := proto.Marshal(op.NewPerm)
+		if err != nil {
+			return nil, fmt.Errorf("permission %s@%s: %w", op.NewPerm.Owner, op.NewPerm.Name, err)
+		}
+
+		rows = append(rows, &AccountPermissionRow{
+			Account:    N(op.NewPerm.Owner),
+			Permission: N(op.NewPerm.Name),
+			Data:       data,
+		})
+	}
+
+	return
+}
+
+func rlimitOpsToWritableRows(latestRlimitOps map[string]*pbcodec.RlimitOp) (rows []*AccountResourceLimitRow, err error) {
+	for _, op := range latestRlimitOps {
+		var owner string
+		var kind AccountResourceLimitKind
+		var message proto.Message
+
+		switch opKind := op.Kind.(type) {
+		case *pbcodec.RlimitOp_AccountLimits:
+			owner, kind, message = opKind.AccountLimits.Owner, AccountResourceLimitKindLimits, opKind.AccountLimits
+		case *pbcodec.RlimitOp_AccountUsage:
+			owner, kind, message = opKind.AccountUsage.Owner, AccountResourceLimitKindUsage, opKind.AccountUsage
+		default:
+			return nil, fmt.Errorf("unsupported rlimit op kind %T", op.Kind)
+		}
+
+		data, err := proto.Marshal(message)
+		if err != nil {
+			return nil, fmt.Errorf("account %s resource limit: %w", owner, err)
+		}
+
+		rows = append(rows, &AccountResourceLimitRow{
+			Account: N(owner),
+			Kind:    kind,
+			Data:    data,
+		})
+	}
+
+	return
+}
+
 func keyAccountOpsToWritableRows(latestKeyAccountOps map[string]*keyAccountOp) (rows []*KeyAccountRow) {
 	for _, op := range latestKeyAccountOps {
 		rows = append(rows, &KeyAccountRow{
@@ -251,10 +427,42 @@ func tableDataRowPath(op *pbcodec.DBOp) string {
 	return op.Code + "/" + op.Scope + "/" + op.TableName + "/" + op.PrimaryKey
 }
 
+func secondaryIndexRowPath(op *pbcodec.DBSecondaryIndexOp) string {
+	return fmt.Sprintf("%s/%s/%s/%d/%s", op.Code, op.Scope, op.TableName, op.IndexPosition, op.PrimaryKey)
+}
+
 func tableRowPath(op *pbcodec.TableOp) string {
 	return op.Code + "/" + op.Scope + "/" + op.TableName
 }
 
+func permissionPath(op *pbcodec.PermOp) string {
+	perm := op.NewPerm
+	if op.Operation == pbcodec.PermOp_OPERATION_REMOVE {
+		perm = op.OldPerm
+	}
+
+	return perm.Owner + "/" + perm.Name
+}
+
+// accountResourceLimitPath returns the path of the account resource limit row
+// affected by the operation, or an empty string when it affects none. Pending
+// limits are not in effect yet, nodeos applies them when the block is finalized
+// which yields a non-pending block level operation, only the latter is kept.
+func accountResourceLimitPath(op *pbcodec.RlimitOp) string {
+	switch kind := op.Kind.(type) {
+	case *pbcodec.RlimitOp_AccountLimits:
+		if kind.AccountLimits.Pending {
+			return ""
+		}
+
+		return kind.AccountLimits.Owner + "/limits"
+	case *pbcodec.RlimitOp_AccountUsage:
+		return kind.AccountUsage.Owner + "/usage"
+	}
+
+	return ""
+}
+
 // Represents a smaller transformation of a `pbcodec.PermOp` to an operation
 // that added or deleted an account/permission pair for a given public key.
 //
@@ -15,14 +15,18 @@
 package fluxdb
 
 import (
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/dfuse-io/dfuse-eosio/codec"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	"github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/proto"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -86,8 +90,8 @@ func TestPreprocessBlock_DbOps(t *testing.T) {
 				testDBOp("INS", "eosio/scope/table1/key2", "/payer2", "/d2"),
 			},
 			expect: []*TableDataRow{
-				{N("eosio"), N("scope"), N("table1"), N("key1"), N("payer1"), false, []byte("d1")},
-				{N("eosio"), N("scope"), N("table1"), N("key2"), N("payer2"), false, []byte("d2")},
+				{N("eosio"), N("scope"), N("table1"), N("key1"), N("payer1"), 0, false, []byte("d1")},
+				{N("eosio"), N("scope"), N("table1"), N("key2"), N("payer2"), 0, false, []byte("d2")},
 			},
 		},
 		{
@@ -97,7 +101,17 @@ func TestPreprocessBlock_DbOps(t *testing.T) {
 				testDBOp("UPD", "eosio/scope/table1/key1", "payer1/payer1", "d1/d2"),
 			},
 			expect: []*TableDataRow{
-				{N("eosio"), N("scope"), N("table1"), N("key1"), N("payer1"), false, []byte("d2")},
+				{N("eosio"), N("scope"), N("table1"), N("key1"), N("payer1"), N("payer1"), false, []byte("d2")},
+			},
+		},
+		{
+			name: "two payer changes, keep the payer before the block",
+			input: []*pbcodec.DBOp{
+				testDBOp("UPD", "eosio/scope/table1/key1", "payer1/payer2", "d0/d1"),
+				testDBOp("UPD", "eosio/scope/table1/key1", "payer2/payer3", "d1/d2"),
+			},
+			expect: []*TableDataRow{
+				{N("eosio"), N("scope"), N("table1"), N("key1"), N("payer3"), N("payer1"), false, []byte("d2")},
 			},
 		},
 		{
@@ -106,7 +120,7 @@ func TestPreprocessBlock_DbOps(t *testing.T) {
 				testDBOp("REM", "eosio/scope/table1/key1", "payer1/", "d0/"),
 			},
 			expect: []*TableDataRow{
-				{N("eosio"), N("scope"), N("table1"), N("key1"), N(""), true, nil},
+				{N("eosio"), N("scope"), N("table1"), N("key1"), N(""), N("payer1"), true, nil},
 			},
 		},
 		{
@@ -117,7 +131,7 @@ func TestPreprocessBlock_DbOps(t *testing.T) {
 				testDBOp("REM", "eosio/scope/table1/key1", "payer1/", "d2/"),
 			},
 			expect: []*TableDataRow{
-				{N("eosio"), N("scope"), N("table1"), N("key1"), N(""), true, nil},
+				{N("eosio"), N("scope"), N("table1"), N("key1"), N(""), N("payer1"), true, nil},
 			},
 		},
 		{
@@ -129,7 +143,7 @@ func TestPreprocessBlock_DbOps(t *testing.T) {
 				testDBOp("REM", "eosio/scope/table1/key1", "payer1/", "d2/"),
 			},
 			expect: []*TableDataRow{
-				{N("eosio"), N("scope"), N("table1"), N("key1"), N(""), true, nil},
+				{N("eosio"), N("scope"), N("table1"), N("key1"), N(""), N("payer1"), true, nil},
 			},
 		},
 		{
@@ -175,6 +189,9 @@ func TestPreprocessBlock_DbOps(t *testing.T) {
 			require.NoError(t, err)
 
 			dataRows := req.(*WriteRequest).TableDatas
+			sort.Slice(dataRows, func(i, j int) bool {
+				return dataRows[i].PrimKey < dataRows[j].PrimKey
+			})
 
 			assert.Equal(t, test.expect, dataRows)
 		})
@@ -210,6 +227,173 @@ func testDBOp(op string, path, payers, datas string) *pbcodec.DBOp {
 	return out
 }
 
+func TestPreprocessBlock_SecondaryIndexOps(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       []*pbcodec.DBSecondaryIndexOp
+		expect      []*SecondaryIndexRow
+		expectedErr error
+	}{
+		{
+			name: "nothing if update doesn't change",
+			input: []*pbcodec.DBSecondaryIndexOp{
+				testSecondaryIndexOp("UPD", "eosio/scope/table1/0/key1", "idx64", "0100000000000000/0100000000000000"),
+			},
+			expect: nil,
+		},
+		{
+			name: "insert converts key to sortable form",
+			input: []*pbcodec.DBSecondaryIndexOp{
+				testSecondaryIndexOp("INS", "eosio/scope/table1/1/key1", "idx64", "/0201000000000000"),
+			},
+			expect: []*SecondaryIndexRow{
+				{N("eosio"), N("scope"), N("table1"), N("key1"), 1, SecondaryIndexKindUint64, false, []byte{0, 0, 0, 0, 0, 0, 0x01, 0x02}},
+			},
+		},
+		{
+			name: "two updt, one sticks",
+			input: []*pbcodec.DBSecondaryIndexOp{
+				testSecondaryIndexOp("UPD", "eosio/scope/table1/0/key1", "idx64", "0100000000000000/0200000000000000"),
+				testSecondaryIndexOp("UPD", "eosio/scope/table1/0/key1", "idx64", "0200000000000000/0300000000000000"),
+			},
+			expect: []*SecondaryIndexRow{
+				{N("eosio"), N("scope"), N("table1"), N("key1"), 0, SecondaryIndexKindUint64, true, []byte{0, 0, 0, 0, 0, 0, 0, 0x01}},
+				{N("eosio"), N("scope"), N("table1"), N("key1"), 0, SecondaryIndexKindUint64, false, []byte{0, 0, 0, 0, 0, 0, 0, 0x03}},
+			},
+		},
+		{
+			name: "update back to the key before the block keeps the entry",
+			input: []*pbcodec.DBSecondaryIndexOp{
+				testSecondaryIndexOp("UPD", "eosio/scope/table1/0/key1", "idx64", "0100000000000000/0200000000000000"),
+				testSecondaryIndexOp("UPD", "eosio/scope/table1/0/key1", "idx64", "0200000000000000/0100000000000000"),
+			},
+			expect: []*SecondaryIndexRow{
+				{N("eosio"), N("scope"), N("table1"), N("key1"), 0, SecondaryIndexKindUint64, false, []byte{0, 0, 0, 0, 0, 0, 0, 0x01}},
+			},
+		},
+		{
+			name: "update then remove deletes the key before the block",
+			input: []*pbcodec.DBSecondaryIndexOp{
+				testSecondaryIndexOp("UPD", "eosio/scope/table1/0/key1", "idx64", "0100000000000000/0200000000000000"),
+				testSecondaryIndexOp("REM", "eosio/scope/table1/0/key1", "idx64", "0200000000000000/"),
+			},
+			expect: []*SecondaryIndexRow{
+				{N("eosio"), N("scope"), N("table1"), N("key1"), 0, SecondaryIndexKindUint64, true, []byte{0, 0, 0, 0, 0, 0, 0, 0x01}},
+			},
+		},
+		{
+			name: "remove, take it out",
+			input: []*pbcodec.DBSecondaryIndexOp{
+				testSecondaryIndexOp("REM", "eosio/scope/table1/0/key1", "idx64", "0100000000000000/"),
+			},
+			expect: []*SecondaryIndexRow{
+				{N("eosio"), N("scope"), N("table1"), N("key1"), 0, SecondaryIndexKindUint64, true, []byte{0, 0, 0, 0, 0, 0, 0, 0x01}},
+			},
+		},
+		{
+			name: "gobble up INS+DEL",
+			input: []*pbcodec.DBSecondaryIndexOp{
+				testSecondaryIndexOp("INS", "eosio/scope/table1/0/key1", "idx64", "/0100000000000000"),
+				testSecondaryIndexOp("REM", "eosio/scope/table1/0/key1", "idx64", "0100000000000000/"),
+			},
+			expect: nil,
+		},
+		{
+			name: "same primary key on different index positions are kept apart",
+			input: []*pbcodec.DBSecondaryIndexOp{
+				testSecondaryIndexOp("INS", "eosio/scope/table1/0/key1", "idx64", "/0100000000000000"),
+				testSecondaryIndexOp("INS", "eosio/scope/table1/1/key1", "idx_double", "/000000000000f03f"),
+			},
+			expect: []*SecondaryIndexRow{
+				{N("eosio"), N("scope"), N("table1"), N("key1"), 0, SecondaryIndexKindUint64, false, []byte{0, 0, 0, 0, 0, 0, 0, 0x01}},
+				{N("eosio"), N("scope"), N("table1"), N("key1"), 1, SecondaryIndexKindFloat64, false, []byte{0xbf, 0xf0, 0, 0, 0, 0, 0, 0}},
+			},
+		},
+		{
+			name: "invalid key length",
+			input: []*pbcodec.DBSecondaryIndexOp{
+				testSecondaryIndexOp("INS", "eosio/scope/table1/0/key1", "idx128", "/0100000000000000"),
+			},
+			expectedErr: errors.New("unable to convert secondary index ops to secondary index row: secondary index eosio/scope/table1/0, primary key key1: secondary key of kind idx128 should have 16 bytes, got 8"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blk := newBlock("0000003a", []string{"1", "2"})
+			blk.TransactionTraces[0].DbSecondaryIndexOps = test.input
+
+			bstreamBlock, err := codec.BlockFromProto(blk)
+			require.NoError(t, err)
+
+			req, err := PreprocessBlock(bstreamBlock)
+			if test.expectedErr != nil {
+				assert.EqualError(t, err, test.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+
+			rows := req.(*WriteRequest).SecondaryIndexes
+			sort.Slice(rows, func(i, j int) bool {
+				if rows[i].IndexPosition != rows[j].IndexPosition {
+					return rows[i].IndexPosition < rows[j].IndexPosition
+				}
+
+				return rows[i].Deletion && !rows[j].Deletion
+			})
+
+			assert.Equal(t, test.expect, rows)
+		})
+	}
+}
+
+func testSecondaryIndexOp(op string, path, kind, keys string) *pbcodec.DBSecondaryIndexOp {
+	chunks := strings.SplitN(path, "/", 5)
+	keyChunks := strings.SplitN(keys, "/", 2)
+
+	indexPosition, err := strconv.ParseUint(chunks[3], 10, 32)
+	if err != nil {
+		panic(err)
+	}
+
+	out := &pbcodec.DBSecondaryIndexOp{
+		Code:            chunks[0],
+		Scope:           chunks[1],
+		TableName:       chunks[2],
+		IndexPosition:   uint32(indexPosition),
+		PrimaryKey:      chunks[4],
+		IndexKind:       kind,
+		OldSecondaryKey: mustDecodeHex(keyChunks[0]),
+		NewSecondaryKey: mustDecodeHex(keyChunks[1]),
+	}
+
+	switch op {
+	case "INS":
+		out.Operation = pbcodec.DBOp_OPERATION_INSERT
+	case "REM":
+		out.Operation = pbcodec.DBOp_OPERATION_REMOVE
+	case "UPD":
+		out.Operation = pbcodec.DBOp_OPERATION_UPDATE
+	default:
+		panic(fmt.Errorf("unknown secondary index op %q", op))
+	}
+	return out
+}
+
+func mustDecodeHex(in string) []byte {
+	if in == "" {
+		return nil
+	}
+
+	out, err := hex.DecodeString(in)
+	if err != nil {
+		panic(err)
+	}
+
+	return out
+}
+
 func TestPreprocessBlock_PermOps(t *testing.T) {
 	blk := newBlock("0000003a", []string{"1", "2"})
 	blk.TransactionTraces[0].PermOps = []*pbcodec.PermOp{
@@ -242,6 +426,67 @@ func TestPreprocessBlock_PermOps(t *testing.T) {
 		{"k2", N("eosio"), N("owner"), true},
 		{"k3", N("eosio"), N("owner"), false},
 	}, keyAccountRows)
+
+	permissionRows := req.(*WriteRequest).AccountPermissions
+	sort.Slice(permissionRows, func(i, j int) bool {
+		return permissionRows[i].Permission < permissionRows[j].Permission
+	})
+
+	assert.Equal(t, []*AccountPermissionRow{
+		{N("eosio"), N("active"), false, mustProtoMarshal(newPermOpData("eosio", "active", []string{"k2"}))},
+		{N("eosio"), N("owner"), false, mustProtoMarshal(newPermOpData("eosio", "owner", []string{"k3"}))},
+	}, permissionRows)
+}
+
+func TestPreprocessBlock_PermOps_Removal(t *testing.T) {
+	blk := newBlock("0000003a", []string{"1"})
+	blk.TransactionTraces[0].PermOps = []*pbcodec.PermOp{
+		newPermOp("UPD", 0, newPermOpData("eosio", "claim", []string{"k1"}), newPermOpData("eosio", "claim", []string{"k2"})),
+		newPermOp("REM", 1, newPermOpData("eosio", "claim", []string{"k2"}), nil),
+	}
+
+	bstreamBlock, err := codec.BlockFromProto(blk)
+	require.NoError(t, err)
+	req, err := PreprocessBlock(bstreamBlock)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*AccountPermissionRow{
+		{N("eosio"), N("claim"), true, nil},
+	}, req.(*WriteRequest).AccountPermissions)
+}
+
+func TestPreprocessBlock_RlimitOps(t *testing.T) {
+	blk := newBlock("0000003a", []string{"1", "2"})
+	blk.TransactionTraces[0].RlimitOps = []*pbcodec.RlimitOp{
+		newAccountLimitsRlimitOp("eosio", false, 10),
+		newAccountUsageRlimitOp("eosio", 100),
+		newAccountLimitsRlimitOp("bob", true, 20),
+	}
+
+	blk.TransactionTraces[1].RlimitOps = []*pbcodec.RlimitOp{
+		newAccountUsageRlimitOp("eosio", 200),
+		{Operation: pbcodec.RlimitOp_OPERATION_UPDATE, Kind: &pbcodec.RlimitOp_State{State: &pbcodec.RlimitState{}}},
+	}
+
+	blk.RlimitOps = []*pbcodec.RlimitOp{
+		newAccountLimitsRlimitOp("bob", false, 20),
+	}
+
+	bstreamBlock, err := codec.BlockFromProto(blk)
+	require.NoError(t, err)
+	req, err := PreprocessBlock(bstreamBlock)
+	require.NoError(t, err)
+
+	rows := req.(*WriteRequest).AccountResourceLimits
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].primKey()+rows[i].tableKey() < rows[j].primKey()+rows[j].tableKey()
+	})
+
+	assert.Equal(t, []*AccountResourceLimitRow{
+		{N("bob"), AccountResourceLimitKindLimits, mustProtoMarshal(newAccountLimitsRlimitOp("bob", false, 20).GetAccountLimits())},
+		{N("eosio"), AccountResourceLimitKindLimits, mustProtoMarshal(newAccountLimitsRlimitOp("eosio", false, 10).GetAccountLimits())},
+		{N("eosio"), AccountResourceLimitKindUsage, mustProtoMarshal(newAccountUsageRlimitOp("eosio", 200).GetAccountUsage())},
+	}, rows)
 }
 
 func newBlock(blockID string, trxIDs []string) *pbcodec.Block {
@@ -295,3 +540,37 @@ func newPermOpData(account string, permission string, publicKeys []string) *pbco
 		},
 	}
 }
+
+func newAccountLimitsRlimitOp(account string, pending bool, ramBytes int64) *pbcodec.RlimitOp {
+	return &pbcodec.RlimitOp{
+		Operation: pbcodec.RlimitOp_OPERATION_UPDATE,
+		Kind: &pbcodec.RlimitOp_AccountLimits{AccountLimits: &pbcodec.RlimitAccountLimits{
+			Owner:     account,
+			Pending:   pending,
+			NetWeight: -1,
+			CpuWeight: -1,
+			RamBytes:  ramBytes,
+		}},
+	}
+}
+
+func newAccountUsageRlimitOp(account string, ramUsage uint64) *pbcodec.RlimitOp {
+	return &pbcodec.RlimitOp{
+		Operation: pbcodec.RlimitOp_OPERATION_UPDATE,
+		Kind: &pbcodec.RlimitOp_AccountUsage{AccountUsage: &pbcodec.RlimitAccountUsage{
+			Owner:    account,
+			NetUsage: &pbcodec.UsageAccumulator{LastOrdinal: 1, ValueEx: 2, Consumed: 3},
+			CpuUsage: &pbcodec.UsageAccumulator{LastOrdinal: 1, ValueEx: 4, Consumed: 5},
+			RamUsage: ramUsage,
+		}},
+	}
+}
+
+func mustProtoMarshal(message proto.Message) []byte {
+	out, err := proto.Marshal(message)
+	if err != nil {
+		panic(err)
+	}
+
+	return out
+}
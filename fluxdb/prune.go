@@ -0,0 +1,271 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/dfuse-io/dtracing"
+	"go.uber.org/zap"
+)
+
+// FetchPrunedBlockNum returns the first block num for which history is retained,
+// reads below it must be rejected. It returns 0 when the database was never pruned.
+func (fdb *FluxDB) FetchPrunedBlockNum(ctx context.Context) (uint32, error) {
+	blockNum, err := fdb.fetchBlockNumMarker(ctx, prunedBlockMarkerKey)
+	if err != nil {
+		return 0, derr.Wrap(err, "fetching pruned block marker")
+	}
+
+	return blockNum, nil
+}
+
+// Prune collapses the history older than `beforeBlockNum`. For each row, only the
+// last version written before that block is kept as the base version (or none at
+// all when it was a deletion), the same goes for ABIs. A fresh index is written for
+// each table at `beforeBlockNum - 1` and older indexes are deleted.
+//
+// Once pruned, reads below `beforeBlockNum` are rejected. Pruning again with the
+// same block is possible, to complete an interrupted run for example.
+//
+// Pruning deletes keys, it fails with `store.ErrDeletionsNotSupported`, leaving the
+// database untouched, when the storage engine cannot delete them.
+func (fdb *FluxDB) Prune(ctx context.Context, beforeBlockNum uint32) error {
+	ctx, span := dtracing.StartSpan(ctx, "prune", "before_block_num", beforeBlockNum)
+	defer span.End()
+
+	if !fdb.store.SupportsDeletions() {
+		return fmt.Errorf("cannot prune history: %w", store.ErrDeletionsNotSupported)
+	}
+
+	if beforeBlockNum < 2 {
+		return fmt.Errorf("pruning before block %d would not remove anything", beforeBlockNum)
+	}
+
+	lastWrittenBlock, err := fdb.FetchLastWrittenBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	if beforeBlockNum > uint32(lastWrittenBlock.Num()) {
+		return fmt.Errorf("cannot prune up to block %d, last written block is %d", beforeBlockNum, lastWrittenBlock.Num())
+	}
+
+	prunedBlockNum, err := fdb.FetchPrunedBlockNum(ctx)
+	if err != nil {
+		return err
+	}
+
+	if beforeBlockNum < prunedBlockNum {
+		return fmt.Errorf("history already pruned up to block %d, cannot prune up to older block %d", prunedBlockNum, beforeBlockNum)
+	}
+
+	zlog.Info("pruning history", zap.Uint32("before_block_num", beforeBlockNum), zap.Uint32("previously_pruned_block_num", prunedBlockNum))
+
+	// The marker is written first so reads in the pruned range are rejected before any row disappears
+	batch := fdb.store.NewBatch(zlog)
+	batch.SetMarker(prunedBlockMarkerKey, []byte(HexBlockNum(beforeBlockNum)))
+	if err := batch.Flush(ctx); err != nil {
+		return derr.Wrap(err, "flushing pruned block marker")
+	}
+
+	abiCount, err := fdb.pruneABIs(ctx, batch, beforeBlockNum)
+	if err != nil {
+		return derr.Wrap(err, "pruning abis")
+	}
+
+	tableCount, rowCount := 0, 0
+	startKey := ""
+	for {
+		tableKey := ""
+		err := fdb.store.ScanTabletRows(ctx, startKey, "", func(key string, _ []byte) error {
+			var err error
+			tableKey, _, _, err = explodeWritableRowKey(key)
+			if err != nil {
+				return fmt.Errorf("couldn't parse row key %q: %w", key, err)
+			}
+
+			return store.BreakScan
+		})
+		if err != nil && err != store.BreakScan {
+			return derr.Wrap(err, "finding next table")
+		}
+
+		if tableKey == "" {
+			break
+		}
+
+		prunedRowCount, err := fdb.pruneTable(ctx, batch, tableKey, beforeBlockNum)
+		if err != nil {
+			return derr.Wrapf(err, "pruning table %q", tableKey)
+		}
+
+		tableCount++
+		rowCount += prunedRowCount
+		if tableCount%1000 == 0 {
+			zlog.Info("pruning tables (1/1000)", zap.String("table_key", tableKey), zap.Int("table_count", tableCount), zap.Int("pruned_row_count", rowCount))
+		}
+
+		// Rows of a table all start with `<tableKey>:`, and `;` follows `:`
+		startKey = tableKey + ";"
+	}
+
+	if err := batch.Flush(ctx); err != nil {
+		return derr.Wrap(err, "final flush")
+	}
+
+	zlog.Info("pruned history",
+		zap.Uint32("before_block_num", beforeBlockNum),
+		zap.Int("pruned_abi_count", abiCount),
+		zap.Int("table_count", tableCount),
+		zap.Int("pruned_row_count", rowCount),
+	)
+
+	return nil
+}
+
+func (fdb *FluxDB) pruneABIs(ctx context.Context, batch store.Batch, beforeBlockNum uint32) (prunedCount int, err error) {
+	var obsoleteKeys []string
+	lastAccountKey := ""
+	err = fdb.store.ScanABIs(ctx, "", "", func(key string, _ []byte) error {
+		accountKey := key[:strings.Index(key, ":")+1]
+
+		abiBlockNum, err := chunkKeyRevBlockNum(key, accountKey)
+		if err != nil {
+			return fmt.Errorf("couldn't infer block num in abi key %q: %w", key, err)
+		}
+
+		if abiBlockNum >= beforeBlockNum {
+			return nil
+		}
+
+		// Keys of an account are ordered from most recent to oldest ABI, the first one is the base version
+		if accountKey != lastAccountKey {
+			lastAccountKey = accountKey
+			return nil
+		}
+
+		obsoleteKeys = append(obsoleteKeys, key)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, key := range obsoleteKeys {
+		batch.DeleteABI(key)
+		if err := batch.FlushIfFull(ctx); err != nil {
+			return 0, derr.Wrap(err, "flushing if full")
+		}
+	}
+
+	return len(obsoleteKeys), nil
+}
+
+type prunedRow struct {
+	key       string
+	blockNum  uint32
+	byteCount int
+}
+
+func (fdb *FluxDB) pruneTable(ctx context.Context, batch store.Batch, tableKey string, beforeBlockNum uint32) (prunedCount int, err error) {
+	var obsoleteKeys []string
+	latestRows := map[string]*prunedRow{}
+
+	firstRowKey := tableKey + ":00000000"
+	lastRowKey := tableKey + ":" + HexBlockNum(beforeBlockNum)
+	err = fdb.store.ScanTabletRows(ctx, firstRowKey, lastRowKey, func(key string, value []byte) error {
+		_, blockNum, primaryKey, err := explodeWritableRowKey(key)
+		if err != nil {
+			return fmt.Errorf("couldn't parse row key %q: %w", key, err)
+		}
+
+		if previous, found := latestRows[primaryKey]; found {
+			obsoleteKeys = append(obsoleteKeys, previous.key)
+		}
+
+		latestRows[primaryKey] = &prunedRow{key: key, blockNum: blockNum, byteCount: len(value)}
+		return nil
+	})
+	if err != nil {
+		return 0, derr.Wrap(err, "read rows")
+	}
+
+	if len(latestRows) == 0 {
+		return 0, nil
+	}
+
+	index := NewTableIndex()
+	index.AtBlockNum = beforeBlockNum - 1
+	index.Squelched = uint32(len(obsoleteKeys) + len(latestRows))
+	for primaryKey, row := range latestRows {
+		if row.byteCount == 0 {
+			obsoleteKeys = append(obsoleteKeys, row.key)
+			continue
+		}
+
+		index.Map[primaryKey] = row.blockNum
+		index.PayloadByteCount += uint64(row.byteCount)
+	}
+	index.RowCount = uint32(len(index.Map))
+
+	for _, key := range obsoleteKeys {
+		batch.DeleteRow(key)
+		if err := batch.FlushIfFull(ctx); err != nil {
+			return 0, derr.Wrap(err, "flushing if full")
+		}
+	}
+
+	snapshot, err := index.MarshalBinary(ctx, tableKey)
+	if err != nil {
+		return 0, derr.Wrap(err, "unable to marshal table index to binary")
+	}
+
+	prefixKey := tableKey + ":"
+	batch.SetIndex(prefixKey+HexRevBlockNum(index.AtBlockNum), snapshot)
+
+	// Older indexes reference row versions that are now gone
+	for indexBlockNum := index.AtBlockNum; indexBlockNum > 0; {
+		indexKey, _, err := fdb.store.FetchIndex(ctx, tableKey, prefixKey, prefixKey+HexRevBlockNum(indexBlockNum-1))
+		if err == store.ErrNotFound {
+			break
+		}
+
+		if err != nil {
+			return 0, derr.Wrap(err, "fetch older index")
+		}
+
+		indexBlockNum, err = chunkKeyRevBlockNum(indexKey, prefixKey)
+		if err != nil {
+			return 0, derr.Wrap(err, "couldn't infer block num in table index's row key")
+		}
+
+		batch.DeleteIndex(indexKey)
+	}
+
+	if err := batch.FlushIfFull(ctx); err != nil {
+		return 0, derr.Wrap(err, "flushing if full")
+	}
+
+	if cached := fdb.idxCache.GetIndex(tableKey); cached != nil && cached.AtBlockNum < beforeBlockNum {
+		fdb.idxCache.CacheIndex(tableKey, index)
+	}
+
+	return len(obsoleteKeys), nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrune(t *testing.T) {
+	t.Run("bigtable", func(t *testing.T) {
+		db, closer := NewTestDB(t)
+		defer closer()
+
+		testPrune(t, db)
+	})
+
+	t.Run("memory", func(t *testing.T) {
+		kvStore, err := NewKVStore("memory://")
+		require.NoError(t, err)
+
+		db := New(kvStore)
+		defer db.Close()
+
+		testPrune(t, db)
+	})
+}
+
+func TestPrune_DeletionsNotSupported(t *testing.T) {
+	kvStore, closer := newTestBadgerKVStore(t)
+	defer closer()
+
+	ctx := context.Background()
+	db := New(kvStore)
+	writePruneTestBlocks(t, db)
+
+	err := db.Prune(ctx, 12)
+	assert.True(t, errors.Is(err, store.ErrDeletionsNotSupported), "unexpected error %v", err)
+
+	prunedBlockNum, err := db.FetchPrunedBlockNum(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), prunedBlockNum, "the pruned block marker should not be written")
+
+	resp, err := db.ReadTable(ctx, &ReadTableRequest{Account: 0, Scope: 1, Table: 2, BlockNum: 10})
+	require.NoError(t, err)
+	assert.Len(t, resp.Rows, 2)
+}
+
+func testPrune(t *testing.T, db *FluxDB) {
+	ctx := context.Background()
+	account, scope, table := uint64(0), uint64(1), uint64(2)
+
+	writePruneTestBlocks(t, db)
+
+	require.NoError(t, db.Prune(ctx, 12))
+
+	prunedBlockNum, err := db.FetchPrunedBlockNum(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(12), prunedBlockNum)
+
+	tableKey := (&TableDataRow{Account: account, Scope: scope, Table: table}).tableKey()
+
+	var rowKeys []string
+	require.NoError(t, db.store.ScanTabletRows(ctx, tableKey+":", tableKey+";", func(key string, _ []byte) error {
+		rowKeys = append(rowKeys, key)
+		return nil
+	}))
+	assert.Equal(t, []string{
+		tableKey + ":0000000b:0000000000000001",
+		tableKey + ":0000000c:0000000000000001",
+		tableKey + ":0000000c:0000000000000003",
+	}, rowKeys)
+
+	var abiKeys []string
+	require.NoError(t, db.store.ScanABIs(ctx, "", "", func(key string, _ []byte) error {
+		abiKeys = append(abiKeys, key)
+		return nil
+	}))
+	assert.Equal(t, []string{HexName(account) + ":" + HexRevBlockNum(11)}, abiKeys)
+
+	index, err := db.getIndex(ctx, tableKey, 12)
+	require.NoError(t, err)
+	require.NotNil(t, index)
+	assert.Equal(t, uint32(11), index.AtBlockNum)
+	assert.Equal(t, map[string]uint32{"0000000000000001": 11}, index.Map)
+	assert.Equal(t, uint32(1), index.RowCount)
+	assert.Equal(t, uint64(9), index.PayloadByteCount)
+
+	resp, err := db.ReadTable(ctx, &ReadTableRequest{Account: account, Scope: scope, Table: table, BlockNum: 12})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("second"), resp.ABI.PackedABI)
+	assert.Equal(t, []*TableRow{
+		{Key: 1, Payer: 5, Data: []byte{0x12}, BlockNum: 12},
+		{Key: 3, Payer: 5, Data: []byte{0x03}, BlockNum: 12},
+	}, resp.Rows)
+
+	assert.Error(t, db.Prune(ctx, 11), "pruning before an already pruned block should fail")
+	assert.NoError(t, db.Prune(ctx, 12), "pruning again at the same block should be possible")
+}
+
+func writePruneTestBlocks(t *testing.T, db *FluxDB) {
+	account, scope, table := uint64(0), uint64(1), uint64(2)
+
+	block := func(blockNum uint32, abi []byte, rows ...*TableDataRow) *WriteRequest {
+		req := tableDataRows(blockNum, rows...)
+		req.BlockID, _ = hex.DecodeString(fmt.Sprintf("%08xaa", blockNum))
+		if abi != nil {
+			req.ABIs = []*ABIRow{&ABIRow{account, blockNum, abi}}
+		}
+
+		return req
+	}
+
+	executeWriteRequests(t, db,
+		block(10, []byte("first"),
+			&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01}},
+			&TableDataRow{account, scope, table, 2, 5, 0, false, []byte{0x02}},
+		),
+		block(11, []byte("second"),
+			&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x11}},
+			&TableDataRow{account, scope, table, 2, 0, 0, true, nil},
+		),
+		block(12, nil,
+			&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x12}},
+			&TableDataRow{account, scope, table, 3, 5, 0, false, []byte{0x03}},
+		),
+		block(13, nil),
+	)
+}
@@ -0,0 +1,276 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dtracing"
+	"github.com/dfuse-io/logging"
+	"go.uber.org/zap"
+)
+
+// ReadRAMPayerRows returns the contract table rows `payer` pays RAM for at
+// `blockNum`, across all contracts, sorted by account, table, scope and primary key.
+//
+// When `limit` is greater than 0, at most `limit` rows starting at `cursor` (the
+// first row when empty) are returned along with the cursor of the next page, empty
+// when there are no more rows.
+func (fdb *FluxDB) ReadRAMPayerRows(ctx context.Context, blockNum uint32, payer uint64, cursor string, limit int, speculativeWrites []*WriteRequest) (out []*RAMPayerRow, nextCursor string, err error) {
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("reading ram payer rows", zap.String("payer", NameToString(payer)), zap.Uint32("block_num", blockNum), zap.String("cursor", cursor), zap.Int("limit", limit))
+
+	if cursor != "" && !IsValidRAMPayerCursor(cursor) {
+		return nil, "", fmt.Errorf("invalid ram payer rows cursor %q", cursor)
+	}
+
+	rows := map[string]*RAMPayerRow{}
+	rowUpdated := func(blockNum uint32, primaryKey string, value []byte) error {
+		if len(value) < 4 {
+			return errors.New("ram payer mappings should contain the row byte count")
+		}
+
+		row, err := ramPayerRowFromPrimaryKey(payer, primaryKey)
+		if err != nil {
+			return err
+		}
+
+		row.ByteCount = big.Uint32(value)
+		rows[primaryKey] = row
+		return nil
+	}
+
+	rowDeleted := func(blockNum uint32, primaryKey string) error {
+		delete(rows, primaryKey)
+		return nil
+	}
+
+	var speculativeRows []*RAMPayerRow
+	speculativeDeletionCount := 0
+	for _, blockWrite := range speculativeWrites {
+		for _, row := range blockWrite.ramPayerRows() {
+			if row.Payer != payer || row.primKey() < cursor {
+				continue
+			}
+
+			if row.Deletion {
+				speculativeDeletionCount++
+			}
+
+			speculativeRows = append(speculativeRows, row)
+		}
+	}
+
+	readLimit := 0
+	if limit > 0 {
+		// The row following the page gives the next cursor, speculative deletions remove rows read from the database
+		readLimit = limit + 1 + speculativeDeletionCount
+	}
+
+	tableKey := (&RAMPayerRow{Payer: payer}).tableKey()
+	err = fdb.readRange(ctx, tableKey, blockNum, cursor, "", readLimit, false, rowUpdated, rowDeleted)
+	if err != nil {
+		return nil, "", derr.Wrapf(err, "unable to read rows for table key %q", tableKey)
+	}
+
+	zlog.Debug("handling speculative writes", zap.Int("row_count", len(speculativeRows)))
+	for _, row := range speculativeRows {
+		if row.Deletion {
+			delete(rows, row.primKey())
+			continue
+		}
+
+		rows[row.primKey()] = row
+	}
+
+	zlog.Debug("post-processing ram payer rows", zap.Int("row_count", len(rows)))
+	primaryKeys := make([]string, 0, len(rows))
+	for primaryKey := range rows {
+		primaryKeys = append(primaryKeys, primaryKey)
+	}
+
+	// Each chunk has a fixed width, so the string order follows the order of the chunks' values
+	sort.Strings(primaryKeys)
+	if limit > 0 && len(primaryKeys) > limit {
+		nextCursor = primaryKeys[limit]
+		primaryKeys = primaryKeys[:limit]
+	}
+
+	for _, primaryKey := range primaryKeys {
+		out = append(out, rows[primaryKey])
+	}
+
+	return out, nextCursor, nil
+}
+
+// FetchRAMPayerStartBlockNum returns the block from which the RAM payer mappings cover
+// all table rows, reads below it must be rejected. It returns 0 when the mappings are
+// incomplete at every block, the database having been written before they were introduced
+// and not backfilled since, see `BackfillRAMPayerRows`.
+func (fdb *FluxDB) FetchRAMPayerStartBlockNum(ctx context.Context) (uint32, error) {
+	blockNum, err := fdb.fetchBlockNumMarker(ctx, ramPayerStartBlockMarkerKey)
+	if err != nil {
+		return 0, derr.Wrap(err, "fetching ram payer start block marker")
+	}
+
+	return blockNum, nil
+}
+
+// BackfillRAMPayerRows maps by RAM payer all the table rows present at the last written
+// block, for databases written before the mappings were introduced. The mappings are
+// written at the last written block, which then becomes the RAM payer start block, so
+// reads below it are still rejected. Nothing must be written to the database while the
+// backfill runs. Running it again, to complete an interrupted run for example, is possible.
+func (fdb *FluxDB) BackfillRAMPayerRows(ctx context.Context) (blockNum uint32, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "backfill ram payer rows")
+	defer span.End()
+
+	lastWrittenBlock, err := fdb.FetchLastWrittenBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	blockNum = uint32(lastWrittenBlock.Num())
+	if blockNum == 0 {
+		return 0, errors.New("database is empty, there is nothing to backfill")
+	}
+
+	startBlockNum, err := fdb.FetchRAMPayerStartBlockNum(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if startBlockNum != 0 {
+		zlog.Info("ram payer mappings are already complete, nothing to backfill", zap.Uint32("start_block_num", startBlockNum))
+		return startBlockNum, nil
+	}
+
+	zlog.Info("backfilling ram payer rows", zap.Uint32("block_num", blockNum))
+
+	tableCount, rowCount := 0, 0
+	batch := fdb.store.NewBatch(zlog)
+	err = fdb.forEachTablet(ctx, func(tableKey string) error {
+		account, accountValid := chunkKeyUint64(tableKey, 1)
+		table, tableValid := chunkKeyUint64(tableKey, 2)
+		scope, scopeValid := chunkKeyUint64(tableKey, 3)
+		if !strings.HasPrefix(tableKey, "td:") || !accountValid || !tableValid || !scopeValid {
+			return nil
+		}
+
+		// Reading goes through versions of a row older than the latest one, only the rows
+		// present at the last written block are mapped
+		latestRows := map[string]*TableRow{}
+		rowUpdated := func(rowBlockNum uint32, primaryKey string, value []byte) error {
+			row, err := tableRowFromValue(rowBlockNum, primaryKey, value)
+			if err != nil {
+				return err
+			}
+
+			latestRows[primaryKey] = row
+			return nil
+		}
+
+		rowDeleted := func(rowBlockNum uint32, primaryKey string) error {
+			delete(latestRows, primaryKey)
+			return nil
+		}
+
+		if err := fdb.read(ctx, tableKey, blockNum, rowUpdated, rowDeleted); err != nil {
+			return derr.Wrapf(err, "unable to read rows of table key %q", tableKey)
+		}
+
+		for _, row := range latestRows {
+			fdb.writeRow(batch, blockNum, newRAMPayerRow(&TableDataRow{
+				Account: account,
+				Scope:   scope,
+				Table:   table,
+				PrimKey: row.Key,
+				Payer:   row.Payer,
+				Data:    row.Data,
+			}), true)
+
+			if err := batch.FlushIfFull(ctx); err != nil {
+				return derr.Wrap(err, "flushing if full")
+			}
+		}
+
+		rowCount += len(latestRows)
+		tableCount++
+		if tableCount%1000 == 0 {
+			zlog.Info("backfilling tables (1/1000)", zap.String("table_key", tableKey), zap.Int("table_count", tableCount), zap.Int("row_count", rowCount))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, derr.Wrap(err, "unable to backfill rows")
+	}
+
+	if err := batch.Flush(ctx); err != nil {
+		return 0, derr.Wrap(err, "flush")
+	}
+
+	if sched := fdb.idxCache.IndexingSchedule(); len(sched) != 0 {
+		if err := fdb.IndexTables(ctx); err != nil {
+			return 0, derr.Wrap(err, "index tables")
+		}
+	}
+
+	// The marker is written last, reads stay rejected until all the mappings are written
+	batch.SetMarker(ramPayerStartBlockMarkerKey, []byte(HexBlockNum(blockNum)))
+	if err := batch.Flush(ctx); err != nil {
+		return 0, derr.Wrap(err, "flushing ram payer start block marker")
+	}
+
+	zlog.Info("backfilled ram payer rows", zap.Uint32("block_num", blockNum), zap.Int("table_count", tableCount), zap.Int("row_count", rowCount))
+	return blockNum, nil
+}
+
+// IsValidRAMPayerCursor tells if `cursor` is one returned by `ReadRAMPayerRows`.
+func IsValidRAMPayerCursor(cursor string) bool {
+	row, err := ramPayerRowFromPrimaryKey(0, cursor)
+	return err == nil && row.primKey() == cursor
+}
+
+func ramPayerRowFromPrimaryKey(payer uint64, primaryKey string) (*RAMPayerRow, error) {
+	chunks := strings.Split(primaryKey, ":")
+	if len(chunks) != 4 {
+		return nil, fmt.Errorf("ram payer primary key should have 4 chunks, got %d", len(chunks))
+	}
+
+	values := make([]uint64, 4)
+	for i, chunk := range chunks {
+		value, err := strconv.ParseUint(chunk, 16, 64)
+		if err != nil {
+			return nil, derr.Wrapf(err, "invalid chunk #%d in ram payer primary key %q", i+1, primaryKey)
+		}
+
+		values[i] = value
+	}
+
+	return &RAMPayerRow{
+		Payer:   payer,
+		Account: values[0],
+		Table:   values[1],
+		Scope:   values[2],
+		PrimKey: values[3],
+	}, nil
+}
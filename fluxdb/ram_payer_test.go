@@ -0,0 +1,209 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRAMPayerRows(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	ctx := context.Background()
+	payer, otherPayer := N("alice"), N("bob")
+	contract, otherContract, table, scope := N("eosio.token"), N("dice"), N("accounts"), N("alice")
+
+	executeWriteRequests(t, db,
+		tableDataRows(2,
+			&TableDataRow{contract, scope, table, 1, payer, 0, false, []byte{0x01, 0x02}},
+			&TableDataRow{contract, scope, table, 2, otherPayer, 0, false, []byte{0x01}},
+			&TableDataRow{otherContract, scope, table, 1, payer, 0, false, []byte{0x01}},
+		),
+		tableDataRows(3,
+			&TableDataRow{contract, scope, table, 1, otherPayer, payer, false, []byte{0x01, 0x02}},
+			&TableDataRow{otherContract, scope, table, 1, payer, payer, false, []byte{0x01, 0x02, 0x03}},
+		),
+		tableDataRows(4,
+			&TableDataRow{otherContract, scope, table, 1, 0, payer, true, nil},
+		),
+	)
+
+	rows, nextCursor, err := db.ReadRAMPayerRows(ctx, 2, payer, "", 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*RAMPayerRow{
+		{payer, otherContract, table, scope, 1, 9, false},
+		{payer, contract, table, scope, 1, 10, false},
+	}, rows)
+	assert.Empty(t, nextCursor)
+
+	// Moving a row to another payer removes the mapping of the previous payer
+	rows, _, err = db.ReadRAMPayerRows(ctx, 3, payer, "", 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*RAMPayerRow{
+		{payer, otherContract, table, scope, 1, 11, false},
+	}, rows)
+
+	rows, _, err = db.ReadRAMPayerRows(ctx, 3, otherPayer, "", 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*RAMPayerRow{
+		{otherPayer, contract, table, scope, 1, 10, false},
+		{otherPayer, contract, table, scope, 2, 9, false},
+	}, rows)
+
+	// Deleting a row removes its mapping, indexed mappings must give the same outcome
+	ramPayerTableKey := (&RAMPayerRow{Payer: payer}).tableKey()
+	db.idxCache.ScheduleIndex(ramPayerTableKey, 3)
+	require.NoError(t, db.IndexTables(ctx))
+
+	rows, _, err = db.ReadRAMPayerRows(ctx, 4, payer, "", 0, nil)
+	require.NoError(t, err)
+	assert.Empty(t, rows)
+
+	moveRow := []*WriteRequest{
+		tableDataRows(5, &TableDataRow{contract, scope, table, 2, payer, otherPayer, false, []byte{0x01}}),
+	}
+
+	rows, _, err = db.ReadRAMPayerRows(ctx, 4, payer, "", 0, moveRow)
+	require.NoError(t, err)
+	assert.Equal(t, []*RAMPayerRow{
+		{payer, contract, table, scope, 2, 9, false},
+	}, rows)
+
+	rows, _, err = db.ReadRAMPayerRows(ctx, 4, otherPayer, "", 0, moveRow)
+	require.NoError(t, err)
+	assert.Equal(t, []*RAMPayerRow{
+		{otherPayer, contract, table, scope, 1, 10, false},
+	}, rows)
+}
+
+func TestReadRAMPayerRows_Pagination(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	ctx := context.Background()
+	payer, otherPayer := N("alice"), N("bob")
+	contract, table, scope := N("eosio.token"), N("accounts"), N("alice")
+
+	executeWriteRequests(t, db,
+		tableDataRows(2,
+			&TableDataRow{contract, scope, table, 1, payer, 0, false, []byte{0x01}},
+			&TableDataRow{contract, scope, table, 2, payer, 0, false, []byte{0x02}},
+			&TableDataRow{contract, scope, table, 3, payer, 0, false, []byte{0x03}},
+		),
+	)
+
+	// Indexed, only the rows needed to fill a page are read
+	db.idxCache.ScheduleIndex((&RAMPayerRow{Payer: payer}).tableKey(), 2)
+	require.NoError(t, db.IndexTables(ctx))
+
+	row := func(primaryKey uint64) *RAMPayerRow {
+		return &RAMPayerRow{payer, contract, table, scope, primaryKey, 9, false}
+	}
+
+	rows, nextCursor, err := db.ReadRAMPayerRows(ctx, 2, payer, "", 2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*RAMPayerRow{row(1), row(2)}, rows)
+	assert.Equal(t, row(3).primKey(), nextCursor)
+
+	rows, nextCursor, err = db.ReadRAMPayerRows(ctx, 2, payer, nextCursor, 2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*RAMPayerRow{row(3)}, rows)
+	assert.Empty(t, nextCursor)
+
+	// Speculative moves out of the page must not leave it short
+	moveRow := []*WriteRequest{
+		tableDataRows(3, &TableDataRow{contract, scope, table, 2, otherPayer, payer, false, []byte{0x02}}),
+	}
+
+	rows, nextCursor, err = db.ReadRAMPayerRows(ctx, 2, payer, "", 2, moveRow)
+	require.NoError(t, err)
+	assert.Equal(t, []*RAMPayerRow{row(1), row(3)}, rows)
+	assert.Empty(t, nextCursor)
+
+	_, _, err = db.ReadRAMPayerRows(ctx, 2, payer, "invalid", 2, nil)
+	assert.Error(t, err)
+}
+
+func TestBackfillRAMPayerRows(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	ctx := context.Background()
+	payer, otherPayer := N("alice"), N("bob")
+	contract, table, scope := N("eosio.token"), N("accounts"), N("alice")
+
+	// A database written before the mappings were introduced only has the table rows
+	batch := db.store.NewBatch(zlog)
+	db.writeRow(batch, 2, &TableDataRow{contract, scope, table, 1, payer, 0, false, []byte{0x01}}, false)
+	db.writeRow(batch, 2, &TableDataRow{contract, scope, table, 2, payer, 0, false, []byte{0x02}}, false)
+	db.writeRow(batch, 3, &TableDataRow{contract, scope, table, 1, otherPayer, payer, false, []byte{0x01, 0x02}}, false)
+	db.writeRow(batch, 3, &TableDataRow{contract, scope, table, 2, 0, payer, true, nil}, false)
+	batch.SetLast(lastBlockRowKey, []byte("00000003"+strings.Repeat("aa", 28)))
+	require.NoError(t, batch.Flush(ctx))
+
+	startBlockNum, err := db.FetchRAMPayerStartBlockNum(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), startBlockNum)
+
+	// Writing more blocks doesn't make the mappings complete
+	write := tableDataRows(4, &TableDataRow{contract, scope, table, 3, payer, 0, false, []byte{0x03}})
+	write.BlockID = append([]byte{0x00, 0x00, 0x00, 0x04}, bytes.Repeat([]byte{0xaa}, 28)...)
+	executeWriteRequests(t, db, write)
+
+	startBlockNum, err = db.FetchRAMPayerStartBlockNum(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), startBlockNum)
+
+	blockNum, err := db.BackfillRAMPayerRows(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4), blockNum)
+
+	startBlockNum, err = db.FetchRAMPayerStartBlockNum(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4), startBlockNum)
+
+	rows, _, err := db.ReadRAMPayerRows(ctx, 4, otherPayer, "", 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*RAMPayerRow{{otherPayer, contract, table, scope, 1, 10, false}}, rows)
+
+	rows, _, err = db.ReadRAMPayerRows(ctx, 4, payer, "", 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*RAMPayerRow{{payer, contract, table, scope, 3, 9, false}}, rows)
+
+	// Running it again leaves the start block untouched
+	blockNum, err = db.BackfillRAMPayerRows(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4), blockNum)
+}
+
+func TestRAMPayerStartBlockNum_EmptyDatabase(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	ctx := context.Background()
+	executeWriteRequests(t, db, tableDataRows(2, &TableDataRow{N("eosio.token"), N("alice"), N("accounts"), 1, N("alice"), 0, false, []byte{0x01}}))
+	executeWriteRequests(t, db, tableDataRows(3, &TableDataRow{N("eosio.token"), N("alice"), N("accounts"), 2, N("alice"), 0, false, []byte{0x02}}))
+
+	startBlockNum, err := db.FetchRAMPayerStartBlockNum(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), startBlockNum)
+}
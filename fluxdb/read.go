@@ -15,6 +15,7 @@
 package fluxdb
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -28,7 +29,9 @@ import (
 	"github.com/dfuse-io/dtracing"
 	eos "github.com/eoscanada/eos-go"
 	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	"github.com/dfuse-io/logging"
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 )
 
@@ -80,10 +83,61 @@ func (fdb *FluxDB) GetABI(ctx context.Context, blockNum uint32, account uint64,
 	return
 }
 
+// ReadABIHistory returns every ABI revision of the account up to `blockNum`, ordered
+// from the oldest to the most recent one.
+func (fdb *FluxDB) ReadABIHistory(ctx context.Context, blockNum uint32, account uint64, speculativeWrites []*WriteRequest) (out []*ABIRow, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "read abi history", "account", eos.NameToString(account), "block_num", blockNum)
+	defer span.End()
+
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("reading ABI history", zap.Uint64("account", account), zap.Uint32("block_num", blockNum))
+
+	prefixKey := HexName(account) + ":"
+	firstKey := prefixKey + HexRevBlockNum(blockNum)
+	lastKey := prefixKey + HexRevBlockNum(0)
+
+	// ABI keys are ordered from most recent to oldest, so revisions are prepended
+	err = fdb.store.ScanABIs(ctx, firstKey, lastKey, func(key string, rawABI []byte) error {
+		abiBlockNum, err := chunkKeyRevBlockNum(key, prefixKey)
+		if err != nil {
+			return fmt.Errorf("couldn't infer block num in abi key %q: %w", key, err)
+		}
+
+		out = append([]*ABIRow{{Account: account, BlockNum: abiBlockNum, PackedABI: rawABI}}, out...)
+		return nil
+	})
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to scan ABIs of account %q", eos.NameToString(account))
+	}
+
+	zlog.Debug("handling speculative writes", zap.Int("write_count", len(speculativeWrites)))
+	for _, blockWrite := range speculativeWrites {
+		if blockWrite.BlockNum > blockNum {
+			continue
+		}
+
+		for _, speculativeABI := range blockWrite.ABIs {
+			if speculativeABI.Account == account {
+				out = append(out, &ABIRow{Account: account, BlockNum: blockWrite.BlockNum, PackedABI: speculativeABI.PackedABI})
+			}
+		}
+	}
+
+	if len(out) == 0 {
+		return nil, DataABINotFoundError(ctx, eos.NameToString(account), blockNum)
+	}
+
+	return out, nil
+}
+
 func (fdb *FluxDB) ReadTable(ctx context.Context, r *ReadTableRequest) (resp *ReadTableResponse, err error) {
 	zlog := logging.Logger(ctx, zlog)
 	zlog.Debug("reading state table", zap.Reflect("request", r))
 
+	if r.isSecondaryIndexRead() {
+		return fdb.readTableBySecondaryIndex(ctx, r)
+	}
+
 	rowData := make(map[string]*TableRow)
 	rowUpdated := func(blockNum uint32, primaryKey string, value []byte) error {
 		if len(value) < 8 {
@@ -106,8 +160,19 @@ func (fdb *FluxDB) ReadTable(ctx context.Context, r *ReadTableRequest) (resp *Re
 		return nil
 	}
 
+	lowerPrimaryKey, upperPrimaryKey, err := r.primaryKeyRange()
+	if err != nil {
+		return nil, err
+	}
+
+	readLimit := 0
+	if offset, limit := r.pagination(); limit > 0 {
+		// Speculative deletions remove rows read from the database, enough of them must be read to still fill the page
+		readLimit = offset + limit + r.speculativeDeletionCount()
+	}
+
 	tableKey := r.tableKey()
-	err = fdb.read(ctx, tableKey, r.BlockNum, rowUpdated, rowDeleted)
+	err = fdb.readRange(ctx, tableKey, r.BlockNum, lowerPrimaryKey, upperPrimaryKey, readLimit, r.Reverse, rowUpdated, rowDeleted)
 	if err != nil {
 		return nil, derr.Wrapf(err, "unable to read rows for table key %q", tableKey)
 	}
@@ -125,6 +190,9 @@ func (fdb *FluxDB) ReadTable(ctx context.Context, r *ReadTableRequest) (resp *Re
 			}
 
 			stringPrimaryKey := fmt.Sprintf("%016x", row.PrimKey)
+			if !primaryKeyInRange(stringPrimaryKey, lowerPrimaryKey, upperPrimaryKey) {
+				continue
+			}
 
 			if row.Deletion {
 				delete(rowData, stringPrimaryKey)
@@ -145,11 +213,165 @@ func (fdb *FluxDB) ReadTable(ctx context.Context, r *ReadTableRequest) (resp *Re
 		rows = append(rows, row)
 	}
 
-	zlog.Debug("sorting table rows")
-	sort.Slice(rows, func(i, j int) bool { return rows[i].Key < rows[j].Key })
+	zlog.Debug("sorting table rows", zap.Bool("reverse", r.Reverse))
+	if r.Reverse {
+		sort.Slice(rows, func(i, j int) bool { return rows[i].Key > rows[j].Key })
+	} else {
+		sort.Slice(rows, func(i, j int) bool { return rows[i].Key < rows[j].Key })
+	}
+
+	start, end := r.page(len(rows))
 
 	return &ReadTableResponse{
 		ABI:  abi,
+		Rows: rows[start:end],
+	}, nil
+}
+
+// readTableBySecondaryIndex reads the entries of the secondary index selected by
+// the request within bounds, only the ones needed to fill the requested page when
+// paginated, and returns the matching table rows ordered by secondary key (and then
+// primary key, like nodeos does). Entries being stored in that order, the bounds
+// and limit are applied by the store range read.
+func (fdb *FluxDB) readTableBySecondaryIndex(ctx context.Context, r *ReadTableRequest) (resp *ReadTableResponse, err error) {
+	zlog := logging.Logger(ctx, zlog)
+
+	type indexEntry struct {
+		entryKey   string
+		primaryKey uint64
+	}
+
+	entries := make(map[string]*indexEntry)
+	rowUpdated := func(blockNum uint32, entryKey string, value []byte) error {
+		if len(value) < 2 {
+			return errors.New("secondary index mappings should contain at least the kind and one key byte")
+		}
+
+		if r.LowerBound != nil && len(r.LowerBound) != len(value)-1 {
+			return fmt.Errorf("lower bound has %d bytes while secondary index keys have %d bytes", len(r.LowerBound), len(value)-1)
+		}
+
+		if r.UpperBound != nil && len(r.UpperBound) != len(value)-1 {
+			return fmt.Errorf("upper bound has %d bytes while secondary index keys have %d bytes", len(r.UpperBound), len(value)-1)
+		}
+
+		primaryKey, err := secondaryIndexEntryPrimaryKey(entryKey)
+		if err != nil {
+			return derr.Wrap(err, "unable to transform secondary index primary key to uint64")
+		}
+
+		entries[entryKey] = &indexEntry{entryKey, primaryKey}
+		return nil
+	}
+
+	rowDeleted := func(blockNum uint32, entryKey string) error {
+		delete(entries, entryKey)
+		return nil
+	}
+
+	readLimit := 0
+	if offset, limit := r.pagination(); limit > 0 {
+		// Speculative deletions remove entries read from the database, enough of them must be read to still fill the page
+		readLimit = offset + limit + r.speculativeDeletionCount()
+	}
+
+	lowerEntryKey, upperEntryKey := r.secondaryKeyRange()
+
+	tableKey := r.secondaryIndexTableKey()
+	err = fdb.readRange(ctx, tableKey, r.BlockNum, lowerEntryKey, upperEntryKey, readLimit, r.Reverse, rowUpdated, rowDeleted)
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to read secondary index rows for table key %q", tableKey)
+	}
+
+	zlog.Debug("handling secondary index speculative writes", zap.Int("write_count", len(r.SpeculativeWrites)))
+	position := r.secondaryIndexPosition()
+	for _, blockWrite := range r.SpeculativeWrites {
+		for _, row := range blockWrite.SecondaryIndexes {
+			if r.Account != row.Account || r.Scope != row.Scope || r.Table != row.Table || position != row.IndexPosition {
+				continue
+			}
+
+			entryKey := row.primKey()
+			if !primaryKeyInRange(entryKey, lowerEntryKey, upperEntryKey) {
+				continue
+			}
+
+			if row.Deletion {
+				delete(entries, entryKey)
+			} else {
+				entries[entryKey] = &indexEntry{entryKey, row.PrimKey}
+			}
+		}
+	}
+
+	selected := make([]*indexEntry, 0, len(entries))
+	for _, entry := range entries {
+		selected = append(selected, entry)
+	}
+
+	zlog.Debug("sorting secondary index entries", zap.Int("entry_count", len(selected)), zap.Bool("reverse", r.Reverse))
+	if r.Reverse {
+		sort.Slice(selected, func(i, j int) bool { return selected[i].entryKey > selected[j].entryKey })
+	} else {
+		sort.Slice(selected, func(i, j int) bool { return selected[i].entryKey < selected[j].entryKey })
+	}
+
+	start, end := r.page(len(selected))
+	selected = selected[start:end]
+
+	if len(selected) == 0 {
+		abi, err := fdb.GetABI(ctx, r.BlockNum, r.Account, r.SpeculativeWrites)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ReadTableResponse{ABI: abi}, nil
+	}
+
+	// Only the primary keys spanned by the selected entries need to be read
+	minPrimaryKey, maxPrimaryKey := selected[0].primaryKey, selected[0].primaryKey
+	for _, entry := range selected[1:] {
+		if entry.primaryKey < minPrimaryKey {
+			minPrimaryKey = entry.primaryKey
+		}
+
+		if entry.primaryKey > maxPrimaryKey {
+			maxPrimaryKey = entry.primaryKey
+		}
+	}
+
+	primaryRequest := *r
+	primaryRequest.IndexPosition = 0
+	primaryRequest.LowerBound = make([]byte, 8)
+	primaryRequest.UpperBound = make([]byte, 8)
+	primaryRequest.Reverse = false
+	primaryRequest.Offset, primaryRequest.Limit = nil, nil
+	big.PutUint64(primaryRequest.LowerBound, minPrimaryKey)
+	big.PutUint64(primaryRequest.UpperBound, maxPrimaryKey)
+
+	primaryResp, err := fdb.ReadTable(ctx, &primaryRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	rowByPrimaryKey := make(map[uint64]*TableRow, len(primaryResp.Rows))
+	for _, row := range primaryResp.Rows {
+		rowByPrimaryKey[row.Key] = row
+	}
+
+	rows := make([]*TableRow, 0, len(selected))
+	for _, entry := range selected {
+		row, found := rowByPrimaryKey[entry.primaryKey]
+		if !found {
+			zlog.Warn("secondary index entry points to a missing table row, skipping it", zap.String("table_key", tableKey), zap.Uint64("primary_key", entry.primaryKey))
+			continue
+		}
+
+		rows = append(rows, row)
+	}
+
+	return &ReadTableResponse{
+		ABI:  primaryResp.ABI,
 		Rows: rows,
 	}, nil
 }
@@ -223,6 +445,414 @@ func (fdb *FluxDB) ReadTableRow(ctx context.Context, r *ReadTableRowRequest) (re
 	}, nil
 }
 
+// ReadTableRows reads all the requested rows of a table in a single pass over
+// the store. Rows are returned in the order of the requested primary keys.
+func (fdb *FluxDB) ReadTableRows(ctx context.Context, r *ReadTableRowsRequest) (resp *ReadTableResponse, err error) {
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("reading state table rows", zap.Reflect("request", r))
+
+	primaryKeys := make([]string, len(r.PrimaryKeys))
+	for i, primaryKey := range r.PrimaryKeys {
+		primaryKeys[i] = fmt.Sprintf("%016x", primaryKey)
+	}
+
+	rowData := make(map[string]*TableRow)
+	rowUpdated := func(blockNum uint32, primaryKey string, value []byte) error {
+		row, err := tableRowFromValue(blockNum, primaryKey, value)
+		if err != nil {
+			return err
+		}
+
+		rowData[primaryKey] = row
+		return nil
+	}
+
+	rowDeleted := func(blockNum uint32, primaryKey string) error {
+		delete(rowData, primaryKey)
+		return nil
+	}
+
+	tableKey := r.tableKey()
+	err = fdb.readMany(ctx, tableKey, primaryKeys, r.BlockNum, rowUpdated, rowDeleted)
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to read rows for table key %q", tableKey)
+	}
+
+	abi, err := fdb.GetABI(ctx, r.BlockNum, r.Account, r.SpeculativeWrites)
+	if err != nil {
+		return nil, err
+	}
+
+	zlog.Debug("handling speculative writes", zap.Int("write_count", len(r.SpeculativeWrites)))
+	for _, blockWrite := range r.SpeculativeWrites {
+		for _, row := range blockWrite.TableDatas {
+			if r.Account != row.Account || r.Scope != row.Scope || r.Table != row.Table {
+				continue
+			}
+
+			stringPrimaryKey := fmt.Sprintf("%016x", row.PrimKey)
+			if row.Deletion {
+				delete(rowData, stringPrimaryKey)
+			} else {
+				rowData[stringPrimaryKey] = &TableRow{
+					Key:      row.PrimKey,
+					Payer:    row.Payer,
+					Data:     row.Data,
+					BlockNum: blockWrite.BlockNum,
+				}
+			}
+		}
+	}
+
+	var rows []*TableRow
+	for _, primaryKey := range primaryKeys {
+		if row, found := rowData[primaryKey]; found {
+			rows = append(rows, row)
+		}
+	}
+
+	return &ReadTableResponse{
+		ABI:  abi,
+		Rows: rows,
+	}, nil
+}
+
+func (fdb *FluxDB) ReadTableRowHistory(ctx context.Context, r *ReadTableRowHistoryRequest) (resp *ReadTableRowHistoryResponse, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "read table row history", "from_block_num", r.FromBlockNum, "to_block_num", r.ToBlockNum)
+	defer span.End()
+
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("reading state table row history", zap.Reflect("request", r))
+
+	if r.FromBlockNum > r.ToBlockNum {
+		return nil, fmt.Errorf("from block num %d is higher than to block num %d", r.FromBlockNum, r.ToBlockNum)
+	}
+
+	tableKey := r.tableKey()
+	primaryKeyString := r.primaryKeyString()
+
+	// Table data row keys are ordered by block num first, so the whole table must be
+	// scanned for the block range, keeping only the rows of the requested primary key.
+	firstRowKey := tableKey + ":" + HexBlockNum(r.FromBlockNum)
+	lastRowKey := tableKey + ":" + HexBlockNum(r.ToBlockNum+1)
+
+	zlog.Debug("reading row history range from database", zap.String("first_row_key", firstRowKey), zap.String("last_row_key", lastRowKey))
+
+	resp = &ReadTableRowHistoryResponse{}
+	err = fdb.store.ScanTabletRows(ctx, firstRowKey, lastRowKey, func(rowKey string, value []byte) error {
+		_, rowBlockNum, candidatePrimaryKey, err := explodeWritableRowKey(rowKey)
+		if err != nil {
+			return fmt.Errorf("couldn't parse row key %q: %w", rowKey, err)
+		}
+
+		if candidatePrimaryKey != primaryKeyString {
+			return nil
+		}
+
+		mutation := &TableRowMutation{BlockNum: rowBlockNum}
+		if len(value) == 0 {
+			mutation.Deletion = true
+		} else {
+			if len(value) < 8 {
+				return fmt.Errorf("table data row %q should contain at least the payer", rowKey)
+			}
+
+			mutation.Row = &TableRow{r.PrimaryKey, big.Uint64(value), value[8:], rowBlockNum}
+		}
+
+		resp.Mutations = append(resp.Mutations, mutation)
+		return nil
+	})
+
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to read row history for table key %q and primary key %d", tableKey, r.PrimaryKey)
+	}
+
+	zlog.Debug("handling speculative writes", zap.Int("write_count", len(r.SpeculativeWrites)))
+	for _, blockWrite := range r.SpeculativeWrites {
+		if blockWrite.BlockNum < r.FromBlockNum || blockWrite.BlockNum > r.ToBlockNum {
+			continue
+		}
+
+		for _, row := range blockWrite.TableDatas {
+			if r.Account != row.Account || r.Scope != row.Scope || r.Table != row.Table || r.PrimaryKey != row.PrimKey {
+				continue
+			}
+
+			mutation := &TableRowMutation{BlockNum: blockWrite.BlockNum, Deletion: row.Deletion}
+			if !row.Deletion {
+				mutation.Row = &TableRow{
+					Key:      row.PrimKey,
+					Payer:    row.Payer,
+					Data:     row.Data,
+					BlockNum: blockWrite.BlockNum,
+				}
+			}
+
+			resp.Mutations = append(resp.Mutations, mutation)
+		}
+	}
+
+	zlog.Debug("read row history results", zap.Int("mutation_count", len(resp.Mutations)))
+	return resp, nil
+}
+
+// DiffTable computes the rows of a table that changed between two blocks. Only the
+// rows written in the `(FromBlockNum, ToBlockNum]` range are scanned, the state of
+// the touched rows at `FromBlockNum` being then read through the table index.
+func (fdb *FluxDB) DiffTable(ctx context.Context, r *DiffTableRequest) (resp *DiffTableResponse, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "diff table", "from_block_num", r.FromBlockNum, "to_block_num", r.ToBlockNum)
+	defer span.End()
+
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("diffing state table", zap.Reflect("request", r))
+
+	if r.FromBlockNum > r.ToBlockNum {
+		return nil, fmt.Errorf("from block num %d is higher than to block num %d", r.FromBlockNum, r.ToBlockNum)
+	}
+
+	tableKey := r.tableKey()
+	firstRowKey := tableKey + ":" + HexBlockNum(r.FromBlockNum+1)
+	lastRowKey := tableKey + ":" + HexBlockNum(r.ToBlockNum+1)
+
+	// A `nil` row means the row was deleted by the last mutation seen for the primary key
+	toRows := map[string]*TableRow{}
+
+	zlog.Debug("reading changed rows range from database", zap.String("first_row_key", firstRowKey), zap.String("last_row_key", lastRowKey))
+	err = fdb.store.ScanTabletRows(ctx, firstRowKey, lastRowKey, func(rowKey string, value []byte) error {
+		_, rowBlockNum, primaryKey, err := explodeWritableRowKey(rowKey)
+		if err != nil {
+			return fmt.Errorf("couldn't parse row key %q: %w", rowKey, err)
+		}
+
+		if len(value) == 0 {
+			toRows[primaryKey] = nil
+			return nil
+		}
+
+		row, err := tableRowFromValue(rowBlockNum, primaryKey, value)
+		if err != nil {
+			return derr.Wrapf(err, "invalid table data row %q", rowKey)
+		}
+
+		toRows[primaryKey] = row
+		return nil
+	})
+
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to read changed rows for table key %q", tableKey)
+	}
+
+	fromRows := map[string]*TableRow{}
+
+	zlog.Debug("handling speculative writes", zap.Int("write_count", len(r.SpeculativeWrites)))
+	for _, blockWrite := range r.SpeculativeWrites {
+		if blockWrite.BlockNum <= r.FromBlockNum || blockWrite.BlockNum > r.ToBlockNum {
+			continue
+		}
+
+		for _, row := range blockWrite.TableDatas {
+			if r.Account != row.Account || r.Scope != row.Scope || r.Table != row.Table {
+				continue
+			}
+
+			stringPrimaryKey := fmt.Sprintf("%016x", row.PrimKey)
+			if row.Deletion {
+				toRows[stringPrimaryKey] = nil
+			} else {
+				toRows[stringPrimaryKey] = &TableRow{
+					Key:      row.PrimKey,
+					Payer:    row.Payer,
+					Data:     row.Data,
+					BlockNum: blockWrite.BlockNum,
+				}
+			}
+		}
+	}
+
+	resp = &DiffTableResponse{}
+	resp.ToABI, err = fdb.GetABI(ctx, r.ToBlockNum, r.Account, SpeculativeWritesUpTo(r.SpeculativeWrites, r.ToBlockNum))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(toRows) == 0 {
+		zlog.Debug("no rows changed in block range")
+		return resp, nil
+	}
+
+	lowerPrimaryKey, upperPrimaryKey := "", ""
+	for primaryKey := range toRows {
+		if lowerPrimaryKey == "" || primaryKey < lowerPrimaryKey {
+			lowerPrimaryKey = primaryKey
+		}
+
+		if upperPrimaryKey == "" || primaryKey > upperPrimaryKey {
+			upperPrimaryKey = primaryKey
+		}
+	}
+
+	rowUpdated := func(blockNum uint32, primaryKey string, value []byte) error {
+		if _, touched := toRows[primaryKey]; !touched {
+			return nil
+		}
+
+		row, err := tableRowFromValue(blockNum, primaryKey, value)
+		if err != nil {
+			return err
+		}
+
+		fromRows[primaryKey] = row
+		return nil
+	}
+
+	rowDeleted := func(blockNum uint32, primaryKey string) error {
+		delete(fromRows, primaryKey)
+		return nil
+	}
+
+	err = fdb.readRange(ctx, tableKey, r.FromBlockNum, lowerPrimaryKey, upperPrimaryKey, 0, false, rowUpdated, rowDeleted)
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to read rows for table key %q", tableKey)
+	}
+
+	for _, blockWrite := range SpeculativeWritesUpTo(r.SpeculativeWrites, r.FromBlockNum) {
+		for _, row := range blockWrite.TableDatas {
+			if r.Account != row.Account || r.Scope != row.Scope || r.Table != row.Table {
+				continue
+			}
+
+			stringPrimaryKey := fmt.Sprintf("%016x", row.PrimKey)
+			if _, touched := toRows[stringPrimaryKey]; !touched {
+				continue
+			}
+
+			if row.Deletion {
+				delete(fromRows, stringPrimaryKey)
+			} else {
+				fromRows[stringPrimaryKey] = &TableRow{
+					Key:      row.PrimKey,
+					Payer:    row.Payer,
+					Data:     row.Data,
+					BlockNum: blockWrite.BlockNum,
+				}
+			}
+		}
+	}
+
+	for primaryKey, toRow := range toRows {
+		fromRow := fromRows[primaryKey]
+
+		switch {
+		case fromRow == nil && toRow != nil:
+			resp.Inserted = append(resp.Inserted, toRow)
+		case fromRow != nil && toRow == nil:
+			resp.Removed = append(resp.Removed, fromRow)
+		case fromRow != nil && toRow != nil:
+			if fromRow.Payer != toRow.Payer || !bytes.Equal(fromRow.Data, toRow.Data) {
+				resp.Updated = append(resp.Updated, &TableRowUpdate{Old: fromRow, New: toRow})
+			}
+		}
+	}
+
+	sort.Slice(resp.Inserted, func(i, j int) bool { return resp.Inserted[i].Key < resp.Inserted[j].Key })
+	sort.Slice(resp.Updated, func(i, j int) bool { return resp.Updated[i].New.Key < resp.Updated[j].New.Key })
+	sort.Slice(resp.Removed, func(i, j int) bool { return resp.Removed[i].Key < resp.Removed[j].Key })
+
+	if len(resp.Updated) > 0 || len(resp.Removed) > 0 {
+		resp.FromABI, err = fdb.GetABI(ctx, r.FromBlockNum, r.Account, SpeculativeWritesUpTo(r.SpeculativeWrites, r.FromBlockNum))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	zlog.Debug("diff table results",
+		zap.Int("inserted_count", len(resp.Inserted)),
+		zap.Int("updated_count", len(resp.Updated)),
+		zap.Int("removed_count", len(resp.Removed)),
+	)
+
+	return resp, nil
+}
+
+// ScanTableWrites walks the table rows written in the requested block range, calling
+// `onBlock` once for each block that wrote at least one row, in block order.
+func (fdb *FluxDB) ScanTableWrites(ctx context.Context, r *ScanTableWritesRequest, onBlock func(blockNum uint32, rows []*TableDataRow) error) error {
+	ctx, span := dtracing.StartSpan(ctx, "scan table writes", "from_block_num", r.FromBlockNum, "to_block_num", r.ToBlockNum)
+	defer span.End()
+
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("scanning state table writes", zap.Reflect("request", r))
+
+	if r.FromBlockNum > r.ToBlockNum {
+		return fmt.Errorf("from block num %d is higher than to block num %d", r.FromBlockNum, r.ToBlockNum)
+	}
+
+	tableKey := r.tableKey()
+	firstRowKey := tableKey + ":" + HexBlockNum(r.FromBlockNum)
+	lastRowKey := tableKey + ":" + HexBlockNum(r.ToBlockNum+1)
+
+	var currentBlockNum uint32
+	var currentRows []*TableDataRow
+
+	err := fdb.store.ScanTabletRows(ctx, firstRowKey, lastRowKey, func(rowKey string, value []byte) error {
+		_, rowBlockNum, primaryKey, err := explodeWritableRowKey(rowKey)
+		if err != nil {
+			return fmt.Errorf("couldn't parse row key %q: %w", rowKey, err)
+		}
+
+		if len(currentRows) > 0 && rowBlockNum != currentBlockNum {
+			if err := onBlock(currentBlockNum, currentRows); err != nil {
+				return err
+			}
+
+			currentRows = nil
+		}
+		currentBlockNum = rowBlockNum
+
+		row := &TableDataRow{Account: r.Account, Scope: r.Scope, Table: r.Table, Deletion: len(value) == 0}
+		if row.Deletion {
+			row.PrimKey, err = strconv.ParseUint(primaryKey, 16, 64)
+			if err != nil {
+				return derr.Wrap(err, "unable to transform table data primary key to uint64")
+			}
+		} else {
+			tableRow, err := tableRowFromValue(rowBlockNum, primaryKey, value)
+			if err != nil {
+				return derr.Wrapf(err, "invalid table data row %q", rowKey)
+			}
+
+			row.PrimKey, row.Payer, row.Data = tableRow.Key, tableRow.Payer, tableRow.Data
+		}
+
+		currentRows = append(currentRows, row)
+		return nil
+	})
+
+	if err != nil {
+		return derr.Wrapf(err, "unable to scan writes for table key %q", tableKey)
+	}
+
+	if len(currentRows) > 0 {
+		return onBlock(currentBlockNum, currentRows)
+	}
+
+	return nil
+}
+
+func tableRowFromValue(blockNum uint32, primaryKey string, value []byte) (*TableRow, error) {
+	if len(value) < 8 {
+		return nil, errors.New("table data index mappings should contain at least the payer")
+	}
+
+	key, err := strconv.ParseUint(primaryKey, 16, 64)
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to transform table data primary key to uint64")
+	}
+
+	return &TableRow{key, big.Uint64(value), value[8:], blockNum}, nil
+}
+
 func (fdb *FluxDB) HasSeenPublicKeyOnce(
 	ctx context.Context,
 	publicKey string,
@@ -301,82 +931,200 @@ func (fdb *FluxDB) ReadKeyAccounts(
 		return accountNames[i] < accountNames[j]
 	})
 
-	return accountNames, nil
+	return accountNames, nil
+}
+
+func (fdb *FluxDB) ReadLinkedPermissions(ctx context.Context, blockNum uint32, account eos.AccountName, speculativeWrites []*WriteRequest) (resp []*LinkedPermission, err error) {
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("reading linked permissions", zap.String("account", string(account)), zap.Uint32("block_num", blockNum))
+
+	rowData := make(map[string]*LinkedPermission)
+	rowUpdated := func(blockNum uint32, primaryKey string, value []byte) error {
+		primaryKeyBuffer := make([]byte, indexPrimaryKeyByteCountByTableKey("al:"))
+		err := authLinkIndexPrimaryKeyWriter(primaryKey, primaryKeyBuffer)
+		if err != nil {
+			return derr.Wrap(err, "unable to transform auth link primary key")
+		}
+
+		contract := big.Uint64(primaryKeyBuffer)
+		action := big.Uint64(primaryKeyBuffer[8:])
+		permissionName := big.Uint64(value)
+
+		rowData[primaryKey] = &LinkedPermission{
+			Contract:       eos.NameToString(contract),
+			Action:         eos.NameToString(action),
+			PermissionName: eos.NameToString(permissionName),
+		}
+		return nil
+	}
+
+	rowDeleted := func(blockNum uint32, primaryKey string) error {
+		delete(rowData, primaryKey)
+		return nil
+	}
+
+	tableKey := fmt.Sprintf("al:%016x", N(string(account)))
+	err = fdb.read(ctx, tableKey, blockNum, rowUpdated, rowDeleted)
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to read rows for table key %q", tableKey)
+	}
+
+	zlog.Debug("handling speculative writes#", zap.Int("write_count", len(speculativeWrites)))
+	for _, blockWrite := range speculativeWrites {
+		for _, row := range blockWrite.AuthLinks {
+			if row.Account != N(string(account)) {
+				continue
+			}
+
+			zlog.Debug("updating auth link", zap.Reflect("auth_link_row", row))
+			stringPrimaryKey := fmt.Sprintf("%016x:%016x", row.Contract, row.Action)
+
+			if row.Deletion {
+				delete(rowData, stringPrimaryKey)
+			} else {
+				rowData[stringPrimaryKey] = &LinkedPermission{
+					Contract:       eos.NameToString(row.Contract),
+					Action:         eos.NameToString(row.Action),
+					PermissionName: eos.NameToString(row.PermissionName),
+				}
+			}
+		}
+	}
+
+	zlog.Debug("post-processing linked permissions", zap.Int("link_permission_count", len(rowData)))
+	var output []*LinkedPermission
+	for _, row := range rowData {
+		output = append(output, row)
+	}
+
+	zlog.Debug("sorting linked permissions")
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].Contract == output[j].Contract {
+			return output[i].Action < output[j].Action
+		}
+
+		return output[i].Contract < output[j].Contract
+	})
+
+	return output, nil
+}
+
+// ReadAccountPermissions returns the permissions of `account` as they were at `blockNum`,
+// sorted by permission name.
+func (fdb *FluxDB) ReadAccountPermissions(ctx context.Context, blockNum uint32, account eos.AccountName, speculativeWrites []*WriteRequest) (resp []*AccountPermission, err error) {
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("reading account permissions", zap.String("account", string(account)), zap.Uint32("block_num", blockNum))
+
+	rowData := make(map[string]*AccountPermission)
+	rowUpdated := func(blockNum uint32, primaryKey string, value []byte) error {
+		permission := &pbcodec.PermissionObject{}
+		if err := proto.Unmarshal(value, permission); err != nil {
+			return derr.Wrapf(err, "unable to decode account permission %q", primaryKey)
+		}
+
+		rowData[primaryKey] = &AccountPermission{BlockNum: blockNum, Permission: permission}
+		return nil
+	}
+
+	rowDeleted := func(blockNum uint32, primaryKey string) error {
+		delete(rowData, primaryKey)
+		return nil
+	}
+
+	tableKey := fmt.Sprintf("ap:%016x", N(string(account)))
+	err = fdb.read(ctx, tableKey, blockNum, rowUpdated, rowDeleted)
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to read rows for table key %q", tableKey)
+	}
+
+	zlog.Debug("handling speculative writes", zap.Int("write_count", len(speculativeWrites)))
+	for _, blockWrite := range speculativeWrites {
+		for _, row := range blockWrite.AccountPermissions {
+			if row.Account != N(string(account)) {
+				continue
+			}
+
+			if row.Deletion {
+				delete(rowData, row.primKey())
+				continue
+			}
+
+			if err := rowUpdated(blockWrite.BlockNum, row.primKey(), row.Data); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	zlog.Debug("post-processing account permissions", zap.Int("permission_count", len(rowData)))
+	var output []*AccountPermission
+	for _, row := range rowData {
+		output = append(output, row)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].Permission.Name < output[j].Permission.Name
+	})
+
+	return output, nil
 }
 
-func (fdb *FluxDB) ReadLinkedPermissions(ctx context.Context, blockNum uint32, account eos.AccountName, speculativeWrites []*WriteRequest) (resp []*LinkedPermission, err error) {
+// ReadAccountResources returns the resource limits and usage of `account` as they were
+// at `blockNum`.
+func (fdb *FluxDB) ReadAccountResources(ctx context.Context, blockNum uint32, account eos.AccountName, speculativeWrites []*WriteRequest) (resp *AccountResources, err error) {
 	zlog := logging.Logger(ctx, zlog)
-	zlog.Debug("reading linked permissions", zap.String("account", string(account)), zap.Uint32("block_num", blockNum))
+	zlog.Debug("reading account resources", zap.String("account", string(account)), zap.Uint32("block_num", blockNum))
 
-	rowData := make(map[string]*LinkedPermission)
+	resp = &AccountResources{}
 	rowUpdated := func(blockNum uint32, primaryKey string, value []byte) error {
-		primaryKeyBuffer := make([]byte, indexPrimaryKeyByteCountByTableKey("al:"))
-		err := authLinkIndexPrimaryKeyWriter(primaryKey, primaryKeyBuffer)
-		if err != nil {
-			return derr.Wrap(err, "unable to transform auth link primary key")
-		}
+		switch primaryKey {
+		case fmt.Sprintf("%02x", byte(AccountResourceLimitKindLimits)):
+			limits := &pbcodec.RlimitAccountLimits{}
+			if err := proto.Unmarshal(value, limits); err != nil {
+				return derr.Wrap(err, "unable to decode account resource limits")
+			}
 
-		contract := big.Uint64(primaryKeyBuffer)
-		action := big.Uint64(primaryKeyBuffer[8:])
-		permissionName := big.Uint64(value)
+			resp.Limits, resp.LimitsBlockNum = limits, blockNum
 
-		rowData[primaryKey] = &LinkedPermission{
-			Contract:       eos.NameToString(contract),
-			Action:         eos.NameToString(action),
-			PermissionName: eos.NameToString(permissionName),
+		case fmt.Sprintf("%02x", byte(AccountResourceLimitKindUsage)):
+			usage := &pbcodec.RlimitAccountUsage{}
+			if err := proto.Unmarshal(value, usage); err != nil {
+				return derr.Wrap(err, "unable to decode account resource usage")
+			}
+
+			resp.Usage, resp.UsageBlockNum = usage, blockNum
+
+		default:
+			return fmt.Errorf("unknown account resource limit kind %q", primaryKey)
 		}
+
 		return nil
 	}
 
+	// Account resource limits are never deleted
 	rowDeleted := func(blockNum uint32, primaryKey string) error {
-		delete(rowData, primaryKey)
 		return nil
 	}
 
-	tableKey := fmt.Sprintf("al:%016x", N(string(account)))
+	tableKey := fmt.Sprintf("arl:%016x", N(string(account)))
 	err = fdb.read(ctx, tableKey, blockNum, rowUpdated, rowDeleted)
 	if err != nil {
 		return nil, derr.Wrapf(err, "unable to read rows for table key %q", tableKey)
 	}
 
-	zlog.Debug("handling speculative writes#", zap.Int("write_count", len(speculativeWrites)))
+	zlog.Debug("handling speculative writes", zap.Int("write_count", len(speculativeWrites)))
 	for _, blockWrite := range speculativeWrites {
-		for _, row := range blockWrite.AuthLinks {
+		for _, row := range blockWrite.AccountResourceLimits {
 			if row.Account != N(string(account)) {
 				continue
 			}
 
-			zlog.Debug("updating auth link", zap.Reflect("auth_link_row", row))
-			stringPrimaryKey := fmt.Sprintf("%016x:%016x", row.Contract, row.Action)
-
-			if row.Deletion {
-				delete(rowData, stringPrimaryKey)
-			} else {
-				rowData[stringPrimaryKey] = &LinkedPermission{
-					Contract:       eos.NameToString(row.Contract),
-					Action:         eos.NameToString(row.Action),
-					PermissionName: eos.NameToString(row.PermissionName),
-				}
+			if err := rowUpdated(blockWrite.BlockNum, row.primKey(), row.Data); err != nil {
+				return nil, err
 			}
 		}
 	}
 
-	zlog.Debug("post-processing linked permissions", zap.Int("link_permission_count", len(rowData)))
-	var output []*LinkedPermission
-	for _, row := range rowData {
-		output = append(output, row)
-	}
-
-	zlog.Debug("sorting linked permissions")
-	sort.Slice(output, func(i, j int) bool {
-		if output[i].Contract == output[j].Contract {
-			return output[i].Action < output[j].Action
-		}
-
-		return output[i].Contract < output[j].Contract
-	})
-
-	return output, nil
+	return resp, nil
 }
 
 func (fdb *FluxDB) HasSeenTableOnce(
@@ -475,76 +1223,66 @@ func (fdb *FluxDB) read(
 	blockNum uint32,
 	rowUpdated func(blockNum uint32, primaryKey string, value []byte) error,
 	rowDeleted func(blockNum uint32, primaryKey string) error,
+) error {
+	return fdb.readRange(ctx, tableKey, blockNum, "", "", 0, false, rowUpdated, rowDeleted)
+}
+
+// readRange is like `read` but only yields rows whose primary key is within the
+// inclusive `[lowerPrimaryKey, upperPrimaryKey]` range, an empty bound leaving
+// the range open on that side. Indexed rows outside the range are never fetched
+// from the store.
+//
+// When `limit` is greater than 0, only the first `limit` rows, in primary key
+// order (descending when `reverse` is set), are guaranteed to be yielded, the
+// indexed rows past them are not fetched. Deleted rows are then never reported.
+func (fdb *FluxDB) readRange(
+	ctx context.Context,
+	tableKey string,
+	blockNum uint32,
+	lowerPrimaryKey string,
+	upperPrimaryKey string,
+	limit int,
+	reverse bool,
+	rowUpdated func(blockNum uint32, primaryKey string, value []byte) error,
+	rowDeleted func(blockNum uint32, primaryKey string) error,
 ) error {
 	ctx, span := dtracing.StartSpan(ctx, "read table", "table_key", tableKey, "block_num", blockNum)
 	defer span.End()
 
 	zlog := logging.Logger(ctx, zlog)
-	zlog.Debug("reading rows from database", zap.String("table_key", tableKey), zap.Uint32("block_num", blockNum))
+	zlog.Debug("reading rows from database",
+		zap.String("table_key", tableKey),
+		zap.Uint32("block_num", blockNum),
+		zap.String("lower_primary_key", lowerPrimaryKey),
+		zap.String("upper_primary_key", upperPrimaryKey),
+		zap.Int("limit", limit),
+	)
 
 	idx, err := fdb.getIndex(ctx, tableKey, blockNum)
 	if err != nil {
 		return err
 	}
 
-	firstRowKey := tableKey + ":00000000"
-	lastRowKey := tableKey + ":" + HexBlockNum(blockNum+1)
+	if idx != nil && limit > 0 {
+		return fdb.readRangeWithLimit(ctx, tableKey, blockNum, idx, lowerPrimaryKey, upperPrimaryKey, limit, reverse, rowUpdated)
+	}
 
+	fromBlockNum := uint32(0)
 	if idx != nil {
 		zlog.Debug("index exists, reconciling it", zap.Int("row_count", len(idx.Map)))
-		firstRowKey = tableKey + ":" + HexBlockNum(idx.AtBlockNum+1)
+		fromBlockNum = idx.AtBlockNum + 1
 
 		var keys []string
 		for primaryKey, blockNum := range idx.Map {
-			keys = append(keys, fmt.Sprintf("%s:%08x:%s", tableKey, blockNum, primaryKey))
-		}
-
-		// Fetch all rows in the index.. could be millions
-		// We need to batch so that the RowList, when serialized, doesn't blow up 1MB
-		// We should batch in 10,000 key reads, we can parallelize those...
-		chunkSize := 5000
-		chunks := int(math.Ceil(float64(len(keys)) / float64(chunkSize)))
-
-		zlog.Debug("reading index rows chunks", zap.Int("chunk_count", chunks))
-		for i := 0; i < chunks; i++ {
-			chunkStart := i * chunkSize
-			chunkEnd := (i + 1) * chunkSize
-			max := len(keys)
-			if max < chunkEnd {
-				chunkEnd = max
+			if !primaryKeyInRange(primaryKey, lowerPrimaryKey, upperPrimaryKey) {
+				continue
 			}
 
-			// TODO: triple check boundaries, EASY off-by-one issue here..
-			keysChunk := keys[chunkStart:chunkEnd]
-
-			zlog.Debug("reading index rows chunk", zap.Int("key_count", len(keysChunk)))
-			keyRead := false
-			err := fdb.store.FetchTabletRows(ctx, keysChunk, func(rowKey string, value []byte) error {
-				if len(value) == 0 {
-					return fmt.Errorf("indexes mappings should not contain empty data, empty rows don't make sense in an index, row %s", rowKey)
-				}
-
-				_, rowBlockNum, primaryKey, err := explodeWritableRowKey(rowKey)
-				if err != nil {
-					return fmt.Errorf("couldn't parse row key %q: %w", rowKey, err)
-				}
-
-				err = rowUpdated(rowBlockNum, primaryKey, value)
-				if err != nil {
-					return derr.Wrapf(err, "rowUpdated callback failed for row %q (indexed rows)", rowKey)
-				}
-
-				keyRead = true
-				return nil
-			})
-
-			if err != nil {
-				return derr.Wrap(err, "reading keys chunks")
-			}
+			keys = append(keys, fmt.Sprintf("%s:%08x:%s", tableKey, blockNum, primaryKey))
+		}
 
-			if !keyRead {
-				return fmt.Errorf("reading a indexed key yielded no row: %s", keysChunk)
-			}
+		if err := fdb.fetchIndexedRows(ctx, keys, rowUpdated); err != nil {
+			return err
 		}
 
 		zlog.Debug("finished reconciling index")
@@ -555,17 +1293,10 @@ func (fdb *FluxDB) read(
 	// fetch all the keys within the index
 	// parse all rows following the index, and keep the latest, so simply override with incoming rows..
 
-	zlog.Debug("reading rows range from database", zap.String("first_row_key", firstRowKey), zap.String("last_row_key", lastRowKey))
-
 	deletedCount := 0
 	updatedCount := 0
 
-	err = fdb.store.ScanTabletRows(ctx, firstRowKey, lastRowKey, func(rowKey string, value []byte) error {
-		_, rowBlockNum, primaryKey, err := explodeWritableRowKey(rowKey)
-		if err != nil {
-			return fmt.Errorf("couldn't parse row key %q: %w", rowKey, err)
-		}
-
+	err = fdb.scanLiveRows(ctx, tableKey, fromBlockNum, blockNum, lowerPrimaryKey, upperPrimaryKey, func(rowKey string, rowBlockNum uint32, primaryKey string, value []byte) error {
 		if len(value) == 0 {
 			err := rowDeleted(rowBlockNum, primaryKey)
 			if err != nil {
@@ -593,6 +1324,266 @@ func (fdb *FluxDB) read(
 	return nil
 }
 
+// readRangeWithLimit reads the live rows written since the index first, they
+// are bounded by the indexing interval. Knowing which indexed rows were
+// updated or deleted since then, only the indexed rows that can make it into
+// the first `limit` rows are fetched.
+func (fdb *FluxDB) readRangeWithLimit(
+	ctx context.Context,
+	tableKey string,
+	blockNum uint32,
+	idx *TableIndex,
+	lowerPrimaryKey string,
+	upperPrimaryKey string,
+	limit int,
+	reverse bool,
+	rowUpdated func(blockNum uint32, primaryKey string, value []byte) error,
+) error {
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("index exists, reading live rows first", zap.Int("row_count", len(idx.Map)))
+
+	type liveRow struct {
+		blockNum uint32
+		value    []byte
+	}
+
+	liveRows := map[string]*liveRow{}
+	err := fdb.scanLiveRows(ctx, tableKey, idx.AtBlockNum+1, blockNum, lowerPrimaryKey, upperPrimaryKey, func(rowKey string, rowBlockNum uint32, primaryKey string, value []byte) error {
+		liveRows[primaryKey] = &liveRow{rowBlockNum, value}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var primaryKeys []string
+	for primaryKey := range idx.Map {
+		if _, overridden := liveRows[primaryKey]; overridden || !primaryKeyInRange(primaryKey, lowerPrimaryKey, upperPrimaryKey) {
+			continue
+		}
+
+		primaryKeys = append(primaryKeys, primaryKey)
+	}
+
+	for primaryKey, row := range liveRows {
+		if len(row.value) > 0 {
+			primaryKeys = append(primaryKeys, primaryKey)
+		}
+	}
+
+	if reverse {
+		sort.Sort(sort.Reverse(sort.StringSlice(primaryKeys)))
+	} else {
+		sort.Strings(primaryKeys)
+	}
+
+	if len(primaryKeys) > limit {
+		primaryKeys = primaryKeys[:limit]
+	}
+
+	var keys []string
+	for _, primaryKey := range primaryKeys {
+		if row, found := liveRows[primaryKey]; found {
+			err := rowUpdated(row.blockNum, primaryKey, row.value)
+			if err != nil {
+				return derr.Wrapf(err, "rowUpdated callback failed for primary key %q (live rows)", primaryKey)
+			}
+
+			continue
+		}
+
+		keys = append(keys, fmt.Sprintf("%s:%08x:%s", tableKey, idx.Map[primaryKey], primaryKey))
+	}
+
+	if err := fdb.fetchIndexedRows(ctx, keys, rowUpdated); err != nil {
+		return err
+	}
+
+	zlog.Info("finished reading rows from database", zap.Int("live_row_count", len(liveRows)), zap.Int("indexed_row_count", len(keys)))
+	return nil
+}
+
+func (fdb *FluxDB) fetchIndexedRows(ctx context.Context, keys []string, rowUpdated func(blockNum uint32, primaryKey string, value []byte) error) error {
+	zlog := logging.Logger(ctx, zlog)
+
+	// Fetch all rows in the index.. could be millions
+	// We need to batch so that the RowList, when serialized, doesn't blow up 1MB
+	// We should batch in 10,000 key reads, we can parallelize those...
+	chunkSize := 5000
+	chunks := int(math.Ceil(float64(len(keys)) / float64(chunkSize)))
+
+	zlog.Debug("reading index rows chunks", zap.Int("chunk_count", chunks))
+	for i := 0; i < chunks; i++ {
+		chunkStart := i * chunkSize
+		chunkEnd := (i + 1) * chunkSize
+		max := len(keys)
+		if max < chunkEnd {
+			chunkEnd = max
+		}
+
+		// TODO: triple check boundaries, EASY off-by-one issue here..
+		keysChunk := keys[chunkStart:chunkEnd]
+
+		zlog.Debug("reading index rows chunk", zap.Int("key_count", len(keysChunk)))
+		keyRead := false
+		err := fdb.store.FetchTabletRows(ctx, keysChunk, func(rowKey string, value []byte) error {
+			if len(value) == 0 {
+				return fmt.Errorf("indexes mappings should not contain empty data, empty rows don't make sense in an index, row %s", rowKey)
+			}
+
+			_, rowBlockNum, primaryKey, err := explodeWritableRowKey(rowKey)
+			if err != nil {
+				return fmt.Errorf("couldn't parse row key %q: %w", rowKey, err)
+			}
+
+			err = rowUpdated(rowBlockNum, primaryKey, value)
+			if err != nil {
+				return derr.Wrapf(err, "rowUpdated callback failed for row %q (indexed rows)", rowKey)
+			}
+
+			keyRead = true
+			return nil
+		})
+
+		if err != nil {
+			return derr.Wrap(err, "reading keys chunks")
+		}
+
+		if !keyRead {
+			return fmt.Errorf("reading a indexed key yielded no row: %s", keysChunk)
+		}
+	}
+
+	return nil
+}
+
+// scanLiveRows scans the rows written from `fromBlockNum` up to `toBlockNum`,
+// both inclusive, that are within the primary key range. Row keys being
+// `<tableKey>:<blockNum>:<primaryKey>`, the bounds narrow the scanned range on
+// its first and last block, rows of the blocks in between are filtered as
+// they come.
+func (fdb *FluxDB) scanLiveRows(
+	ctx context.Context,
+	tableKey string,
+	fromBlockNum uint32,
+	toBlockNum uint32,
+	lowerPrimaryKey string,
+	upperPrimaryKey string,
+	onRow func(rowKey string, blockNum uint32, primaryKey string, value []byte) error,
+) error {
+	zlog := logging.Logger(ctx, zlog)
+	if fromBlockNum > toBlockNum {
+		zlog.Debug("no live rows to read", zap.Uint32("from_block_num", fromBlockNum), zap.Uint32("to_block_num", toBlockNum))
+		return nil
+	}
+
+	firstRowKey := tableKey + ":" + HexBlockNum(fromBlockNum)
+	if lowerPrimaryKey != "" {
+		firstRowKey += ":" + lowerPrimaryKey
+	}
+
+	lastRowKey := tableKey + ":" + HexBlockNum(toBlockNum+1)
+	if upperPrimaryKey != "" {
+		if afterUpperPrimaryKey, ok := nextPrimaryKey(upperPrimaryKey); ok {
+			lastRowKey = tableKey + ":" + HexBlockNum(toBlockNum) + ":" + afterUpperPrimaryKey
+		}
+	}
+
+	zlog.Debug("reading rows range from database", zap.String("first_row_key", firstRowKey), zap.String("last_row_key", lastRowKey))
+
+	return fdb.store.ScanTabletRows(ctx, firstRowKey, lastRowKey, func(rowKey string, value []byte) error {
+		_, rowBlockNum, primaryKey, err := explodeWritableRowKey(rowKey)
+		if err != nil {
+			return fmt.Errorf("couldn't parse row key %q: %w", rowKey, err)
+		}
+
+		if !primaryKeyInRange(primaryKey, lowerPrimaryKey, upperPrimaryKey) {
+			return nil
+		}
+
+		return onRow(rowKey, rowBlockNum, primaryKey, value)
+	})
+}
+
+// readMany is like `read` but only yields the rows of the given primary keys. The
+// indexed rows are fetched together and a single scan, bounded by the smallest
+// and largest requested keys, reads the live rows.
+func (fdb *FluxDB) readMany(
+	ctx context.Context,
+	tableKey string,
+	primaryKeys []string,
+	blockNum uint32,
+	rowUpdated func(blockNum uint32, primaryKey string, value []byte) error,
+	rowDeleted func(blockNum uint32, primaryKey string) error,
+) error {
+	ctx, span := dtracing.StartSpan(ctx, "read many", "table_key", tableKey, "block_num", blockNum)
+	defer span.End()
+
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("reading many keys from database", zap.String("table_key", tableKey), zap.Int("key_count", len(primaryKeys)), zap.Uint32("block_num", blockNum))
+
+	if len(primaryKeys) == 0 {
+		return nil
+	}
+
+	wanted := make(map[string]bool, len(primaryKeys))
+	lowerPrimaryKey, upperPrimaryKey := primaryKeys[0], primaryKeys[0]
+	for _, primaryKey := range primaryKeys {
+		wanted[primaryKey] = true
+		if primaryKey < lowerPrimaryKey {
+			lowerPrimaryKey = primaryKey
+		}
+
+		if primaryKey > upperPrimaryKey {
+			upperPrimaryKey = primaryKey
+		}
+	}
+
+	idx, err := fdb.getIndex(ctx, tableKey, blockNum)
+	if err != nil {
+		return err
+	}
+
+	fromBlockNum := uint32(0)
+	if idx != nil {
+		zlog.Debug("index exists, reconciling it", zap.Int("row_count", len(idx.Map)))
+		fromBlockNum = idx.AtBlockNum + 1
+
+		var keys []string
+		for primaryKey := range wanted {
+			if indexedBlockNum, found := idx.Map[primaryKey]; found {
+				keys = append(keys, fmt.Sprintf("%s:%08x:%s", tableKey, indexedBlockNum, primaryKey))
+			}
+		}
+
+		if err := fdb.fetchIndexedRows(ctx, keys, rowUpdated); err != nil {
+			return err
+		}
+
+		zlog.Debug("finished reconciling index")
+	}
+
+	return fdb.scanLiveRows(ctx, tableKey, fromBlockNum, blockNum, lowerPrimaryKey, upperPrimaryKey, func(rowKey string, rowBlockNum uint32, primaryKey string, value []byte) error {
+		if !wanted[primaryKey] {
+			return nil
+		}
+
+		if len(value) == 0 {
+			if err := rowDeleted(rowBlockNum, primaryKey); err != nil {
+				return derr.Wrapf(err, "rowDeleted callback failed for row %q", rowKey)
+			}
+
+			return nil
+		}
+
+		if err := rowUpdated(rowBlockNum, primaryKey, value); err != nil {
+			return derr.Wrapf(err, "rowUpdated callback failed for row %q", rowKey)
+		}
+
+		return nil
+	})
+}
+
 func (fdb *FluxDB) readSingle(
 	ctx context.Context,
 	tableKey string,
@@ -729,9 +1720,29 @@ func (fdb *FluxDB) CheckCleanDBForSharding() error {
 	return errors.New("live injector's marker of last written block present, expected no element to exist")
 }
 
+// fetchBlockNumMarker returns the block num held by the marker `key`, written as
+// `HexBlockNum`, 0 when the marker was never set.
+func (fdb *FluxDB) fetchBlockNumMarker(ctx context.Context, key string) (uint32, error) {
+	value, err := fdb.store.FetchMarker(ctx, key)
+	if err == store.ErrNotFound {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	blockNum, err := strconv.ParseUint(string(value), 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("marker %q block num %q is not valid: %w", key, string(value), err)
+	}
+
+	return uint32(blockNum), nil
+}
+
 func (fdb *FluxDB) lastBlockKey() string {
 	if fdb.IsSharding() {
-		return fmt.Sprintf("shard-%03d", fdb.shardIndex)
+		return shardLastBlockRowKey(fdb.shardIndex)
 	}
 	return lastBlockRowKey
 }
@@ -16,11 +16,14 @@ package fluxdb
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/dfuse-io/derr"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opencensus.io/trace"
@@ -40,17 +43,561 @@ func TestReadWithSpeculative(t *testing.T) {
 	executeWriteRequests(t, db, writeEmptyABI(blockNum, account))
 
 	speculativeWrites := writeRequests(
-		tableDataRows(blockNum, &TableDataRow{account, scope, table, key, 5, true, nil}),
+		tableDataRows(blockNum, &TableDataRow{account, scope, table, key, 5, 0, true, nil}),
 	)
 
 	resp, err := db.ReadTable(context.Background(), &ReadTableRequest{
-		account, scope, table, &key, 123, &offset, &limit, speculativeWrites,
+		Account:           account,
+		Scope:             scope,
+		Table:             table,
+		Key:               &key,
+		BlockNum:          123,
+		Offset:            &offset,
+		Limit:             &limit,
+		SpeculativeWrites: speculativeWrites,
 	})
 
 	require.NoError(t, err)
 	require.Len(t, resp.Rows, 0)
 }
 
+func TestReadTableWithBounds(t *testing.T) {
+	blockNum := uint32(123)
+	account, scope, table := uint64(0), uint64(1), uint64(2)
+
+	primaryKey := func(key uint64) []byte {
+		out := make([]byte, 8)
+		big.PutUint64(out, key)
+		return out
+	}
+
+	tests := []struct {
+		name         string
+		lowerBound   []byte
+		upperBound   []byte
+		reverse      bool
+		expectedKeys []uint64
+	}{
+		{"unbounded", nil, nil, false, []uint64{1, 3, 4, 5, 6}},
+		{"unbounded reverse", nil, nil, true, []uint64{6, 5, 4, 3, 1}},
+		{"lower bound", primaryKey(4), nil, false, []uint64{4, 5, 6}},
+		{"upper bound", nil, primaryKey(3), false, []uint64{1, 3}},
+		{"both bounds, inclusive", primaryKey(3), primaryKey(5), false, []uint64{3, 4, 5}},
+		{"both bounds reverse", primaryKey(2), primaryKey(6), true, []uint64{6, 5, 4, 3}},
+		{"empty range", primaryKey(7), primaryKey(10), false, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, closer := NewTestDB(t)
+			defer closer()
+
+			executeWriteRequests(t, db, writeEmptyABI(blockNum, account), tableDataRows(blockNum,
+				&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01}},
+				&TableDataRow{account, scope, table, 2, 5, 0, false, []byte{0x02}},
+				&TableDataRow{account, scope, table, 3, 5, 0, false, []byte{0x03}},
+				&TableDataRow{account, scope, table, 4, 5, 0, false, []byte{0x04}},
+				&TableDataRow{account, scope, table, 5, 5, 0, false, []byte{0x05}},
+			))
+
+			speculativeWrites := writeRequests(
+				tableDataRows(blockNum+1,
+					&TableDataRow{account, scope, table, 2, 5, 0, true, nil},
+					&TableDataRow{account, scope, table, 6, 5, 0, false, []byte{0x06}},
+				),
+			)
+
+			resp, err := db.ReadTable(context.Background(), &ReadTableRequest{
+				Account:           account,
+				Scope:             scope,
+				Table:             table,
+				BlockNum:          blockNum + 1,
+				SpeculativeWrites: speculativeWrites,
+				LowerBound:        test.lowerBound,
+				UpperBound:        test.upperBound,
+				Reverse:           test.reverse,
+			})
+			require.NoError(t, err)
+
+			var keys []uint64
+			for _, row := range resp.Rows {
+				keys = append(keys, row.Key)
+			}
+
+			assert.Equal(t, test.expectedKeys, keys)
+		})
+	}
+}
+
+func TestReadTableWithLimit(t *testing.T) {
+	account, scope, table := uint64(0), uint64(1), uint64(2)
+
+	primaryKey := func(key uint64) []byte {
+		out := make([]byte, 8)
+		big.PutUint64(out, key)
+		return out
+	}
+
+	tests := []struct {
+		name         string
+		offset       uint32
+		limit        uint32
+		lowerBound   []byte
+		upperBound   []byte
+		reverse      bool
+		expectedKeys []uint64
+	}{
+		{"no limit", 0, 0, nil, nil, false, []uint64{1, 4, 5, 6, 7}},
+		{"limit", 0, 2, nil, nil, false, []uint64{1, 4}},
+		{"limit reverse", 0, 2, nil, nil, true, []uint64{7, 6}},
+		{"offset and limit", 1, 2, nil, nil, false, []uint64{4, 5}},
+		{"offset past rows", 6, 2, nil, nil, false, nil},
+		{"lower bound and limit", 0, 2, primaryKey(4), nil, false, []uint64{4, 5}},
+		{"upper bound and limit reverse", 0, 10, nil, primaryKey(5), true, []uint64{5, 4, 1}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			db, closer := NewTestDB(t)
+			defer closer()
+
+			executeWriteRequests(t, db, writeEmptyABI(10, account), tableDataRows(10,
+				&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01}},
+				&TableDataRow{account, scope, table, 2, 5, 0, false, []byte{0x02}},
+				&TableDataRow{account, scope, table, 3, 5, 0, false, []byte{0x03}},
+				&TableDataRow{account, scope, table, 4, 5, 0, false, []byte{0x04}},
+				&TableDataRow{account, scope, table, 5, 5, 0, false, []byte{0x05}},
+				&TableDataRow{account, scope, table, 6, 5, 0, false, []byte{0x06}},
+			))
+
+			tableKey := (&ReadTableRequest{Account: account, Scope: scope, Table: table}).tableKey()
+			db.idxCache.ScheduleIndex(tableKey, 10)
+			require.NoError(t, db.IndexTables(ctx))
+
+			executeWriteRequests(t, db, tableDataRows(11,
+				&TableDataRow{account, scope, table, 2, 5, 0, true, nil},
+				&TableDataRow{account, scope, table, 4, 5, 0, false, []byte{0x14}},
+				&TableDataRow{account, scope, table, 7, 5, 0, false, []byte{0x07}},
+			))
+
+			speculativeWrites := writeRequests(
+				tableDataRows(12, &TableDataRow{account, scope, table, 3, 5, 0, true, nil}),
+			)
+
+			resp, err := db.ReadTable(ctx, &ReadTableRequest{
+				Account:           account,
+				Scope:             scope,
+				Table:             table,
+				BlockNum:          12,
+				Offset:            &test.offset,
+				Limit:             &test.limit,
+				SpeculativeWrites: speculativeWrites,
+				LowerBound:        test.lowerBound,
+				UpperBound:        test.upperBound,
+				Reverse:           test.reverse,
+			})
+			require.NoError(t, err)
+
+			var keys []uint64
+			for _, row := range resp.Rows {
+				keys = append(keys, row.Key)
+				if row.Key == 4 {
+					assert.Equal(t, []byte{0x14}, row.Data)
+				}
+			}
+
+			assert.Equal(t, test.expectedKeys, keys)
+		})
+	}
+}
+
+func TestReadTableBySecondaryIndex_Uint256Range(t *testing.T) {
+	blockNum := uint32(123)
+	account, scope, table := uint64(0), uint64(1), uint64(2)
+
+	// A `key256` as laid out in memory by nodeos, two little endian `uint128` words
+	rawKey256 := func(first, second uint64) []byte {
+		out := make([]byte, 32)
+		binary.LittleEndian.PutUint64(out[0:8], first)
+		binary.LittleEndian.PutUint64(out[16:24], second)
+		return out
+	}
+
+	secondaryIndexRow := func(primaryKey uint64, raw []byte) *SecondaryIndexRow {
+		key, err := sortableSecondaryKey(SecondaryIndexKindUint256, raw)
+		require.NoError(t, err)
+
+		return &SecondaryIndexRow{account, scope, table, primaryKey, 0, SecondaryIndexKindUint256, false, key}
+	}
+
+	bound := func(raw []byte) []byte {
+		kind, out, err := SecondaryKeyFromString("i256", hex.EncodeToString(raw))
+		require.NoError(t, err)
+		require.Equal(t, SecondaryIndexKindUint256, kind)
+
+		return out
+	}
+
+	tests := []struct {
+		name         string
+		lowerBound   []byte
+		upperBound   []byte
+		reverse      bool
+		expectedKeys []uint64
+	}{
+		{"unbounded", nil, nil, false, []uint64{1, 2, 3, 4}},
+		{"lower bound", bound(rawKey256(2, 0)), nil, false, []uint64{2, 3, 4}},
+		{"upper bound", nil, bound(rawKey256(2, 9)), false, []uint64{1, 2, 3}},
+		{"both bounds, inclusive", bound(rawKey256(2, 0)), bound(rawKey256(2, 9)), false, []uint64{2, 3}},
+		{"both bounds reverse", bound(rawKey256(1, 6)), bound(rawKey256(3, 0)), true, []uint64{3, 2}},
+		{"second word only", bound(rawKey256(2, 1)), bound(rawKey256(2, 8)), false, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, closer := NewTestDB(t)
+			defer closer()
+
+			executeWriteRequests(t, db, writeEmptyABI(blockNum, account), &WriteRequest{
+				BlockNum: blockNum,
+				TableDatas: []*TableDataRow{
+					{account, scope, table, 1, 5, 0, false, []byte{0x01}},
+					{account, scope, table, 2, 5, 0, false, []byte{0x02}},
+					{account, scope, table, 3, 5, 0, false, []byte{0x03}},
+					{account, scope, table, 4, 5, 0, false, []byte{0x04}},
+				},
+				SecondaryIndexes: []*SecondaryIndexRow{
+					secondaryIndexRow(1, rawKey256(1, 5)),
+					secondaryIndexRow(2, rawKey256(2, 0)),
+					secondaryIndexRow(3, rawKey256(2, 9)),
+					secondaryIndexRow(4, rawKey256(3, 1)),
+				},
+			})
+
+			resp, err := db.ReadTable(context.Background(), &ReadTableRequest{
+				Account:       account,
+				Scope:         scope,
+				Table:         table,
+				BlockNum:      blockNum,
+				IndexPosition: 2,
+				LowerBound:    test.lowerBound,
+				UpperBound:    test.upperBound,
+				Reverse:       test.reverse,
+			})
+			require.NoError(t, err)
+
+			var keys []uint64
+			for _, row := range resp.Rows {
+				keys = append(keys, row.Key)
+			}
+
+			assert.Equal(t, test.expectedKeys, keys)
+		})
+	}
+}
+
+func TestReadTableBySecondaryIndexWithLimit(t *testing.T) {
+	account, scope, table := uint64(0), uint64(1), uint64(2)
+
+	entry := func(primaryKey uint64, secondaryKey uint64, deletion bool) *SecondaryIndexRow {
+		return &SecondaryIndexRow{account, scope, table, primaryKey, 0, SecondaryIndexKindUint64, deletion, uint64ToSortable(secondaryKey)}
+	}
+
+	tests := []struct {
+		name         string
+		limit        uint32
+		lowerBound   []byte
+		upperBound   []byte
+		reverse      bool
+		expectedKeys []uint64
+	}{
+		{"no limit", 0, nil, nil, false, []uint64{2, 5, 1, 4}},
+		{"limit", 2, nil, nil, false, []uint64{2, 5}},
+		{"limit reverse", 1, nil, nil, true, []uint64{4}},
+		{"lower bound", 0, uint64ToSortable(35), nil, false, []uint64{1, 4}},
+		{"upper bound reverse", 0, nil, uint64ToSortable(30), true, []uint64{5, 2}},
+		{"changed and deleted entries are out", 0, uint64ToSortable(5), uint64ToSortable(25), false, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			db, closer := NewTestDB(t)
+			defer closer()
+
+			first := tableDataRows(10,
+				&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01}},
+				&TableDataRow{account, scope, table, 2, 5, 0, false, []byte{0x02}},
+				&TableDataRow{account, scope, table, 3, 5, 0, false, []byte{0x03}},
+				&TableDataRow{account, scope, table, 4, 5, 0, false, []byte{0x04}},
+				&TableDataRow{account, scope, table, 5, 5, 0, false, []byte{0x05}},
+			)
+			first.SecondaryIndexes = []*SecondaryIndexRow{entry(1, 40, false), entry(2, 30, false), entry(3, 20, false), entry(4, 10, false), entry(5, 30, false)}
+			executeWriteRequests(t, db, writeEmptyABI(10, account), first)
+
+			request := &ReadTableRequest{Account: account, Scope: scope, Table: table, IndexPosition: 2}
+			db.idxCache.ScheduleIndex(request.tableKey(), 10)
+			db.idxCache.ScheduleIndex(request.secondaryIndexTableKey(), 10)
+			require.NoError(t, db.IndexTables(ctx))
+
+			// The secondary key of row 4 changes, its entry moves from 10 to 50
+			executeWriteRequests(t, db, &WriteRequest{BlockNum: 11, SecondaryIndexes: []*SecondaryIndexRow{entry(4, 10, true), entry(4, 50, false)}})
+
+			speculativeWrites := writeRequests(&WriteRequest{BlockNum: 12, SecondaryIndexes: []*SecondaryIndexRow{entry(3, 20, true)}})
+
+			fetching := &fetchRecordingKVStore{KVStore: db.store}
+			db.store = fetching
+
+			request.BlockNum = 12
+			request.Limit = &test.limit
+			request.SpeculativeWrites = speculativeWrites
+			request.LowerBound = test.lowerBound
+			request.UpperBound = test.upperBound
+			request.Reverse = test.reverse
+
+			resp, err := db.ReadTable(ctx, request)
+			require.NoError(t, err)
+
+			var keys []uint64
+			for _, row := range resp.Rows {
+				keys = append(keys, row.Key)
+			}
+
+			assert.Equal(t, test.expectedKeys, keys)
+
+			var fetchedEntryCount int
+			for _, key := range fetching.fetchedKeys {
+				if strings.HasPrefix(key, request.secondaryIndexTableKey()) {
+					fetchedEntryCount++
+				}
+			}
+
+			if test.limit > 0 {
+				// At most the entries filling the page are fetched, plus one per speculative deletion
+				assert.LessOrEqual(t, fetchedEntryCount, int(test.limit)+1)
+			}
+		})
+	}
+}
+
+func TestReadTableRowHistory(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	account, scope, table, key := uint64(0), uint64(1), uint64(2), uint64(3)
+
+	executeWriteRequests(t, db,
+		writeEmptyABI(10, account),
+		tableDataRows(10, &TableDataRow{account, scope, table, key, 5, 0, false, []byte{0x01}}),
+		tableDataRows(11, &TableDataRow{account, scope, table, key + 1, 5, 0, false, []byte{0x0a}}),
+		tableDataRows(12, &TableDataRow{account, scope, table, key, 6, 0, false, []byte{0x02}}),
+		tableDataRows(13, &TableDataRow{account, scope, table, key, 0, 0, true, nil}),
+	)
+
+	speculativeWrites := writeRequests(
+		tableDataRows(14, &TableDataRow{account, scope, table, key, 7, 0, false, []byte{0x03}}),
+		tableDataRows(15, &TableDataRow{account, scope, table, key, 8, 0, false, []byte{0x04}}),
+	)
+
+	resp, err := db.ReadTableRowHistory(context.Background(), &ReadTableRowHistoryRequest{
+		Account:           account,
+		Scope:             scope,
+		Table:             table,
+		PrimaryKey:        key,
+		FromBlockNum:      11,
+		ToBlockNum:        14,
+		SpeculativeWrites: speculativeWrites,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*TableRowMutation{
+		{BlockNum: 12, Row: &TableRow{key, 6, []byte{0x02}, 12}},
+		{BlockNum: 13, Deletion: true},
+		{BlockNum: 14, Row: &TableRow{key, 7, []byte{0x03}, 14}},
+	}, resp.Mutations)
+}
+
+func TestDiffTable(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	account, scope, table := uint64(0), uint64(1), uint64(2)
+
+	executeWriteRequests(t, db,
+		writeEmptyABI(10, account),
+		tableDataRows(10,
+			&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01}},
+			&TableDataRow{account, scope, table, 2, 5, 0, false, []byte{0x02}},
+			&TableDataRow{account, scope, table, 3, 5, 0, false, []byte{0x03}},
+			&TableDataRow{account, scope, table, 4, 5, 0, false, []byte{0x04}},
+		),
+		tableDataRows(11,
+			&TableDataRow{account, scope, table, 2, 5, 0, false, []byte{0x22}},
+			&TableDataRow{account, scope, table, 3, 0, 0, true, nil},
+			&TableDataRow{account, scope, table, 5, 5, 0, false, []byte{0x05}},
+		),
+		tableDataRows(12,
+			&TableDataRow{account, scope, table, 4, 6, 0, false, []byte{0x04}},
+			&TableDataRow{account, scope, table, 5, 0, 0, true, nil},
+		),
+	)
+
+	speculativeWrites := writeRequests(
+		tableDataRows(13,
+			&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01}},
+			&TableDataRow{account, scope, table, 6, 5, 0, false, []byte{0x06}},
+		),
+	)
+
+	resp, err := db.DiffTable(context.Background(), &DiffTableRequest{
+		Account:           account,
+		Scope:             scope,
+		Table:             table,
+		FromBlockNum:      10,
+		ToBlockNum:        13,
+		SpeculativeWrites: speculativeWrites,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*TableRow{
+		{6, 5, []byte{0x06}, 13},
+	}, resp.Inserted)
+
+	assert.Equal(t, []*TableRowUpdate{
+		{Old: &TableRow{2, 5, []byte{0x02}, 10}, New: &TableRow{2, 5, []byte{0x22}, 11}},
+		{Old: &TableRow{4, 5, []byte{0x04}, 10}, New: &TableRow{4, 6, []byte{0x04}, 12}},
+	}, resp.Updated)
+
+	assert.Equal(t, []*TableRow{
+		{3, 5, []byte{0x03}, 10},
+	}, resp.Removed)
+
+	require.NotNil(t, resp.FromABI)
+	require.NotNil(t, resp.ToABI)
+}
+
+func TestScanTableWrites(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	account, scope, table := uint64(0), uint64(1), uint64(2)
+
+	executeWriteRequests(t, db,
+		writeEmptyABI(10, account),
+		tableDataRows(10, &TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01}}),
+		tableDataRows(11,
+			&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x11}},
+			&TableDataRow{account, scope, table, 2, 5, 0, false, []byte{0x02}},
+		),
+		tableDataRows(12, &TableDataRow{account, scope, table + 1, 1, 5, 0, false, []byte{0x01}}),
+		tableDataRows(13, &TableDataRow{account, scope, table, 1, 0, 0, true, nil}),
+		tableDataRows(14, &TableDataRow{account, scope, table, 2, 6, 0, false, []byte{0x22}}),
+	)
+
+	blocks := map[uint32][]*TableDataRow{}
+	err := db.ScanTableWrites(context.Background(), &ScanTableWritesRequest{
+		Account:      account,
+		Scope:        scope,
+		Table:        table,
+		FromBlockNum: 11,
+		ToBlockNum:   13,
+	}, func(blockNum uint32, rows []*TableDataRow) error {
+		blocks[blockNum] = rows
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[uint32][]*TableDataRow{
+		11: {
+			{account, scope, table, 1, 5, 0, false, []byte{0x11}},
+			{account, scope, table, 2, 5, 0, false, []byte{0x02}},
+		},
+		13: {
+			{account, scope, table, 1, 0, 0, true, nil},
+		},
+	}, blocks)
+}
+
+func TestReadAccountPermissions(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	ctx := context.Background()
+	account := N("eosio")
+	owner := newPermOpData("eosio", "owner", []string{"k1"})
+	active := newPermOpData("eosio", "active", []string{"k2"})
+	activeUpdated := newPermOpData("eosio", "active", []string{"k3"})
+
+	executeWriteRequests(t, db,
+		&WriteRequest{BlockNum: 10, AccountPermissions: []*AccountPermissionRow{
+			{account, N("owner"), false, mustProtoMarshal(owner)},
+			{account, N("active"), false, mustProtoMarshal(active)},
+			{N("other"), N("active"), false, mustProtoMarshal(newPermOpData("other", "active", []string{"k4"}))},
+		}},
+		&WriteRequest{BlockNum: 12, AccountPermissions: []*AccountPermissionRow{
+			{account, N("owner"), true, nil},
+		}},
+	)
+
+	speculativeWrites := writeRequests(
+		&WriteRequest{BlockNum: 13, AccountPermissions: []*AccountPermissionRow{
+			{account, N("active"), false, mustProtoMarshal(activeUpdated)},
+		}},
+	)
+
+	permissions, err := db.ReadAccountPermissions(ctx, 11, "eosio", nil)
+	require.NoError(t, err)
+	require.Len(t, permissions, 2)
+	assert.Equal(t, uint32(10), permissions[0].BlockNum)
+	assert.True(t, proto.Equal(active, permissions[0].Permission))
+	assert.True(t, proto.Equal(owner, permissions[1].Permission))
+
+	permissions, err = db.ReadAccountPermissions(ctx, 13, "eosio", speculativeWrites)
+	require.NoError(t, err)
+	require.Len(t, permissions, 1)
+	assert.Equal(t, uint32(13), permissions[0].BlockNum)
+	assert.True(t, proto.Equal(activeUpdated, permissions[0].Permission))
+}
+
+func TestReadAccountResources(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	ctx := context.Background()
+	account := N("eosio")
+	limits := newAccountLimitsRlimitOp("eosio", false, 10).GetAccountLimits()
+	usage := newAccountUsageRlimitOp("eosio", 100).GetAccountUsage()
+	usageUpdated := newAccountUsageRlimitOp("eosio", 200).GetAccountUsage()
+
+	executeWriteRequests(t, db,
+		&WriteRequest{BlockNum: 10, AccountResourceLimits: []*AccountResourceLimitRow{
+			{account, AccountResourceLimitKindLimits, mustProtoMarshal(limits)},
+		}},
+		&WriteRequest{BlockNum: 11, AccountResourceLimits: []*AccountResourceLimitRow{
+			{account, AccountResourceLimitKindUsage, mustProtoMarshal(usage)},
+		}},
+	)
+
+	speculativeWrites := writeRequests(
+		&WriteRequest{BlockNum: 12, AccountResourceLimits: []*AccountResourceLimitRow{
+			{account, AccountResourceLimitKindUsage, mustProtoMarshal(usageUpdated)},
+		}},
+	)
+
+	resources, err := db.ReadAccountResources(ctx, 10, "eosio", nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(10), resources.LimitsBlockNum)
+	assert.True(t, proto.Equal(limits, resources.Limits))
+	assert.Nil(t, resources.Usage)
+
+	resources, err = db.ReadAccountResources(ctx, 12, "eosio", speculativeWrites)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(limits, resources.Limits))
+	assert.Equal(t, uint32(12), resources.UsageBlockNum)
+	assert.True(t, proto.Equal(usageUpdated, resources.Usage))
+}
+
 func TestReadGetABI(t *testing.T) {
 	acct := N("eosio")
 	traceID := fixedTraceID("00000000000000000000000000000001")
@@ -134,6 +681,46 @@ func TestReadGetABI(t *testing.T) {
 	}
 }
 
+func TestReadABIHistory(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	// The not found error carries the trace ID of the context's span
+	ctx, _ := trace.StartSpanWithRemoteParent(context.Background(), "test", trace.SpanContext{TraceID: fixedTraceID("00000000000000000000000000000001")})
+	acct := N("eosio")
+
+	executeWriteRequests(t, db,
+		writePackedABI(3, acct, []byte("3")),
+		writePackedABI(4, N("other"), []byte("other")),
+		writePackedABI(5, acct, []byte("5")),
+		writePackedABI(8, acct, []byte("8")),
+	)
+
+	speculativeWrites := []*WriteRequest{
+		{BlockNum: 10, ABIs: []*ABIRow{{Account: acct, PackedABI: []byte("10")}}},
+		{BlockNum: 11, ABIs: []*ABIRow{{Account: acct, PackedABI: []byte("11")}}},
+	}
+
+	abis, err := db.ReadABIHistory(ctx, 10, acct, speculativeWrites)
+	require.NoError(t, err)
+	assert.Equal(t, []*ABIRow{
+		{Account: acct, BlockNum: 3, PackedABI: []byte("3")},
+		{Account: acct, BlockNum: 5, PackedABI: []byte("5")},
+		{Account: acct, BlockNum: 8, PackedABI: []byte("8")},
+		{Account: acct, BlockNum: 10, PackedABI: []byte("10")},
+	}, abis)
+
+	abis, err = db.ReadABIHistory(ctx, 5, acct, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*ABIRow{
+		{Account: acct, BlockNum: 3, PackedABI: []byte("3")},
+		{Account: acct, BlockNum: 5, PackedABI: []byte("5")},
+	}, abis)
+
+	_, err = db.ReadABIHistory(ctx, 2, acct, nil)
+	assertError(t, DataABINotFoundError(ctx, "eosio", 2), err)
+}
+
 func assertError(t *testing.T, expected error, actual error) {
 	require.Error(t, actual)
 
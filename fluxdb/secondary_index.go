@@ -0,0 +1,237 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	mathbig "math/big"
+	"strconv"
+	"strings"
+)
+
+type SecondaryIndexKind byte
+
+const (
+	SecondaryIndexKindUnknown SecondaryIndexKind = iota
+	SecondaryIndexKindUint64
+	SecondaryIndexKindUint128
+	SecondaryIndexKindUint256
+	SecondaryIndexKindFloat64
+	SecondaryIndexKindFloat128
+)
+
+// SecondaryIndexKindFromString turns the nodeos index kind (`idx64`, `idx128`,
+// `idx256`, `idx_double` and `idx_long_double`) into a `SecondaryIndexKind`.
+func SecondaryIndexKindFromString(in string) (SecondaryIndexKind, error) {
+	switch in {
+	case "idx64":
+		return SecondaryIndexKindUint64, nil
+	case "idx128":
+		return SecondaryIndexKindUint128, nil
+	case "idx256":
+		return SecondaryIndexKindUint256, nil
+	case "idx_double":
+		return SecondaryIndexKindFloat64, nil
+	case "idx_long_double":
+		return SecondaryIndexKindFloat128, nil
+	default:
+		return SecondaryIndexKindUnknown, fmt.Errorf("unknown secondary index kind %q", in)
+	}
+}
+
+func (k SecondaryIndexKind) String() string {
+	switch k {
+	case SecondaryIndexKindUint64:
+		return "idx64"
+	case SecondaryIndexKindUint128:
+		return "idx128"
+	case SecondaryIndexKindUint256:
+		return "idx256"
+	case SecondaryIndexKindFloat64:
+		return "idx_double"
+	case SecondaryIndexKindFloat128:
+		return "idx_long_double"
+	default:
+		return "unknown"
+	}
+}
+
+func (k SecondaryIndexKind) byteCount() int {
+	switch k {
+	case SecondaryIndexKindUint64, SecondaryIndexKindFloat64:
+		return 8
+	case SecondaryIndexKindUint128, SecondaryIndexKindFloat128:
+		return 16
+	case SecondaryIndexKindUint256:
+		return 32
+	default:
+		return 0
+	}
+}
+
+// sortableSecondaryKey turns a secondary key, as laid out in memory by nodeos,
+// into a big endian representation that sorts byte-wise the same way nodeos
+// orders the index.
+func sortableSecondaryKey(kind SecondaryIndexKind, raw []byte) ([]byte, error) {
+	if len(raw) != kind.byteCount() {
+		return nil, fmt.Errorf("secondary key of kind %s should have %d bytes, got %d", kind, kind.byteCount(), len(raw))
+	}
+
+	out := make([]byte, len(raw))
+	switch kind {
+	case SecondaryIndexKindUint64, SecondaryIndexKindUint128:
+		reverseInto(out, raw)
+
+	case SecondaryIndexKindUint256:
+		// A `key256` is two `uint128` words compared in order, each of them little endian
+		reverseInto(out[0:16], raw[0:16])
+		reverseInto(out[16:32], raw[16:32])
+
+	case SecondaryIndexKindFloat64, SecondaryIndexKindFloat128:
+		reverseInto(out, raw)
+		sortableFloatBits(out)
+
+	default:
+		return nil, fmt.Errorf("unsupported secondary index kind %d", kind)
+	}
+
+	return out, nil
+}
+
+// SecondaryKeyFromString parses a secondary key bound as received by the API
+// into its sortable form. The `keyType` follows nodeos `get_table_rows` naming.
+func SecondaryKeyFromString(keyType string, in string) (kind SecondaryIndexKind, out []byte, err error) {
+	switch keyType {
+	case "i64", "uint64":
+		value, err := strconv.ParseUint(in, 10, 64)
+		if err != nil {
+			return kind, nil, fmt.Errorf("invalid i64 secondary key %q: %w", in, err)
+		}
+
+		return SecondaryIndexKindUint64, uint64ToSortable(value), nil
+
+	case "name":
+		value, err := StringToName(in)
+		if err != nil {
+			return kind, nil, fmt.Errorf("invalid name secondary key %q: %w", in, err)
+		}
+
+		return SecondaryIndexKindUint64, uint64ToSortable(value), nil
+
+	case "hex":
+		value, err := strconv.ParseUint(in, 16, 64)
+		if err != nil {
+			return kind, nil, fmt.Errorf("invalid hex secondary key %q: %w", in, err)
+		}
+
+		return SecondaryIndexKindUint64, uint64ToSortable(value), nil
+
+	case "i128":
+		value, ok := new(mathbig.Int).SetString(in, 0)
+		if !ok || value.Sign() < 0 || value.BitLen() > 128 {
+			return kind, nil, fmt.Errorf("invalid i128 secondary key %q", in)
+		}
+
+		valueBytes := value.Bytes()
+		out = make([]byte, 16)
+		copy(out[16-len(valueBytes):], valueBytes)
+
+		return SecondaryIndexKindUint128, out, nil
+
+	case "i256":
+		// The hexadecimal form is the key as laid out in memory by nodeos, it
+		// must go through the same transformation as the stored keys
+		raw, err := hex.DecodeString(strings.TrimPrefix(in, "0x"))
+		if err != nil || len(raw) != 32 {
+			return kind, nil, fmt.Errorf("invalid i256 secondary key %q, expecting 64 hexadecimal characters", in)
+		}
+
+		out, err = sortableSecondaryKey(SecondaryIndexKindUint256, raw)
+		if err != nil {
+			return kind, nil, err
+		}
+
+		return SecondaryIndexKindUint256, out, nil
+
+	case "float64":
+		value, err := strconv.ParseFloat(in, 64)
+		if err != nil {
+			return kind, nil, fmt.Errorf("invalid float64 secondary key %q: %w", in, err)
+		}
+
+		out = uint64ToSortable(math.Float64bits(value))
+		sortableFloatBits(out)
+
+		return SecondaryIndexKindFloat64, out, nil
+
+	default:
+		return kind, nil, fmt.Errorf("unsupported secondary key type %q", keyType)
+	}
+}
+
+// secondaryIndexEntryKeyByteCount is the length of the key under which secondary index
+// entries are stored, the sortable secondary key right-padded to the widest kind (`idx256`)
+// followed by the big endian primary key. All entries of an index then sort by secondary
+// key first and primary key second, the order nodeos iterates them.
+const secondaryIndexEntryKeyByteCount = 32 + 8
+
+// secondaryIndexEntryKey returns the hex encoded key under which the entry mapping
+// `primaryKey` to `secondaryKey` (in its sortable form) is stored.
+func secondaryIndexEntryKey(secondaryKey []byte, primaryKey uint64) string {
+	buffer := make([]byte, secondaryIndexEntryKeyByteCount)
+	copy(buffer, secondaryKey)
+	big.PutUint64(buffer[32:], primaryKey)
+
+	return hex.EncodeToString(buffer)
+}
+
+// secondaryIndexEntryPrimaryKey extracts the table row primary key out of a secondary
+// index entry key.
+func secondaryIndexEntryPrimaryKey(entryKey string) (uint64, error) {
+	if len(entryKey) != 2*secondaryIndexEntryKeyByteCount {
+		return 0, fmt.Errorf("secondary index entry key should have %d hex characters, got %d", 2*secondaryIndexEntryKeyByteCount, len(entryKey))
+	}
+
+	return strconv.ParseUint(entryKey[64:], 16, 64)
+}
+
+func uint64ToSortable(value uint64) []byte {
+	out := make([]byte, 8)
+	big.PutUint64(out, value)
+
+	return out
+}
+
+// sortableFloatBits flips the bits of a big endian IEEE 754 value so that
+// negative numbers sort before positive ones when compared byte-wise.
+func sortableFloatBits(buffer []byte) {
+	if buffer[0]&0x80 != 0 {
+		for i := range buffer {
+			buffer[i] = ^buffer[i]
+		}
+		return
+	}
+
+	buffer[0] |= 0x80
+}
+
+func reverseInto(dst, src []byte) {
+	last := len(src) - 1
+	for i := range src {
+		dst[i] = src[last-i]
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"sync"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	eos "github.com/eoscanada/eos-go"
+)
+
+// Decoding rows to JSON is where most of the read time goes, so ABIs are decoded once
+// and kept around along with the per-table decoders built from them, and decoded rows
+// are kept in an LRU cache, rows being immutable once written at a given block.
+// The ABI decoders cache is bounded by its number of decoders while the decoded rows
+// cache is bounded by the bytes of the rows it holds (binary and JSON forms).
+var defaultABIDecoderCacheSize = 1000
+var defaultDecodedRowCacheBytes = 100 * 1024 * 1024
+
+// abiDecoder is the decoded form of an `ABIRow`, it hands out a `tableDecoder` per
+// table of the ABI, each built once and reused across rows and requests.
+type abiDecoder struct {
+	abiRow *fluxdb.ABIRow
+	abi    *eos.ABI
+	rows   *lruCache
+
+	lock   sync.Mutex
+	tables map[eos.TableName]*tableDecoder
+}
+
+// tableDecoder decodes the rows of a single table of an ABI to JSON, the table's
+// struct type being resolved once when the decoder is built.
+type tableDecoder struct {
+	abiDecoder *abiDecoder
+	table      eos.TableName
+	structType string
+}
+
+type abiDecoderKey struct {
+	account     uint64
+	abiBlockNum uint32
+}
+
+type decodedRowKey struct {
+	account     uint64
+	abiBlockNum uint32
+	table       eos.TableName
+	rowBlockNum uint32
+	primaryKey  uint64
+}
+
+type decodedRow struct {
+	decoder *tableDecoder
+	data    []byte
+	json    []byte
+}
+
+// abiDecoder returns the decoder of the ABI row, reusing the cached one when the ABI
+// was already decoded. Speculative ABIs of different forks can be set at the same block,
+// the cached decoder is only reused when its packed ABI is the same.
+func (srv *EOSServer) abiDecoder(abiRow *fluxdb.ABIRow) (*abiDecoder, error) {
+	key := abiDecoderKey{abiRow.Account, abiRow.BlockNum}
+	if cached, found := srv.abiDecoders.get(key); found {
+		decoder := cached.(*abiDecoder)
+		if bytes.Equal(decoder.abiRow.PackedABI, abiRow.PackedABI) {
+			return decoder, nil
+		}
+	}
+
+	var abiObj *eos.ABI
+	if err := eos.UnmarshalBinary(abiRow.PackedABI, &abiObj); err != nil {
+		return nil, derr.Wrapf(err, "unable to decode packed ABI %q to JSON", abiRow.PackedABI)
+	}
+
+	decoder := &abiDecoder{
+		abiRow: abiRow,
+		abi:    abiObj,
+		rows:   srv.decodedRows,
+		tables: map[eos.TableName]*tableDecoder{},
+	}
+
+	srv.abiDecoders.add(key, decoder, 1)
+	return decoder, nil
+}
+
+// tableDecoder returns the decoder of the table, nil when the ABI doesn't define it.
+func (d *abiDecoder) tableDecoder(table eos.TableName) *tableDecoder {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if decoder, found := d.tables[table]; found {
+		return decoder
+	}
+
+	var decoder *tableDecoder
+	if tableDef := d.abi.TableForName(table); tableDef != nil {
+		decoder = &tableDecoder{abiDecoder: d, table: table, structType: tableDef.Type}
+	}
+
+	d.tables[table] = decoder
+	return decoder
+}
+
+// mustTableDecoder is `tableDecoder` returning a not found error when the ABI doesn't
+// define the table.
+func (d *abiDecoder) mustTableDecoder(ctx context.Context, table eos.TableName) (*tableDecoder, error) {
+	decoder := d.tableDecoder(table)
+	if decoder == nil {
+		return nil, fluxdb.DataTableNotFoundError(ctx, eos.AccountName(fluxdb.NameToString(d.abiRow.Account)), table)
+	}
+
+	return decoder, nil
+}
+
+// decode returns the JSON of the row written at `rowBlockNum`, from the cache when the
+// row was already decoded. Speculative rows of different forks can share the same block
+// and primary key, so a cached row is only used when its data is the same.
+func (d *tableDecoder) decode(rowBlockNum uint32, primaryKey uint64, data []byte) ([]byte, error) {
+	key := decodedRowKey{d.abiDecoder.abiRow.Account, d.abiDecoder.abiRow.BlockNum, d.table, rowBlockNum, primaryKey}
+	if cached, found := d.abiDecoder.rows.get(key); found {
+		row := cached.(*decodedRow)
+		if row.decoder == d && bytes.Equal(row.data, data) {
+			return row.json, nil
+		}
+	}
+
+	jsonData, err := d.abiDecoder.abi.DecodeTableRowTyped(d.structType, data)
+	if err != nil {
+		return nil, err
+	}
+
+	d.abiDecoder.rows.add(key, &decodedRow{decoder: d, data: data, json: jsonData}, len(data)+len(jsonData))
+	return jsonData, nil
+}
+
+// lruCache is a concurrency safe, least recently used cache bounded by the total size
+// of its entries, the size of each entry being given when it's added. A cache of size
+// 0 or less never holds anything.
+type lruCache struct {
+	maxSize int
+
+	lock    sync.Mutex
+	size    int
+	entries *list.List
+	index   map[interface{}]*list.Element
+}
+
+type lruEntry struct {
+	key   interface{}
+	value interface{}
+	size  int
+}
+
+func newLRUCache(maxSize int) *lruCache {
+	return &lruCache{
+		maxSize: maxSize,
+		entries: list.New(),
+		index:   map[interface{}]*list.Element{},
+	}
+}
+
+func (c *lruCache) get(key interface{}) (interface{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	element, found := c.index[key]
+	if !found {
+		return nil, false
+	}
+
+	c.entries.MoveToFront(element)
+	return element.Value.(*lruEntry).value, true
+}
+
+func (c *lruCache) add(key, value interface{}, size int) {
+	if c.maxSize <= 0 {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if element, found := c.index[key]; found {
+		entry := element.Value.(*lruEntry)
+		c.size += size - entry.size
+		entry.value, entry.size = value, size
+		c.entries.MoveToFront(element)
+	} else {
+		c.index[key] = c.entries.PushFront(&lruEntry{key, value, size})
+		c.size += size
+	}
+
+	// An entry bigger than the whole cache ends up evicted right away
+	for c.size > c.maxSize {
+		oldest := c.entries.Back()
+		entry := oldest.Value.(*lruEntry)
+		c.entries.Remove(oldest)
+		delete(c.index, entry.key)
+		c.size -= entry.size
+	}
+}
+
+func (c *lruCache) len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.entries.Len()
+}
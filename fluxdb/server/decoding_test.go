@@ -0,0 +1,260 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	eos "github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/ecc"
+	"github.com/francoispqt/gojay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache(t *testing.T) {
+	cache := newLRUCache(2)
+	cache.add("a", 1, 1)
+	cache.add("b", 2, 1)
+
+	value, found := cache.get("a")
+	require.True(t, found)
+	assert.Equal(t, 1, value)
+
+	// "b" is now the least recently used entry
+	cache.add("c", 3, 1)
+	assert.Equal(t, 2, cache.len())
+
+	_, found = cache.get("b")
+	assert.False(t, found)
+
+	value, found = cache.get("c")
+	require.True(t, found)
+	assert.Equal(t, 3, value)
+
+	cache.add("c", 4, 1)
+	value, _ = cache.get("c")
+	assert.Equal(t, 4, value)
+	assert.Equal(t, 2, cache.len())
+}
+
+func TestLRUCache_SizeBound(t *testing.T) {
+	cache := newLRUCache(10)
+	cache.add("a", 1, 4)
+	cache.add("b", 2, 4)
+	assert.Equal(t, 2, cache.len())
+
+	// "a" is evicted to make room
+	cache.add("c", 3, 6)
+	assert.Equal(t, 2, cache.len())
+
+	_, found := cache.get("a")
+	assert.False(t, found)
+
+	// Growing an entry evicts the others
+	cache.add("d", 4, 4)
+	assert.Equal(t, 2, cache.len())
+
+	cache.add("d", 5, 10)
+	assert.Equal(t, 1, cache.len())
+
+	value, found := cache.get("d")
+	require.True(t, found)
+	assert.Equal(t, 5, value)
+
+	// An entry bigger than the cache is never kept
+	cache.add("e", 6, 11)
+	assert.Equal(t, 0, cache.len())
+}
+
+func TestLRUCache_Disabled(t *testing.T) {
+	cache := newLRUCache(0)
+	cache.add("a", 1, 1)
+
+	_, found := cache.get("a")
+	assert.False(t, found)
+	assert.Equal(t, 0, cache.len())
+}
+
+func TestTableDecoder(t *testing.T) {
+	srv := &EOSServer{abiDecoders: newLRUCache(10), decodedRows: newLRUCache(1000)}
+	abiRow := testABIRow(t, 10, "rows")
+
+	abiDecoder, err := srv.abiDecoder(abiRow)
+	require.NoError(t, err)
+
+	sameABIDecoder, err := srv.abiDecoder(testABIRow(t, 10, "rows"))
+	require.NoError(t, err)
+	assert.True(t, abiDecoder == sameABIDecoder, "same ABI should reuse the cached decoder")
+
+	forkedABIDecoder, err := srv.abiDecoder(testABIRow(t, 10, "forked"))
+	require.NoError(t, err)
+	assert.False(t, abiDecoder == forkedABIDecoder, "different ABI at same block should not reuse the cached decoder")
+
+	assert.Nil(t, abiDecoder.tableDecoder("unknown"))
+
+	decoder := abiDecoder.tableDecoder("rows")
+	require.NotNil(t, decoder)
+	assert.True(t, decoder == abiDecoder.tableDecoder("rows"), "table decoder should be built only once")
+
+	expected, err := abiDecoder.abi.DecodeTableRowTyped(decoder.structType, []byte{0x05, 0, 0, 0, 0, 0, 0, 0})
+	require.NoError(t, err)
+
+	actual, err := decoder.decode(12, 1, []byte{0x05, 0, 0, 0, 0, 0, 0, 0})
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+	assert.Equal(t, 1, srv.decodedRows.len())
+
+	actual, err = decoder.decode(12, 1, []byte{0x05, 0, 0, 0, 0, 0, 0, 0})
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+	assert.Equal(t, 1, srv.decodedRows.len())
+
+	// A speculative row of another fork at the same block and primary key
+	expected, err = abiDecoder.abi.DecodeTableRowTyped(decoder.structType, []byte{0x06, 0, 0, 0, 0, 0, 0, 0})
+	require.NoError(t, err)
+
+	actual, err = decoder.decode(12, 1, []byte{0x06, 0, 0, 0, 0, 0, 0, 0})
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+	assert.Equal(t, 1, srv.decodedRows.len())
+}
+
+// BenchmarkReadTableResponse_JSON measures the throughput of `json=true` responses,
+// decoding each row (the decoded rows cache being disabled) and streaming it.
+func BenchmarkReadTableResponse_JSON(b *testing.B) {
+	abi := testRichABI(b)
+	packedABI, err := eos.MarshalBinary(abi)
+	require.NoError(b, err)
+
+	srv := &EOSServer{abiDecoders: newLRUCache(10), decodedRows: newLRUCache(0)}
+	abiDecoder, err := srv.abiDecoder(&fluxdb.ABIRow{Account: fluxdb.N("eosio.token"), BlockNum: 1, PackedABI: packedABI})
+	require.NoError(b, err)
+
+	decoder := abiDecoder.tableDecoder("rows")
+	data := testRichRowData(b)
+
+	response := &readTableResponse{}
+	for i := 0; i < 1000; i++ {
+		response.Rows = append(response.Rows, &tableRow{
+			Key:  "alice",
+			Data: &onTheFlyABISerializer{decoder: decoder, rowBlockNum: 1, primaryKey: uint64(i), data: data},
+		})
+	}
+
+	b.SetBytes(int64(len(response.Rows) * len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if err := gojay.NewEncoder(ioutil.Discard).Encode(response); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func testRichABI(t require.TestingT) *eos.ABI {
+	abi, err := eos.NewABI(strings.NewReader(`{
+		"version": "eosio::abi/1.1",
+		"types": [{"new_type_name": "account_name", "type": "name"}],
+		"structs": [
+			{"name": "base_row", "base": "", "fields": [{"name": "id", "type": "uint64"}]},
+			{"name": "point", "base": "", "fields": [{"name": "x", "type": "int32"}, {"name": "y", "type": "float64"}]},
+			{"name": "row", "base": "base_row", "fields": [
+				{"name": "owner", "type": "account_name"},
+				{"name": "balance", "type": "asset"},
+				{"name": "flag", "type": "bool"},
+				{"name": "small", "type": "int8"},
+				{"name": "big", "type": "int64"},
+				{"name": "memo", "type": "string"},
+				{"name": "blob", "type": "bytes"},
+				{"name": "points", "type": "point[]"},
+				{"name": "empty", "type": "uint16[]"},
+				{"name": "maybe", "type": "point?"},
+				{"name": "missing", "type": "uint32?"},
+				{"name": "choice", "type": "choice"},
+				{"name": "sym", "type": "symbol"},
+				{"name": "code", "type": "symbol_code"},
+				{"name": "hash", "type": "checksum256"},
+				{"name": "when", "type": "time_point_sec"},
+				{"name": "at", "type": "time_point"},
+				{"name": "ratio", "type": "float32"},
+				{"name": "huge", "type": "uint128"},
+				{"name": "signed", "type": "varint32"},
+				{"name": "unsigned", "type": "varuint32"},
+				{"name": "key", "type": "public_key"},
+				{"name": "extension", "type": "uint32$"}
+			]},
+			{"name": "lenient", "base": "", "fields": [{"name": "value", "type": "uint8"}, {"name": "other", "type": "unknown_type?"}]}
+		],
+		"variants": [{"name": "choice", "types": ["uint8", "point"]}],
+		"tables": [{"name": "rows", "index_type": "i64", "key_names": [], "key_types": [], "type": "row"}]
+	}`))
+	require.NoError(t, err)
+
+	return abi
+}
+
+func testRichRowData(t require.TestingT) []byte {
+	key, err := ecc.NewPublicKey("EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV")
+	require.NoError(t, err)
+
+	buffer := &bytes.Buffer{}
+	write := func(values ...interface{}) {
+		for _, value := range values {
+			data, err := eos.MarshalBinary(value)
+			require.NoError(t, err)
+			buffer.Write(data)
+		}
+	}
+
+	varint := make([]byte, binary.MaxVarintLen64)
+
+	write(uint64(7), eos.Name("alice"), eos.NewEOSAsset(10000), true, int8(-3), int64(-1<<40), `say "hi"`, []byte{0x01, 0x02, 0x03})
+	write(eos.Varuint32(2), int32(-1), float64(1.5), int32(2), float64(-0.25))
+	write(eos.Varuint32(0))
+	write(uint8(1), int32(3), float64(3))
+	write(uint8(0))
+	write(eos.Varuint32(1), int32(4), float64(4))
+	buffer.Write([]byte{0x04, 'E', 'O', 'S', 0, 0, 0, 0})
+	buffer.Write([]byte{'E', 'O', 'S', 0, 0, 0, 0, 0})
+	buffer.Write(bytes.Repeat([]byte{0xab}, 32))
+	write(uint32(1577836800), uint64(1577836800123000), float32(0.1))
+	buffer.Write(bytes.Repeat([]byte{0x01}, 16))
+	buffer.Write(varint[:binary.PutVarint(varint, -42)])
+	buffer.Write(varint[:binary.PutUvarint(varint, 300)])
+	write(key)
+
+	return buffer.Bytes()
+}
+
+func testABIRow(t *testing.T, blockNum uint32, structName string) *fluxdb.ABIRow {
+	abi, err := eos.NewABI(strings.NewReader(`{
+		"version": "eosio::abi/1.1",
+		"structs": [{"name": "` + structName + `", "base": "", "fields": [{"name": "value", "type": "uint64"}]}],
+		"tables": [{"name": "rows", "index_type": "i64", "key_names": [], "key_types": [], "type": "` + structName + `"}]
+	}`))
+	require.NoError(t, err)
+
+	packedABI, err := eos.MarshalBinary(abi)
+	require.NoError(t, err)
+
+	return &fluxdb.ABIRow{Account: fluxdb.N("eosio.token"), BlockNum: blockNum, PackedABI: packedABI}
+}
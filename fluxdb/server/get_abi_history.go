@@ -0,0 +1,223 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) getABIHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlog := logging.Logger(ctx, zlog)
+
+	errors := validateGetABIHistoryRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractGetABIHistoryRequest(r)
+	zlog.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, lastWrittenBlockID, upToBlockID, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, false)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	abiRows, err := srv.db.ReadABIHistory(ctx, actualBlockNum, fluxdb.N(string(request.Account)), speculativeWrites)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "reading ABI history failed"))
+		return
+	}
+
+	response := &getABIHistoryResponse{
+		commonStateResponse: newCommonGetResponse(upToBlockID, lastWrittenBlockID),
+		Account:             request.Account,
+	}
+
+	var previousABI *eos.ABI
+	for _, abiRow := range abiRows {
+		hash := sha256.Sum256(abiRow.PackedABI)
+		revision := &abiRevisionResponse{
+			BlockNum: abiRow.BlockNum,
+			Hash:     hex.EncodeToString(hash[:]),
+		}
+
+		if request.Diff {
+			abiDecoder, err := srv.abiDecoder(abiRow)
+			if err != nil {
+				writeError(ctx, w, derr.Wrapf(err, "decoding ABI at block %d failed", abiRow.BlockNum))
+				return
+			}
+
+			revision.Diff = diffABIs(previousABI, abiDecoder.abi)
+			previousABI = abiDecoder.abi
+		}
+
+		response.Revisions = append(response.Revisions, revision)
+	}
+
+	zlog.Debug("writing response", zap.Int("revision_count", len(response.Revisions)))
+	writeResponse(ctx, w, response)
+}
+
+type getABIHistoryRequest struct {
+	BlockNum uint32          `json:"block_num"`
+	Account  eos.AccountName `json:"account"`
+	Diff     bool            `json:"diff"`
+}
+
+type getABIHistoryResponse struct {
+	*commonStateResponse
+
+	Account   eos.AccountName        `json:"account"`
+	Revisions []*abiRevisionResponse `json:"revisions"`
+}
+
+// abiRevisionResponse is one `setabi` of the account, `hash` being the sha256 of the
+// packed ABI, like the ABI hash reported by nodeos. When requested, `diff` lists what
+// changed compared to the previous revision, everything being added on the first one.
+type abiRevisionResponse struct {
+	BlockNum uint32           `json:"block_num"`
+	Hash     string           `json:"hash"`
+	Diff     *abiDiffResponse `json:"diff,omitempty"`
+}
+
+type abiDiffResponse struct {
+	Actions *abiDefinitionsDiff `json:"actions"`
+	Tables  *abiDefinitionsDiff `json:"tables"`
+	Structs *abiDefinitionsDiff `json:"structs"`
+}
+
+// abiDefinitionsDiff holds the names of the definitions added, removed and changed,
+// an action or a table also being changed when the struct it refers to changed.
+type abiDefinitionsDiff struct {
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+	Changed []string `json:"changed,omitempty"`
+}
+
+func diffABIs(previous, current *eos.ABI) *abiDiffResponse {
+	if previous == nil {
+		previous = &eos.ABI{}
+	}
+
+	previousStructs := map[string]interface{}{}
+	for _, structDef := range previous.Structs {
+		previousStructs[structDef.Name] = structDef
+	}
+
+	currentStructs := map[string]interface{}{}
+	for _, structDef := range current.Structs {
+		currentStructs[structDef.Name] = structDef
+	}
+
+	structsDiff := diffDefinitions(previousStructs, currentStructs, nil)
+	changedStructs := map[string]bool{}
+	for _, name := range structsDiff.Changed {
+		changedStructs[name] = true
+	}
+
+	previousActions := map[string]interface{}{}
+	for _, action := range previous.Actions {
+		previousActions[string(action.Name)] = action
+	}
+
+	currentActions := map[string]interface{}{}
+	currentActionTypes := map[string]string{}
+	for _, action := range current.Actions {
+		currentActions[string(action.Name)] = action
+		currentActionTypes[string(action.Name)] = action.Type
+	}
+
+	previousTables := map[string]interface{}{}
+	for _, table := range previous.Tables {
+		previousTables[string(table.Name)] = table
+	}
+
+	currentTables := map[string]interface{}{}
+	currentTableTypes := map[string]string{}
+	for _, table := range current.Tables {
+		currentTables[string(table.Name)] = table
+		currentTableTypes[string(table.Name)] = table.Type
+	}
+
+	return &abiDiffResponse{
+		Actions: diffDefinitions(previousActions, currentActions, func(name string) bool { return changedStructs[currentActionTypes[name]] }),
+		Tables:  diffDefinitions(previousTables, currentTables, func(name string) bool { return changedStructs[currentTableTypes[name]] }),
+		Structs: structsDiff,
+	}
+}
+
+// diffDefinitions compares definitions by name, a definition present on both sides
+// being changed when it differs or when `dependencyChanged` reports so.
+func diffDefinitions(previous, current map[string]interface{}, dependencyChanged func(name string) bool) *abiDefinitionsDiff {
+	out := &abiDefinitionsDiff{}
+	for name, definition := range current {
+		previousDefinition, found := previous[name]
+		switch {
+		case !found:
+			out.Added = append(out.Added, name)
+		case !reflect.DeepEqual(previousDefinition, definition):
+			out.Changed = append(out.Changed, name)
+		case dependencyChanged != nil && dependencyChanged(name):
+			out.Changed = append(out.Changed, name)
+		}
+	}
+
+	for name := range previous {
+		if _, found := current[name]; !found {
+			out.Removed = append(out.Removed, name)
+		}
+	}
+
+	sort.Strings(out.Added)
+	sort.Strings(out.Removed)
+	sort.Strings(out.Changed)
+
+	return out
+}
+
+func validateGetABIHistoryRequest(r *http.Request) url.Values {
+	return validator.ValidateQueryParams(r, validator.Rules{
+		"account":   []string{"required", "fluxdb.eos.name"},
+		"block_num": []string{"fluxdb.eos.blockNum"},
+		"diff":      []string{"bool"},
+	})
+}
+
+func extractGetABIHistoryRequest(r *http.Request) *getABIHistoryRequest {
+	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
+
+	return &getABIHistoryRequest{
+		BlockNum: uint32(blockNum64),
+		Account:  eos.AccountName(r.FormValue("account")),
+		Diff:     boolInput(r.FormValue("diff")),
+	}
+}
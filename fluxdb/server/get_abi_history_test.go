@@ -0,0 +1,68 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	eos "github.com/eoscanada/eos-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffABIs(t *testing.T) {
+	previous := &eos.ABI{
+		Structs: []eos.StructDef{
+			{Name: "transfer", Fields: []eos.FieldDef{{Name: "from", Type: "name"}, {Name: "to", Type: "name"}}},
+			{Name: "account", Fields: []eos.FieldDef{{Name: "balance", Type: "asset"}}},
+			{Name: "close", Fields: []eos.FieldDef{{Name: "owner", Type: "name"}}},
+		},
+		Actions: []eos.ActionDef{
+			{Name: "transfer", Type: "transfer"},
+			{Name: "close", Type: "close"},
+		},
+		Tables: []eos.TableDef{
+			{Name: "accounts", IndexType: "i64", Type: "account"},
+		},
+	}
+
+	current := &eos.ABI{
+		Structs: []eos.StructDef{
+			{Name: "transfer", Fields: []eos.FieldDef{{Name: "from", Type: "name"}, {Name: "to", Type: "name"}}},
+			{Name: "account", Fields: []eos.FieldDef{{Name: "balance", Type: "asset"}, {Name: "locked", Type: "bool"}}},
+			{Name: "open", Fields: []eos.FieldDef{{Name: "owner", Type: "name"}}},
+			{Name: "stat", Fields: []eos.FieldDef{{Name: "supply", Type: "asset"}}},
+		},
+		Actions: []eos.ActionDef{
+			{Name: "transfer", Type: "transfer", RicardianContract: "Transfers tokens"},
+			{Name: "open", Type: "open"},
+		},
+		Tables: []eos.TableDef{
+			{Name: "accounts", IndexType: "i64", Type: "account"},
+			{Name: "stat", IndexType: "i64", Type: "stat"},
+		},
+	}
+
+	assert.Equal(t, &abiDiffResponse{
+		Actions: &abiDefinitionsDiff{Added: []string{"open"}, Removed: []string{"close"}, Changed: []string{"transfer"}},
+		Tables:  &abiDefinitionsDiff{Added: []string{"stat"}, Changed: []string{"accounts"}},
+		Structs: &abiDefinitionsDiff{Added: []string{"open", "stat"}, Removed: []string{"close"}, Changed: []string{"account"}},
+	}, diffABIs(previous, current))
+
+	assert.Equal(t, &abiDiffResponse{
+		Actions: &abiDefinitionsDiff{Added: []string{"close", "transfer"}},
+		Tables:  &abiDefinitionsDiff{Added: []string{"accounts"}},
+		Structs: &abiDefinitionsDiff{Added: []string{"account", "close", "transfer"}},
+	}, diffABIs(nil, previous))
+}
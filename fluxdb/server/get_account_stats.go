@@ -0,0 +1,120 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) getAccountStatsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlog := logging.Logger(ctx, zlog)
+
+	errors := validateGetAccountStatsRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractGetAccountStatsRequest(r)
+	zlog.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, lastWrittenBlockID, upToBlockID, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, request.IrreversibleOnly)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	tablesStats, err := srv.db.ReadAccountTableStats(ctx, actualBlockNum, fluxdb.N(string(request.Account)), speculativeWrites)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "reading account table stats failed"))
+		return
+	}
+
+	response := &getAccountStatsResponse{
+		commonStateResponse: newCommonGetResponse(upToBlockID, lastWrittenBlockID),
+		Account:             request.Account,
+		TableCount:          len(tablesStats),
+		Tables:              make([]*accountTableStats, len(tablesStats)),
+	}
+
+	for i, stats := range tablesStats {
+		response.RowCount += stats.RowCount
+		response.PayloadBytes += stats.PayloadByteCount
+		response.Tables[i] = &accountTableStats{
+			Table:        fluxdb.NameToString(stats.Table),
+			Scope:        fluxdb.NameToString(stats.Scope),
+			RowCount:     stats.RowCount,
+			PayloadBytes: stats.PayloadByteCount,
+		}
+	}
+
+	zlog.Debug("writing response", zap.Int("table_count", response.TableCount), zap.Uint64("row_count", response.RowCount))
+	writeResponse(ctx, w, response)
+}
+
+type getAccountStatsRequest struct {
+	BlockNum         uint32          `json:"block_num"`
+	IrreversibleOnly bool            `json:"irreversible_only"`
+	Account          eos.AccountName `json:"account"`
+}
+
+// getAccountStatsResponse rolls up the stats of every (table, scope) pair of the
+// account holding rows at the requested block, each pair being counted once in
+// `table_count`.
+type getAccountStatsResponse struct {
+	*commonStateResponse
+
+	Account      eos.AccountName      `json:"account"`
+	TableCount   int                  `json:"table_count"`
+	RowCount     uint64               `json:"row_count"`
+	PayloadBytes uint64               `json:"payload_bytes"`
+	Tables       []*accountTableStats `json:"tables"`
+}
+
+type accountTableStats struct {
+	Table        string `json:"table"`
+	Scope        string `json:"scope"`
+	RowCount     uint64 `json:"row_count"`
+	PayloadBytes uint64 `json:"payload_bytes"`
+}
+
+func validateGetAccountStatsRequest(r *http.Request) url.Values {
+	return validator.ValidateQueryParams(r, validator.Rules{
+		"block_num":         []string{"fluxdb.eos.blockNum"},
+		"account":           []string{"required", "fluxdb.eos.name"},
+		"irreversible_only": []string{"bool"},
+	})
+}
+
+func extractGetAccountStatsRequest(r *http.Request) *getAccountStatsRequest {
+	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
+	irreversibleOnly, _ := strconv.ParseBool(r.FormValue("irreversible_only"))
+
+	return &getAccountStatsRequest{
+		BlockNum:         uint32(blockNum64),
+		IrreversibleOnly: irreversibleOnly,
+		Account:          eos.AccountName(r.FormValue("account")),
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) getResourcesHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlog := logging.Logger(ctx, zlog)
+
+	errors := validateGetResourcesRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractGetResourcesRequest(r)
+	zlog.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, lastWrittenBlockID, upToBlockID, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, false)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	resources, err := srv.db.ReadAccountResources(ctx, actualBlockNum, request.Account, speculativeWrites)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "reading account resources failed"))
+		return
+	}
+
+	writeResponse(ctx, w, newGetResourcesResponse(request.Account, resources, newCommonGetResponse(upToBlockID, lastWrittenBlockID)))
+}
+
+type getResourcesRequest struct {
+	BlockNum uint32          `json:"block_num"`
+	Account  eos.AccountName `json:"account"`
+}
+
+// getResourcesResponse holds the account's resource limits and usage, each being
+// null when fluxdb never saw it, `block_num` being the block at which it last changed.
+// A limit of `-1` means the resource is unlimited.
+type getResourcesResponse struct {
+	*commonStateResponse
+
+	Account eos.AccountName         `json:"account"`
+	Limits  *resourceLimitsResponse `json:"limits"`
+	Usage   *resourceUsageResponse  `json:"usage"`
+}
+
+type resourceLimitsResponse struct {
+	NetWeight int64  `json:"net_weight"`
+	CPUWeight int64  `json:"cpu_weight"`
+	RAMBytes  int64  `json:"ram_bytes"`
+	BlockNum  uint32 `json:"block_num"`
+}
+
+type resourceUsageResponse struct {
+	NetUsage *usageAccumulatorResponse `json:"net_usage"`
+	CPUUsage *usageAccumulatorResponse `json:"cpu_usage"`
+	RAMUsage uint64                    `json:"ram_usage"`
+	BlockNum uint32                    `json:"block_num"`
+}
+
+type usageAccumulatorResponse struct {
+	LastOrdinal uint32 `json:"last_ordinal"`
+	ValueEx     uint64 `json:"value_ex"`
+	Consumed    uint64 `json:"consumed"`
+}
+
+func newGetResourcesResponse(account eos.AccountName, resources *fluxdb.AccountResources, common *commonStateResponse) *getResourcesResponse {
+	out := &getResourcesResponse{
+		commonStateResponse: common,
+		Account:             account,
+	}
+
+	if limits := resources.Limits; limits != nil {
+		out.Limits = &resourceLimitsResponse{
+			NetWeight: limits.NetWeight,
+			CPUWeight: limits.CpuWeight,
+			RAMBytes:  limits.RamBytes,
+			BlockNum:  resources.LimitsBlockNum,
+		}
+	}
+
+	if usage := resources.Usage; usage != nil {
+		out.Usage = &resourceUsageResponse{
+			NetUsage: newUsageAccumulatorResponse(usage.NetUsage),
+			CPUUsage: newUsageAccumulatorResponse(usage.CpuUsage),
+			RAMUsage: usage.RamUsage,
+			BlockNum: resources.UsageBlockNum,
+		}
+	}
+
+	return out
+}
+
+func newUsageAccumulatorResponse(accumulator *pbcodec.UsageAccumulator) *usageAccumulatorResponse {
+	if accumulator == nil {
+		return &usageAccumulatorResponse{}
+	}
+
+	return &usageAccumulatorResponse{
+		LastOrdinal: accumulator.LastOrdinal,
+		ValueEx:     accumulator.ValueEx,
+		Consumed:    accumulator.Consumed,
+	}
+}
+
+func validateGetResourcesRequest(r *http.Request) url.Values {
+	return validator.ValidateQueryParams(r, validator.Rules{
+		"block_num": []string{"fluxdb.eos.blockNum"},
+		"account":   []string{"required", "fluxdb.eos.name"},
+	})
+}
+
+func extractGetResourcesRequest(r *http.Request) *getResourcesRequest {
+	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
+
+	return &getResourcesRequest{
+		BlockNum: uint32(blockNum64),
+		Account:  eos.AccountName(r.FormValue("account")),
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) getTableDiffHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlog := logging.Logger(ctx, zlog)
+
+	errors := validateGetTableDiffRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractGetTableDiffRequest(r)
+	zlog.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, lastWrittenBlockID, upToBlockID, speculativeWrites, err := srv.prepareRead(ctx, request.ToBlock, request.IrreversibleOnly)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	if request.FromBlock > actualBlockNum {
+		writeError(ctx, w, derr.RequestValidationError(ctx, url.Values{
+			"from_block": []string{fmt.Sprintf("The from_block field must be lower or equal to the block the diff is computed at (%d)", actualBlockNum)},
+		}))
+		return
+	}
+
+	if err := srv.checkBlockNumRetained(ctx, request.FromBlock); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	diffResponse, err := srv.diffTable(
+		ctx,
+		request.FromBlock,
+		actualBlockNum,
+		request.Account,
+		request.Table,
+		request.Scope,
+		request.readRequestCommon,
+		getKeyConverterForType(request.KeyType),
+		speculativeWrites,
+	)
+
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "diff table failed"))
+		return
+	}
+
+	response := &getTableDiffResponse{
+		commonStateResponse: newCommonGetResponse(upToBlockID, lastWrittenBlockID),
+		diffTableResponse:   diffResponse,
+	}
+
+	zlog.Debug("streaming response",
+		zap.Int("inserted_count", len(diffResponse.Inserted)),
+		zap.Int("updated_count", len(diffResponse.Updated)),
+		zap.Int("removed_count", len(diffResponse.Removed)),
+		zap.Reflect("common_response", response.commonStateResponse),
+	)
+	streamResponse(ctx, w, response)
+}
+
+type getTableDiffRequest struct {
+	*readRequestCommon
+
+	IrreversibleOnly bool   `json:"irreversible_only"`
+	Account          string `json:"account"`
+	Table            string `json:"table"`
+	Scope            string `json:"scope"`
+	FromBlock        uint32 `json:"from_block"`
+	ToBlock          uint32 `json:"to_block"`
+}
+
+type getTableDiffResponse struct {
+	*commonStateResponse
+	*diffTableResponse
+}
+
+type diffTableResponse struct {
+	ABI      *eos.ABI          `json:"abi"`
+	Inserted []*tableRow       `json:"inserted"`
+	Updated  []*tableRowUpdate `json:"updated"`
+	Removed  []*tableRow       `json:"removed"`
+}
+
+type tableRowUpdate struct {
+	Old *tableRow `json:"old"`
+	New *tableRow `json:"new"`
+}
+
+func validateGetTableDiffRequest(r *http.Request) url.Values {
+	errors := validator.ValidateQueryParams(r, withCommonValidationRules(validator.Rules{
+		"account":           []string{"required", "fluxdb.eos.name"},
+		"table":             []string{"required", "fluxdb.eos.name"},
+		"scope":             []string{"fluxdb.eos.extendedName"},
+		"from_block":        []string{"required", "fluxdb.eos.blockNum"},
+		"to_block":          []string{"fluxdb.eos.blockNum"},
+		"irreversible_only": []string{"bool"},
+	}))
+
+	// Let's ensure the scope param is at least present (but can be the empty string)
+	if _, ok := r.Form["scope"]; !ok {
+		errors["scope"] = []string{"The scope field is required"}
+	}
+
+	if len(errors["from_block"]) == 0 && len(errors["to_block"]) == 0 {
+		request := extractGetTableDiffRequest(r)
+		if request.ToBlock != 0 && request.FromBlock > request.ToBlock {
+			errors["from_block"] = []string{fmt.Sprintf("The from_block field must be lower or equal to to_block (%d)", request.ToBlock)}
+		}
+	}
+
+	return errors
+}
+
+func extractGetTableDiffRequest(r *http.Request) *getTableDiffRequest {
+	irreversibleOnly, _ := strconv.ParseBool(r.FormValue("irreversible_only"))
+	fromBlock, _ := strconv.ParseUint(r.FormValue("from_block"), 10, 32)
+	toBlock, _ := strconv.ParseUint(r.FormValue("to_block"), 10, 32)
+
+	return &getTableDiffRequest{
+		readRequestCommon: extractReadRequestCommon(r),
+
+		Table:            r.FormValue("table"),
+		Account:          r.FormValue("account"),
+		Scope:            r.FormValue("scope"),
+		FromBlock:        uint32(fromBlock),
+		ToBlock:          uint32(toBlock),
+		IrreversibleOnly: irreversibleOnly,
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) getTableRowHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlog := logging.Logger(ctx, zlog)
+
+	errors := validateGetTableRowHistoryRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractGetTableRowHistoryRequest(r)
+	zlog.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, lastWrittenBlockID, upToBlockID, speculativeWrites, err := srv.prepareRead(ctx, request.ToBlock, request.IrreversibleOnly)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	// Without a lower bound, the history simply starts at the first retained block
+	if request.FromBlock != 0 {
+		if err := srv.checkBlockNumRetained(ctx, request.FromBlock); err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+	}
+
+	mutations, err := srv.readTableRowHistory(
+		ctx,
+		request.FromBlock,
+		actualBlockNum,
+		request.Account,
+		request.Table,
+		request.Scope,
+		request.PrimaryKey,
+		request.readRequestCommon,
+		getKeyConverterForType(request.KeyType),
+		speculativeWrites,
+	)
+
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "read table row history failed"))
+		return
+	}
+
+	response := &getTableRowHistoryResponse{
+		commonStateResponse: newCommonGetResponse(upToBlockID, lastWrittenBlockID),
+		Mutations:           mutations,
+	}
+
+	zlog.Debug("streaming response", zap.Int("mutation_count", len(response.Mutations)), zap.Reflect("common_response", response.commonStateResponse))
+	streamResponse(ctx, w, response)
+}
+
+type getTableRowHistoryRequest struct {
+	*readRequestCommon
+
+	IrreversibleOnly bool   `json:"irreversible_only"`
+	Account          string `json:"account"`
+	Table            string `json:"table"`
+	Scope            string `json:"scope"`
+	PrimaryKey       string `json:"primary_key"`
+	FromBlock        uint32 `json:"from_block"`
+	ToBlock          uint32 `json:"to_block"`
+}
+
+type getTableRowHistoryResponse struct {
+	*commonStateResponse
+	Mutations []*tableRowMutation `json:"mutations"`
+}
+
+func validateGetTableRowHistoryRequest(r *http.Request) url.Values {
+	errors := validator.ValidateQueryParams(r, withCommonValidationRules(validator.Rules{
+		"account":           []string{"required", "fluxdb.eos.name"},
+		"table":             []string{"required", "fluxdb.eos.name"},
+		"scope":             []string{"fluxdb.eos.extendedName"},
+		"primary_key":       []string{"required"},
+		"from_block":        []string{"fluxdb.eos.blockNum"},
+		"to_block":          []string{"fluxdb.eos.blockNum"},
+		"irreversible_only": []string{"bool"},
+	}))
+
+	// Let's ensure the scope param is at least present (but can be the empty string)
+	if _, ok := r.Form["scope"]; !ok {
+		errors["scope"] = []string{"The scope field is required"}
+	}
+
+	if len(errors["primary_key"]) == 0 && len(errors["key_type"]) == 0 {
+		keyType := r.FormValue("key_type")
+		if _, err := getKeyConverterForType(keyType).FromString(r.FormValue("primary_key")); err != nil {
+			errors["primary_key"] = []string{"The primary_key field must be a valid key for the requested key_type"}
+		}
+	}
+
+	if len(errors["from_block"]) == 0 && len(errors["to_block"]) == 0 {
+		request := extractGetTableRowHistoryRequest(r)
+		if request.ToBlock != 0 && request.FromBlock > request.ToBlock {
+			errors["from_block"] = []string{fmt.Sprintf("The from_block field must be lower or equal to to_block (%d)", request.ToBlock)}
+		}
+	}
+
+	return errors
+}
+
+func extractGetTableRowHistoryRequest(r *http.Request) *getTableRowHistoryRequest {
+	irreversibleOnly, _ := strconv.ParseBool(r.FormValue("irreversible_only"))
+	fromBlock, _ := strconv.ParseUint(r.FormValue("from_block"), 10, 32)
+	toBlock, _ := strconv.ParseUint(r.FormValue("to_block"), 10, 32)
+
+	return &getTableRowHistoryRequest{
+		readRequestCommon: extractReadRequestCommon(r),
+
+		Table:            r.FormValue("table"),
+		Account:          r.FormValue("account"),
+		Scope:            r.FormValue("scope"),
+		PrimaryKey:       r.FormValue("primary_key"),
+		FromBlock:        uint32(fromBlock),
+		ToBlock:          uint32(toBlock),
+		IrreversibleOnly: irreversibleOnly,
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) getTableStatsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlog := logging.Logger(ctx, zlog)
+
+	errors := validateGetTableStatsRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractGetTableStatsRequest(r)
+	zlog.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, lastWrittenBlockID, upToBlockID, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, request.IrreversibleOnly)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	stats, err := srv.db.ReadTableStats(ctx, actualBlockNum, fluxdb.N(request.Account), fluxdb.EN(request.Scope), fluxdb.N(request.Table), speculativeWrites)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "reading table stats failed"))
+		return
+	}
+
+	response := &getTableStatsResponse{
+		commonStateResponse: newCommonGetResponse(upToBlockID, lastWrittenBlockID),
+		Account:             request.Account,
+		Scope:               request.Scope,
+		Table:               request.Table,
+		RowCount:            stats.RowCount,
+		PayloadBytes:        stats.PayloadByteCount,
+	}
+
+	writeResponse(ctx, w, response)
+}
+
+type getTableStatsRequest struct {
+	BlockNum         uint32 `json:"block_num"`
+	IrreversibleOnly bool   `json:"irreversible_only"`
+	Account          string `json:"account"`
+	Scope            string `json:"scope"`
+	Table            string `json:"table"`
+}
+
+type getTableStatsResponse struct {
+	*commonStateResponse
+
+	Account      string `json:"account"`
+	Scope        string `json:"scope"`
+	Table        string `json:"table"`
+	RowCount     uint64 `json:"row_count"`
+	PayloadBytes uint64 `json:"payload_bytes"`
+}
+
+func validateGetTableStatsRequest(r *http.Request) url.Values {
+	errors := validator.ValidateQueryParams(r, validator.Rules{
+		"block_num":         []string{"fluxdb.eos.blockNum"},
+		"account":           []string{"required", "fluxdb.eos.name"},
+		"table":             []string{"required", "fluxdb.eos.name"},
+		"scope":             []string{"fluxdb.eos.extendedName"},
+		"irreversible_only": []string{"bool"},
+	})
+
+	// Let's ensure the scope param is at least present (but can be the empty string)
+	if _, ok := r.Form["scope"]; !ok {
+		errors["scope"] = []string{"The scope field is required"}
+	}
+
+	return errors
+}
+
+func extractGetTableStatsRequest(r *http.Request) *getTableStatsRequest {
+	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
+	irreversibleOnly, _ := strconv.ParseBool(r.FormValue("irreversible_only"))
+
+	return &getTableStatsRequest{
+		BlockNum:         uint32(blockNum64),
+		IrreversibleOnly: irreversibleOnly,
+		Account:          r.FormValue("account"),
+		Scope:            r.FormValue("scope"),
+		Table:            r.FormValue("table"),
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	pbfluxdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/fluxdb/v1"
+	"github.com/dfuse-io/dgrpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// GRPCServer serves the streaming endpoints of FluxDB, it needs the live
+// handler to follow the blocks as they are processed by the pipeline.
+type GRPCServer struct {
+	db      *fluxdb.FluxDB
+	handler *fluxdb.FluxDBHandler
+	addr    string
+	gs      *grpc.Server
+}
+
+func NewGRPC(addr string, db *fluxdb.FluxDB, handler *fluxdb.FluxDBHandler) *GRPCServer {
+	srv := &GRPCServer{
+		db:      db,
+		handler: handler,
+		addr:    addr,
+		gs:      dgrpc.NewServer(dgrpc.WithLogger(zlog)),
+	}
+
+	pbfluxdb.RegisterStateServer(srv.gs, srv)
+
+	db.OnTerminating(func(e error) {
+		// Streams never end by themselves, a graceful stop would wait on them forever
+		zlog.Info("shutting down grpc server, closing active streams")
+		srv.gs.Stop()
+	})
+
+	return srv
+}
+
+func (srv *GRPCServer) Serve() {
+	zlog.Info("listening & serving gRPC content", zap.String("grpc_listen_addr", srv.addr))
+	listener, err := net.Listen("tcp", srv.addr)
+	if err != nil {
+		srv.db.Shutdown(fmt.Errorf("failed listening grpc %q: %w", srv.addr, err))
+		return
+	}
+
+	err = srv.gs.Serve(listener)
+	if err != nil && err != grpc.ErrServerStopped {
+		srv.db.Shutdown(fmt.Errorf("failed serving grpc %q: %w", srv.addr, err))
+	}
+}
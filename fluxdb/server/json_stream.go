@@ -25,12 +25,12 @@ import (
 )
 
 func (s *onTheFlyABISerializer) MarshalJSON() ([]byte, error) {
-	jsonData, err := s.abi.DecodeTableRowTyped(s.structType, s.data)
+	jsonData, err := s.decoder.decode(s.rowBlockNum, s.primaryKey, s.data)
 	if err != nil {
 		// TRACK THIS..
 		return json.Marshal(map[string]interface{}{
 			"hex":   eos.HexBytes(s.data),
-			"error": fmt.Sprintf("ABI from block %d, row struct %q, data: %q, err: %s", s.abiRow.BlockNum, s.structType, hex.EncodeToString(s.data), err),
+			"error": fmt.Sprintf("ABI from block %d, row struct %q, data: %q, err: %s", s.decoder.abiDecoder.abiRow.BlockNum, s.decoder.structType, hex.EncodeToString(s.data), err),
 		})
 	}
 
@@ -80,6 +80,7 @@ func (r *commonStateResponse) IsNil() bool { return r == nil }
 func (r *getTableResponse) MarshalJSONObject(enc *gojay.Encoder) {
 	enc.AddStringKey("account", r.Account)
 	enc.AddStringKey("scope", r.Scope)
+	enc.AddStringKeyOmitEmpty("table", r.Table)
 
 	r.readTableResponse.MarshalJSONObject(enc)
 }
@@ -98,6 +99,86 @@ func (r *getTableRowResponse) MarshalJSONObject(enc *gojay.Encoder) {
 
 func (r *getTableRowResponse) IsNil() bool { return r == nil }
 
+func (r *getTableRowHistoryResponse) MarshalJSONObject(enc *gojay.Encoder) {
+	r.commonStateResponse.MarshalJSONObject(enc)
+
+	enc.AddArrayKey("mutations", gojay.EncodeArrayFunc(func(enc *gojay.Encoder) {
+		lastIdx := len(r.Mutations) - 1
+		for idx, mutation := range r.Mutations {
+			if err := enc.EncodeObject(mutation); err != nil {
+				// the error should bubble up through the `gojay.Encoder`.
+				return
+			}
+			if idx != lastIdx {
+				enc.AppendByte(',')
+			}
+		}
+	}))
+}
+
+func (r *getTableRowHistoryResponse) IsNil() bool { return r == nil }
+
+func (m *tableRowMutation) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.AddUint32Key("block_num", m.BlockNum)
+	if m.Deleted {
+		enc.AddBoolKey("deleted", true)
+	}
+
+	m.Row.MarshalJSONObject(enc)
+}
+
+func (m *tableRowMutation) IsNil() bool { return m == nil }
+
+func (r *getTableDiffResponse) MarshalJSONObject(enc *gojay.Encoder) {
+	r.commonStateResponse.MarshalJSONObject(enc)
+
+	if r.ABI != nil {
+		d, _ := json.Marshal(r.ABI)
+		j := gojay.EmbeddedJSON(d)
+
+		enc.AddEmbeddedJSONKey("abi", &j)
+	}
+
+	enc.AddArrayKey("inserted", tableRows(r.Inserted))
+	enc.AddArrayKey("updated", gojay.EncodeArrayFunc(func(enc *gojay.Encoder) {
+		lastIdx := len(r.Updated) - 1
+		for idx, update := range r.Updated {
+			if err := enc.EncodeObject(update); err != nil {
+				// the error should bubble up through the `gojay.Encoder`.
+				return
+			}
+			if idx != lastIdx {
+				enc.AppendByte(',')
+			}
+		}
+	}))
+	enc.AddArrayKey("removed", tableRows(r.Removed))
+}
+
+func (r *getTableDiffResponse) IsNil() bool { return r == nil }
+
+func (u *tableRowUpdate) MarshalJSONObject(enc *gojay.Encoder) {
+	enc.AddObjectKey("old", u.Old)
+	enc.AddObjectKey("new", u.New)
+}
+
+func (u *tableRowUpdate) IsNil() bool { return u == nil }
+
+func tableRows(rows []*tableRow) gojay.EncodeArrayFunc {
+	return gojay.EncodeArrayFunc(func(enc *gojay.Encoder) {
+		lastIdx := len(rows) - 1
+		for idx, row := range rows {
+			if err := enc.EncodeObject(row); err != nil {
+				// the error should bubble up through the `gojay.Encoder`.
+				return
+			}
+			if idx != lastIdx {
+				enc.AppendByte(',')
+			}
+		}
+	})
+}
+
 func (r *readTableResponse) MarshalJSONObject(enc *gojay.Encoder) {
 	if r.ABI != nil {
 		d, _ := json.Marshal(r.ABI)
@@ -136,11 +217,11 @@ func (r *tableRow) MarshalJSONObject(enc *gojay.Encoder) {
 	case *onTheFlyABISerializer:
 		s := v
 
-		jsonData, err := s.abi.DecodeTableRowTyped(s.structType, s.data)
+		jsonData, err := s.decoder.decode(s.rowBlockNum, s.primaryKey, s.data)
 		if err != nil {
 			// TRACK THIS..
 			enc.AddStringKey("hex", hex.EncodeToString(s.data))
-			enc.AddStringKey("error", fmt.Sprintf("ABI from block %d, row struct %q, err: %s", s.abiRow.BlockNum, s.structType, err))
+			enc.AddStringKey("error", fmt.Sprintf("ABI from block %d, row struct %q, err: %s", s.decoder.abiDecoder.abiRow.BlockNum, s.decoder.structType, err))
 		} else {
 			jsonData := gojay.EmbeddedJSON(jsonData)
 			enc.AddEmbeddedJSONKey("json", &jsonData)
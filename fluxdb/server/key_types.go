@@ -17,7 +17,11 @@ package server
 import (
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	eos "github.com/eoscanada/eos-go"
 )
@@ -27,13 +31,62 @@ type KeyConverter interface {
 	ToString(key uint64) (string, error)
 }
 
+// RowKeyConverter is a `KeyConverter` able to render a key from the content of its
+// row, decoded to JSON through the table's ABI, instead of from the key alone. It's
+// used for keys that can't be turned back into something readable, like hashes.
+type RowKeyConverter interface {
+	KeyConverter
+
+	RowToString(key uint64, rowJSON []byte) (string, error)
+}
+
 var keyTypeToKeyConverter = map[string]KeyConverter{
 	"uint64":      &Uint64KeyConverter{},
+	"i64":         &Uint64KeyConverter{},
 	"name":        &NameKeyConverter{},
 	"symbol":      &SymbolKeyConverter{},
 	"symbol_code": &SymbolCodeKeyConverter{},
 	"hex":         &HexKeyConverter{},
 	"hex_be":      &HexBEKeyConverter{},
+	"checksum256": &Checksum256KeyConverter{},
+}
+
+// structKeyTypePrefix starts the expression form of `key_type`, `struct:account,symbol`
+// renders keys from the `account` and `symbol` fields of each row.
+const structKeyTypePrefix = "struct:"
+
+// RegisterKeyConverter makes `converter` available through `key_type=<keyType>`. It's
+// not safe for concurrent use and must be called before the server starts, from an
+// `init` function typically.
+func RegisterKeyConverter(keyType string, converter KeyConverter) {
+	if _, exists := keyTypeToKeyConverter[keyType]; exists {
+		panic(fmt.Errorf("key converter for key type %q is already registered", keyType))
+	}
+
+	if strings.HasPrefix(keyType, structKeyTypePrefix) {
+		panic(fmt.Errorf("key type %q cannot start with reserved prefix %q", keyType, structKeyTypePrefix))
+	}
+
+	keyTypeToKeyConverter[keyType] = converter
+}
+
+func isValidKeyType(keyType string) bool {
+	if _, exists := keyTypeToKeyConverter[keyType]; exists {
+		return true
+	}
+
+	_, err := newStructKeyConverter(keyType)
+	return err == nil
+}
+
+func registeredKeyTypes() []string {
+	keyTypes := make([]string, 0, len(keyTypeToKeyConverter))
+	for keyType := range keyTypeToKeyConverter {
+		keyTypes = append(keyTypes, keyType)
+	}
+
+	sort.Strings(keyTypes)
+	return keyTypes
 }
 
 type Uint64KeyConverter struct{}
@@ -114,6 +167,10 @@ func (c *HexBEKeyConverter) ToString(key uint64) (string, error) {
 }
 
 func getKeyConverterForType(keyType string) KeyConverter {
+	if structKeyConverter, err := newStructKeyConverter(keyType); err == nil {
+		return structKeyConverter
+	}
+
 	keyConverter, exists := keyTypeToKeyConverter[keyType]
 	if !exists {
 		// Name is always the default key converter whatever happen
@@ -122,3 +179,83 @@ func getKeyConverterForType(keyType string) KeyConverter {
 
 	return keyConverter
 }
+
+// Checksum256KeyConverter handles keys derived from a `checksum256`, written as its 64
+// characters hexadecimal form. Contracts build such keys from the first 8 bytes of the
+// checksum, read as a little endian integer, so the checksum can't be recovered from
+// the key, which is rendered in decimal. A decimal key is also accepted as input.
+type Checksum256KeyConverter struct{}
+
+func (c *Checksum256KeyConverter) FromString(key string) (uint64, error) {
+	if len(key) != 64 {
+		return strconv.ParseUint(key, 10, 64)
+	}
+
+	checksum, err := hex.DecodeString(key)
+	if err != nil {
+		return 0, fmt.Errorf("invalid checksum256 %q: %w", key, err)
+	}
+
+	return binary.LittleEndian.Uint64(checksum), nil
+}
+
+func (c *Checksum256KeyConverter) ToString(key uint64) (string, error) {
+	return strconv.FormatUint(key, 10), nil
+}
+
+// StructKeyConverter renders keys from fields of their row, `key_type=struct:account,symbol`
+// rendering the key of a row `{"account":"eosio.token","symbol":"4,EOS",...}` as
+// `eosio.token:4,EOS`. Keys can't be computed back from the fields, so as input, keys
+// are expected in decimal form, which is also how they are rendered when the row is
+// not decoded, for deletions for example.
+type StructKeyConverter struct {
+	fields []string
+}
+
+func newStructKeyConverter(keyType string) (*StructKeyConverter, error) {
+	if !strings.HasPrefix(keyType, structKeyTypePrefix) {
+		return nil, fmt.Errorf("key type %q is not a struct expression", keyType)
+	}
+
+	fields := strings.Split(strings.TrimPrefix(keyType, structKeyTypePrefix), ",")
+	for _, field := range fields {
+		if field == "" {
+			return nil, fmt.Errorf("key type %q has an empty field", keyType)
+		}
+	}
+
+	return &StructKeyConverter{fields: fields}, nil
+}
+
+func (c *StructKeyConverter) FromString(key string) (uint64, error) {
+	return strconv.ParseUint(key, 10, 64)
+}
+
+func (c *StructKeyConverter) ToString(key uint64) (string, error) {
+	return strconv.FormatUint(key, 10), nil
+}
+
+func (c *StructKeyConverter) RowToString(key uint64, rowJSON []byte) (string, error) {
+	var row map[string]json.RawMessage
+	if err := json.Unmarshal(rowJSON, &row); err != nil {
+		return "", fmt.Errorf("unable to decode row: %w", err)
+	}
+
+	values := make([]string, len(c.fields))
+	for i, field := range c.fields {
+		value, found := row[field]
+		if !found {
+			return "", fmt.Errorf("field %q not found in row struct", field)
+		}
+
+		// Strings are used as is, other values (numbers, objects, ...) in their JSON form
+		var stringValue string
+		if err := json.Unmarshal(value, &stringValue); err == nil {
+			values[i] = stringValue
+		} else {
+			values[i] = string(value)
+		}
+	}
+
+	return strings.Join(values, ":"), nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksum256KeyConverter(t *testing.T) {
+	converter := getKeyConverterForType("checksum256")
+
+	key, err := converter.FromString("0102030405060708aabbccddeeff00112233445566778899aabbccddeeff0011")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x0807060504030201), key)
+
+	key, err = converter.FromString("42")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), key)
+
+	_, err = converter.FromString("zz02030405060708aabbccddeeff00112233445566778899aabbccddeeff0011")
+	assert.Error(t, err)
+}
+
+func TestStructKeyConverter(t *testing.T) {
+	converter, ok := getKeyConverterForType("struct:account,balance,id").(RowKeyConverter)
+	require.True(t, ok)
+
+	rendered, err := converter.RowToString(5, []byte(`{"id":5,"account":"eosio.token","balance":"1.0000 EOS"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "eosio.token:1.0000 EOS:5", rendered)
+
+	_, err = converter.RowToString(5, []byte(`{"id":5,"account":"eosio.token"}`))
+	assert.EqualError(t, err, `field "balance" not found in row struct`)
+
+	rendered, err = converter.ToString(5)
+	require.NoError(t, err)
+	assert.Equal(t, "5", rendered)
+}
+
+func TestGetKeyConverterForType(t *testing.T) {
+	assert.IsType(t, &NameKeyConverter{}, getKeyConverterForType(""))
+	assert.IsType(t, &NameKeyConverter{}, getKeyConverterForType("unknown"))
+	assert.IsType(t, &NameKeyConverter{}, getKeyConverterForType("struct:"))
+	assert.IsType(t, &Uint64KeyConverter{}, getKeyConverterForType("i64"))
+	assert.IsType(t, &StructKeyConverter{}, getKeyConverterForType("struct:account"))
+
+	assert.Panics(t, func() { RegisterKeyConverter("name", &NameKeyConverter{}) })
+	assert.Panics(t, func() { RegisterKeyConverter("struct:id", &NameKeyConverter{}) })
+}
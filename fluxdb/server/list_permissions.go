@@ -0,0 +1,179 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) listPermissionsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlog := logging.Logger(ctx, zlog)
+
+	errors := validateListPermissionsRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractListPermissionsRequest(r)
+	zlog.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, lastWrittenBlockID, upToBlockID, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, false)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	permissions, err := srv.db.ReadAccountPermissions(ctx, actualBlockNum, request.Account, speculativeWrites)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "reading account permissions failed"))
+		return
+	}
+
+	response := &listPermissionsResponse{
+		commonStateResponse: newCommonGetResponse(upToBlockID, lastWrittenBlockID),
+		Account:             request.Account,
+		Permissions:         make([]*permissionResponse, len(permissions)),
+	}
+
+	for i, permission := range permissions {
+		response.Permissions[i] = newPermissionResponse(permission)
+	}
+
+	writeResponse(ctx, w, response)
+}
+
+type listPermissionsRequest struct {
+	BlockNum uint32          `json:"block_num"`
+	Account  eos.AccountName `json:"account"`
+}
+
+type listPermissionsResponse struct {
+	*commonStateResponse
+
+	Account     eos.AccountName       `json:"account"`
+	Permissions []*permissionResponse `json:"permissions"`
+}
+
+// permissionResponse follows the format of the permissions returned by nodeos
+// `get_account`, `block_num` being the block at which the permission last changed.
+type permissionResponse struct {
+	PermName     string             `json:"perm_name"`
+	LastUpdated  *time.Time         `json:"last_updated,omitempty"`
+	BlockNum     uint32             `json:"block_num"`
+	RequiredAuth *authorityResponse `json:"required_auth"`
+}
+
+type authorityResponse struct {
+	Threshold uint32                           `json:"threshold"`
+	Keys      []*keyWeightResponse             `json:"keys"`
+	Accounts  []*permissionLevelWeightResponse `json:"accounts"`
+	Waits     []*waitWeightResponse            `json:"waits"`
+}
+
+type keyWeightResponse struct {
+	Key    string `json:"key"`
+	Weight uint32 `json:"weight"`
+}
+
+type permissionLevelWeightResponse struct {
+	Permission *permissionLevelResponse `json:"permission"`
+	Weight     uint32                   `json:"weight"`
+}
+
+type permissionLevelResponse struct {
+	Actor      string `json:"actor"`
+	Permission string `json:"permission"`
+}
+
+type waitWeightResponse struct {
+	WaitSec uint32 `json:"wait_sec"`
+	Weight  uint32 `json:"weight"`
+}
+
+func newPermissionResponse(permission *fluxdb.AccountPermission) *permissionResponse {
+	out := &permissionResponse{
+		PermName:     permission.Permission.Name,
+		BlockNum:     permission.BlockNum,
+		RequiredAuth: newAuthorityResponse(permission.Permission.Authority),
+	}
+
+	if lastUpdated, err := ptypes.Timestamp(permission.Permission.LastUpdated); err == nil {
+		out.LastUpdated = &lastUpdated
+	}
+
+	return out
+}
+
+func newAuthorityResponse(authority *pbcodec.Authority) *authorityResponse {
+	out := &authorityResponse{
+		Keys:     []*keyWeightResponse{},
+		Accounts: []*permissionLevelWeightResponse{},
+		Waits:    []*waitWeightResponse{},
+	}
+
+	if authority == nil {
+		return out
+	}
+
+	out.Threshold = authority.Threshold
+	for _, key := range authority.Keys {
+		out.Keys = append(out.Keys, &keyWeightResponse{Key: key.PublicKey, Weight: key.Weight})
+	}
+
+	for _, account := range authority.Accounts {
+		level := &permissionLevelResponse{}
+		if account.Permission != nil {
+			level.Actor, level.Permission = account.Permission.Actor, account.Permission.Permission
+		}
+
+		out.Accounts = append(out.Accounts, &permissionLevelWeightResponse{Permission: level, Weight: account.Weight})
+	}
+
+	for _, wait := range authority.Waits {
+		out.Waits = append(out.Waits, &waitWeightResponse{WaitSec: wait.WaitSec, Weight: wait.Weight})
+	}
+
+	return out
+}
+
+func validateListPermissionsRequest(r *http.Request) url.Values {
+	return validator.ValidateQueryParams(r, validator.Rules{
+		"block_num": []string{"fluxdb.eos.blockNum"},
+		"account":   []string{"required", "fluxdb.eos.name"},
+	})
+}
+
+func extractListPermissionsRequest(r *http.Request) *listPermissionsRequest {
+	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
+
+	return &listPermissionsRequest{
+		BlockNum: uint32(blockNum64),
+		Account:  eos.AccountName(r.FormValue("account")),
+	}
+}
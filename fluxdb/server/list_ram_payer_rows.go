@@ -0,0 +1,168 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) listRAMPayerRowsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlog := logging.Logger(ctx, zlog)
+
+	errors := validateListRAMPayerRowsRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractListRAMPayerRowsRequest(r)
+	zlog.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, lastWrittenBlockID, upToBlockID, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, request.IrreversibleOnly)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	startBlockNum, err := srv.db.FetchRAMPayerStartBlockNum(ctx)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "unable to retrieve ram payer start block num"))
+		return
+	}
+
+	// Rows written before the mappings started are missing from them until backfilled
+	if startBlockNum == 0 || actualBlockNum < startBlockNum {
+		writeError(ctx, w, fluxdb.AppRAMPayerRowsUnavailableError(ctx, actualBlockNum, startBlockNum))
+		return
+	}
+
+	rows, nextCursor, err := srv.db.ReadRAMPayerRows(ctx, actualBlockNum, fluxdb.N(string(request.Payer)), request.Cursor, request.Limit, speculativeWrites)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "reading ram payer rows failed"))
+		return
+	}
+
+	response := &listRAMPayerRowsResponse{
+		commonStateResponse: newCommonGetResponse(upToBlockID, lastWrittenBlockID),
+		Payer:               request.Payer,
+		Tables:              []*ramPayerTable{},
+		NextCursor:          nextCursor,
+	}
+
+	keyConverter := getKeyConverterForType(request.KeyType)
+
+	// Rows are sorted by account, table and scope, so rows of a table are contiguous
+	var current *ramPayerTable
+	for _, row := range rows {
+		if current == nil || current.account != row.Account || current.table != row.Table || current.scope != row.Scope {
+			current = &ramPayerTable{
+				account: row.Account,
+				table:   row.Table,
+				scope:   row.Scope,
+
+				Account: fluxdb.NameToString(row.Account),
+				Table:   fluxdb.NameToString(row.Table),
+				Scope:   fluxdb.NameToString(row.Scope),
+			}
+			response.Tables = append(response.Tables, current)
+		}
+
+		primaryKey, err := keyConverter.ToString(row.PrimKey)
+		if err != nil {
+			writeError(ctx, w, derr.Wrapf(err, "unable to convert key %d to string", row.PrimKey))
+			return
+		}
+
+		current.PrimaryKeys = append(current.PrimaryKeys, primaryKey)
+		current.RowCount++
+		current.PayloadBytes += uint64(row.ByteCount)
+		response.RowCount++
+		response.PayloadBytes += uint64(row.ByteCount)
+	}
+
+	zlog.Debug("writing response", zap.Int("table_count", len(response.Tables)), zap.Uint64("row_count", response.RowCount))
+	writeResponse(ctx, w, response)
+}
+
+type listRAMPayerRowsRequest struct {
+	BlockNum         uint32          `json:"block_num"`
+	IrreversibleOnly bool            `json:"irreversible_only"`
+	Payer            eos.AccountName `json:"payer"`
+	KeyType          string          `json:"key_type"`
+	Cursor           string          `json:"cursor"`
+	Limit            int             `json:"limit"`
+}
+
+// listRAMPayerRowsResponse holds a page of the payer's rows, the counts covering
+// only the rows of the page. When `next_cursor` is set, more rows follow, they are
+// listed by passing it as the `cursor` of the next request.
+type listRAMPayerRowsResponse struct {
+	*commonStateResponse
+
+	Payer        eos.AccountName  `json:"payer"`
+	RowCount     uint64           `json:"row_count"`
+	PayloadBytes uint64           `json:"payload_bytes"`
+	Tables       []*ramPayerTable `json:"tables"`
+	NextCursor   string           `json:"next_cursor,omitempty"`
+}
+
+// ramPayerTable groups the rows of a contract table paid by the payer, the bytes
+// being the size of the rows payload (payer and data), like in table stats.
+type ramPayerTable struct {
+	account, table, scope uint64
+
+	Account      string   `json:"account"`
+	Table        string   `json:"table"`
+	Scope        string   `json:"scope"`
+	RowCount     uint64   `json:"row_count"`
+	PayloadBytes uint64   `json:"payload_bytes"`
+	PrimaryKeys  []string `json:"primary_keys"`
+}
+
+func validateListRAMPayerRowsRequest(r *http.Request) url.Values {
+	return validator.ValidateQueryParams(r, validator.Rules{
+		"block_num":         []string{"fluxdb.eos.blockNum"},
+		"payer":             []string{"required", "fluxdb.eos.name"},
+		"key_type":          []string{"fluxdb.eos.keyType"},
+		"irreversible_only": []string{"bool"},
+		"cursor":            []string{"fluxdb.eos.ramPayerCursor"},
+		"limit":             []string{"numeric"},
+	})
+}
+
+func extractListRAMPayerRowsRequest(r *http.Request) *listRAMPayerRowsRequest {
+	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
+	irreversibleOnly, _ := strconv.ParseBool(r.FormValue("irreversible_only"))
+	limit, _ := intInput(r.FormValue("limit"), 0)
+
+	return &listRAMPayerRowsRequest{
+		BlockNum:         uint32(blockNum64),
+		IrreversibleOnly: irreversibleOnly,
+		Payer:            eos.AccountName(r.FormValue("payer")),
+		KeyType:          r.FormValue("key_type"),
+		Cursor:           r.FormValue("cursor"),
+		Limit:            limit,
+	}
+}
@@ -15,11 +15,15 @@
 package server
 
 import (
+	"encoding/binary"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
 	"github.com/dfuse-io/logging"
 	"github.com/dfuse-io/validator"
 	"go.uber.org/zap"
@@ -30,6 +34,10 @@ func (srv *EOSServer) listTableRowsHandler(w http.ResponseWriter, r *http.Reques
 	zlog := logging.Logger(ctx, zlog)
 
 	errors := validateGetTableRequest(r)
+	if len(errors) == 0 && !srv.secondaryIndexReads && extractGetTableRequest(r).isSecondaryIndexRead() {
+		errors["index_position"] = []string{"Reading through secondary indexes is not enabled on this server"}
+	}
+
 	if len(errors) > 0 {
 		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
 		return
@@ -51,6 +59,7 @@ func (srv *EOSServer) listTableRowsHandler(w http.ResponseWriter, r *http.Reques
 		request.Table,
 		request.Scope,
 		request.readRequestCommon,
+		request.indexQuery(),
 		getKeyConverterForType(request.KeyType),
 		speculativeWrites,
 	)
@@ -76,6 +85,99 @@ type listTableRowsRequest struct {
 	Account          string `json:"account"`
 	Table            string `json:"table"`
 	Scope            string `json:"scope"`
+
+	IndexPosition string `json:"index_position"`
+	IndexKeyType  string `json:"index_key_type"`
+	LowerBound    string `json:"lower_bound"`
+	UpperBound    string `json:"upper_bound"`
+	Reverse       bool   `json:"reverse"`
+}
+
+// tableIndexQuery selects the index through which a table is read, the
+// inclusive bounds (in their sortable form) restricting the rows returned as
+// well as the order in which they are returned.
+type tableIndexQuery struct {
+	IndexPosition          uint32
+	LowerBound, UpperBound []byte
+	Reverse                bool
+}
+
+func (r *listTableRowsRequest) indexQuery() *tableIndexQuery {
+	query := &tableIndexQuery{
+		IndexPosition: indexPositionFromString(r.IndexPosition),
+		Reverse:       r.Reverse,
+	}
+
+	// Bounds were checked at validation time, errors cannot happen here
+	if r.LowerBound != "" {
+		query.LowerBound, _ = r.parseBound(r.LowerBound)
+	}
+
+	if r.UpperBound != "" {
+		query.UpperBound, _ = r.parseBound(r.UpperBound)
+	}
+
+	return query
+}
+
+func (r *listTableRowsRequest) isSecondaryIndexRead() bool {
+	return indexPositionFromString(r.IndexPosition) >= 2
+}
+
+// parseBound turns a `lower_bound` or `upper_bound` value into its sortable
+// form. On the primary index, the bound is a primary key interpreted through
+// `key_type` while on secondary indexes, `index_key_type` is used.
+func (r *listTableRowsRequest) parseBound(bound string) ([]byte, error) {
+	if r.isSecondaryIndexRead() {
+		_, key, err := fluxdb.SecondaryKeyFromString(r.indexKeyType(), bound)
+		return key, err
+	}
+
+	primaryKey, err := getKeyConverterForType(r.KeyType).FromString(bound)
+	if err != nil {
+		return nil, err
+	}
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, primaryKey)
+
+	return key, nil
+}
+
+func (r *listTableRowsRequest) boundKeyType() string {
+	if r.isSecondaryIndexRead() {
+		return r.indexKeyType()
+	}
+
+	if !isValidKeyType(r.KeyType) {
+		return "name"
+	}
+
+	return r.KeyType
+}
+
+func (r *listTableRowsRequest) indexKeyType() string {
+	if r.IndexKeyType == "" {
+		return "i64"
+	}
+
+	return r.IndexKeyType
+}
+
+var indexPositionNames = []string{"primary", "secondary", "tertiary", "fourth", "fifth", "sixth", "seventh", "eighth", "ninth", "tenth"}
+
+// indexPositionFromString follows nodeos `index_position` semantics, both
+// `primary` and `1` refer to the primary index while `secondary` and `2`
+// refer to the first secondary index.
+func indexPositionFromString(in string) uint32 {
+	for i, name := range indexPositionNames {
+		if in == name {
+			return uint32(i + 1)
+		}
+	}
+
+	position, _ := strconv.ParseUint(in, 10, 32)
+	return uint32(position)
 }
 
 func validateGetTableRequest(r *http.Request) url.Values {
@@ -84,6 +186,9 @@ func validateGetTableRequest(r *http.Request) url.Values {
 		"table":             []string{"required", "fluxdb.eos.name"},
 		"scope":             []string{"fluxdb.eos.extendedName"},
 		"irreversible_only": []string{"bool"},
+		"index_position":    []string{"in:" + strings.Join(indexPositionNames, ",") + ",1,2,3,4,5,6,7,8,9,10"},
+		"index_key_type":    []string{"in:i64,uint64,name,hex,i128,i256,float64"},
+		"reverse":           []string{"bool"},
 	}))
 
 	// Let's ensure the scope param is at least present (but can be the empty string)
@@ -91,6 +196,21 @@ func validateGetTableRequest(r *http.Request) url.Values {
 		errors["scope"] = []string{"The scope field is required"}
 	}
 
+	if len(errors["index_position"]) > 0 || len(errors["index_key_type"]) > 0 || len(errors["key_type"]) > 0 {
+		return errors
+	}
+
+	request := extractGetTableRequest(r)
+	for field, bound := range map[string]string{"lower_bound": request.LowerBound, "upper_bound": request.UpperBound} {
+		if bound == "" {
+			continue
+		}
+
+		if _, err := request.parseBound(bound); err != nil {
+			errors[field] = []string{fmt.Sprintf("The %s field must be a valid %s key", field, request.boundKeyType())}
+		}
+	}
+
 	return errors
 }
 
@@ -103,5 +223,11 @@ func extractGetTableRequest(r *http.Request) *listTableRowsRequest {
 		Account:          r.FormValue("account"),
 		Scope:            r.FormValue("scope"),
 		IrreversibleOnly: irreversibleOnly,
+
+		IndexPosition: r.FormValue("index_position"),
+		IndexKeyType:  r.FormValue("index_key_type"),
+		LowerBound:    r.FormValue("lower_bound"),
+		UpperBound:    r.FormValue("upper_bound"),
+		Reverse:       boolInput(r.FormValue("reverse")),
 	}
 }
@@ -66,6 +66,7 @@ func (srv *EOSServer) listTablesRowsForAccountsHandler(w http.ResponseWriter, r
 				request.Table,
 				request.Scope,
 				request.readRequestCommon,
+				nil,
 				keyConverter,
 				speculativeWrites,
 			)
@@ -65,6 +65,7 @@ func (srv *EOSServer) listTablesRowsForScopesHandler(w http.ResponseWriter, r *h
 				request.Table,
 				scope,
 				request.readRequestCommon,
+				nil,
 				keyConverter,
 				speculativeWrites,
 			)
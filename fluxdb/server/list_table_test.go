@@ -0,0 +1,54 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTableRowsHandler_SecondaryIndexReads(t *testing.T) {
+	tests := []struct {
+		name         string
+		enabled      bool
+		query        string
+		expectedCode int
+	}{
+		{"primary index, disabled", false, "index_position=primary", http.StatusOK},
+		{"secondary index, disabled", false, "index_position=secondary", http.StatusBadRequest},
+		{"secondary index by number, disabled", false, "index_position=3", http.StatusBadRequest},
+		{"secondary index, enabled", true, "index_position=secondary", http.StatusOK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestBatchServer(t)
+			if test.enabled {
+				srv.EnableSecondaryIndexReads()
+			}
+
+			response := httptest.NewRecorder()
+			srv.listTableRowsHandler(response, httptest.NewRequest("GET", "/v0/state/table?account=eosio.token&table=accounts&scope=alice&"+test.query, nil))
+
+			assert.Equal(t, test.expectedCode, response.Code, response.Body.String())
+			if test.expectedCode == http.StatusBadRequest {
+				assert.Contains(t, response.Body.String(), "Reading through secondary indexes is not enabled on this server")
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/abourget/llerrgroup"
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) listTablesRowsBatchHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlog := logging.Logger(ctx, zlog)
+
+	request := &listTablesRowsBatchRequest{}
+	err := extractListTablesRowsBatchRequest(r, request)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "extracting request"))
+		return
+	}
+
+	zlog.Debug("extracted request", zap.Reflect("request", request))
+
+	// All tables are read at the same block with the same speculative writes, so they are all
+	// consistent with each other and share a single `up_to_block_id`.
+	actualBlockNum, lastWrittenBlockID, upToBlockID, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, request.IrreversibleOnly)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	tableCount := len(request.Tables)
+	tableResponses := make([]*getTableResponse, tableCount)
+	keyConverter := getKeyConverterForType(request.KeyType)
+	group := llerrgroup.New(parallelReadRequestCount)
+
+	zlog.Debug("starting read table operations group", zap.Int("table_count", tableCount))
+	for i, table := range request.Tables {
+		if group.Stop() {
+			zlog.Debug("read table operations group completed")
+			break
+		}
+
+		i, table := i, table
+		group.Go(func() error {
+			response, err := srv.readBatchTable(ctx, actualBlockNum, table, &request.readRequestCommon, keyConverter, speculativeWrites)
+			if err != nil {
+				return err
+			}
+
+			zlog.Debug("adding table read rows to response", zap.Int("index", i), zap.Int("row_count", len(response.Rows)))
+			tableResponses[i] = &getTableResponse{
+				Account:           table.Account,
+				Scope:             table.Scope,
+				Table:             table.Table,
+				readTableResponse: response,
+			}
+
+			return nil
+		})
+	}
+
+	zlog.Info("waiting for all read requests to finish")
+	if err := group.Wait(); err != nil {
+		writeError(ctx, w, derr.Wrap(err, "waiting for all read request to complete"))
+		return
+	}
+
+	// Tables are returned in the same order as they were requested
+	response := &getMultiTableRowsResponse{
+		commonStateResponse: newCommonGetResponse(upToBlockID, lastWrittenBlockID),
+		Tables:              tableResponses,
+	}
+
+	zlog.Debug("streaming response", zap.Int("table_count", len(response.Tables)), zap.Reflect("common_response", response.commonStateResponse))
+	streamResponse(ctx, w, response)
+}
+
+// readBatchTable reads the whole table when no primary keys are requested, otherwise
+// only the requested rows are read, rows deleted or that never existed being skipped.
+func (srv *EOSServer) readBatchTable(
+	ctx context.Context,
+	blockNum uint32,
+	table *batchTableRequest,
+	request *readRequestCommon,
+	keyConverter KeyConverter,
+	speculativeWrites []*fluxdb.WriteRequest,
+) (*readTableResponse, error) {
+	if len(table.PrimaryKeys) == 0 {
+		return srv.readTable(ctx, blockNum, table.Account, table.Table, table.Scope, request, nil, keyConverter, speculativeWrites)
+	}
+
+	return srv.readTableRows(ctx, blockNum, table.Account, table.Table, table.Scope, table.PrimaryKeys, request, keyConverter, speculativeWrites)
+}
+
+type listTablesRowsBatchRequest struct {
+	readRequestCommon
+
+	IrreversibleOnly bool                 `json:"irreversible_only"`
+	Tables           []*batchTableRequest `json:"tables"`
+}
+
+type batchTableRequest struct {
+	Account     string   `json:"account"`
+	Scope       string   `json:"scope"`
+	Table       string   `json:"table"`
+	PrimaryKeys []string `json:"primary_keys"`
+}
+
+func validateListTablesRowsBatchRequest(r *http.Request, request *listTablesRowsBatchRequest) url.Values {
+	errors := validator.ValidateJSONBody(r, request, validator.Rules{
+		"tables":   []string{"required"},
+		"key_type": []string{"fluxdb.eos.keyType"},
+	})
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	if len(request.Tables) > maxBatchTableCount {
+		errors["tables"] = []string{fmt.Sprintf("The tables field must have at most %d elements", maxBatchTableCount)}
+		return errors
+	}
+
+	keyConverter := getKeyConverterForType(request.KeyType)
+	for i, table := range request.Tables {
+		field := fmt.Sprintf("tables[%d]", i)
+		if table == nil {
+			errors[field] = []string{fmt.Sprintf("The %s field is required", field)}
+			continue
+		}
+
+		if err := validator.EOSNameRule(field+".account", "fluxdb.eos.name", "", table.Account); err != nil {
+			errors[field+".account"] = []string{err.Error()}
+		}
+
+		if err := validator.EOSNameRule(field+".table", "fluxdb.eos.name", "", table.Table); err != nil {
+			errors[field+".table"] = []string{err.Error()}
+		}
+
+		// The scope can be the empty string
+		if table.Scope != "" {
+			if err := validator.EOSExtendedNameRule(field+".scope", "fluxdb.eos.extendedName", "", table.Scope); err != nil {
+				errors[field+".scope"] = []string{err.Error()}
+			}
+		}
+
+		for j, primaryKey := range table.PrimaryKeys {
+			if _, err := keyConverter.FromString(primaryKey); err != nil {
+				keyField := fmt.Sprintf("%s.primary_keys[%d]", field, j)
+				errors[keyField] = []string{fmt.Sprintf("The %s field must be a valid key for the requested key_type", keyField)}
+			}
+		}
+	}
+
+	return errors
+}
+
+func extractListTablesRowsBatchRequest(r *http.Request, request *listTablesRowsBatchRequest) error {
+	ctx := r.Context()
+	if r.Body == nil {
+		return derr.MissingBodyError(ctx)
+	}
+
+	requestErrors := validateListTablesRowsBatchRequest(r, request)
+	if len(requestErrors) > 0 {
+		if _, ok := requestErrors["_error"]; ok {
+			return derr.InvalidJSONError(ctx, errors.New(requestErrors["_error"][0]))
+		}
+
+		return derr.RequestValidationError(ctx, requestErrors)
+	}
+
+	return nil
+}
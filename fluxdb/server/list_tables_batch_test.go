@@ -0,0 +1,145 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	eos "github.com/eoscanada/eos-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTablesRowsBatchHandler(t *testing.T) {
+	srv := newTestBatchServer(t)
+
+	tests := []struct {
+		name             string
+		irreversibleOnly bool
+		expectedBlockNum uint32
+		expected         map[string][]string
+	}{
+		{
+			name:             "head block",
+			expectedBlockNum: 3,
+			expected: map[string][]string{
+				"alice": {"3=13", "1=11"},
+				"bob":   {"1=21", "2=22"},
+			},
+		},
+		{
+			name:             "irreversible only",
+			irreversibleOnly: true,
+			expected: map[string][]string{
+				"alice": {"3=03", "1=01"},
+				"bob":   {"1=21"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body := fmt.Sprintf(`{
+				"key_type": "uint64",
+				"irreversible_only": %t,
+				"tables": [
+					{"account": "eosio.token", "scope": "alice", "table": "accounts", "primary_keys": ["3", "4", "1"]},
+					{"account": "eosio.token", "scope": "bob", "table": "accounts"}
+				]
+			}`, test.irreversibleOnly)
+
+			response := httptest.NewRecorder()
+			srv.listTablesRowsBatchHandler(response, httptest.NewRequest("POST", "/v0/state/tables/batch", strings.NewReader(body)))
+			require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+
+			var actual struct {
+				UpToBlockID string `json:"up_to_block_id"`
+				Tables      []struct {
+					Scope string `json:"scope"`
+					Rows  []struct {
+						Key string `json:"key"`
+						Hex string `json:"hex"`
+					} `json:"rows"`
+				} `json:"tables"`
+			}
+			require.NoError(t, json.Unmarshal(response.Body.Bytes(), &actual))
+
+			if test.expectedBlockNum != 0 {
+				assert.Equal(t, test.expectedBlockNum, fluxdb.BlockNum(actual.UpToBlockID))
+			} else {
+				assert.Empty(t, actual.UpToBlockID)
+			}
+
+			require.Len(t, actual.Tables, 2)
+			for _, table := range actual.Tables {
+				var rows []string
+				for _, row := range table.Rows {
+					rows = append(rows, row.Key+"="+row.Hex)
+				}
+
+				assert.Equal(t, test.expected[table.Scope], rows, "table scope %s", table.Scope)
+			}
+		})
+	}
+}
+
+func newTestBatchServer(t *testing.T) *EOSServer {
+	ctx := context.Background()
+
+	kvStore, err := fluxdb.NewKVStore("memory://")
+	require.NoError(t, err)
+
+	db := fluxdb.New(kvStore)
+	account := fluxdb.N("eosio.token")
+
+	abi, err := eos.MarshalBinary(&eos.ABI{
+		Version: "eosio::abi/1.1",
+		Structs: []eos.StructDef{{Name: "account", Fields: []eos.FieldDef{{Name: "balance", Type: "uint8"}}}},
+		Tables:  []eos.TableDef{{Name: "accounts", IndexType: "i64", Type: "account"}},
+	})
+	require.NoError(t, err)
+
+	row := func(scope string, primaryKey uint64, data byte) *fluxdb.TableDataRow {
+		return &fluxdb.TableDataRow{Account: account, Scope: fluxdb.N(scope), Table: fluxdb.N("accounts"), PrimKey: primaryKey, Payer: fluxdb.N(scope), Data: []byte{data}}
+	}
+
+	block := func(blockNum uint32, rows ...*fluxdb.TableDataRow) *fluxdb.WriteRequest {
+		blockID, _ := hex.DecodeString(fmt.Sprintf("%08xaa", blockNum))
+		return &fluxdb.WriteRequest{BlockNum: blockNum, BlockID: blockID, TableDatas: rows}
+	}
+
+	irreversible := block(2, row("alice", 1, 0x01), row("alice", 3, 0x03), row("bob", 1, 0x21))
+	irreversible.ABIs = []*fluxdb.ABIRow{{Account: account, BlockNum: 2, PackedABI: abi}}
+	require.NoError(t, db.WriteBatch(ctx, []*fluxdb.WriteRequest{irreversible}))
+
+	speculative := block(3, row("alice", 1, 0x11), row("alice", 3, 0x13), row("bob", 2, 0x22))
+	db.HeadBlock = func(ctx context.Context) bstream.BlockRef {
+		return bstream.NewBlockRef(hex.EncodeToString(speculative.BlockID), 3)
+	}
+	db.SpeculativeWritesFetcher = func(ctx context.Context, headBlockID string, upToBlockNum uint32) []*fluxdb.WriteRequest {
+		return fluxdb.SpeculativeWritesUpTo([]*fluxdb.WriteRequest{speculative}, upToBlockNum)
+	}
+
+	return New("", db)
+}
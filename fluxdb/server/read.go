@@ -52,6 +52,8 @@ func (srv *EOSServer) prepareRead(
 		if chosenBlockNum == 0 {
 			chosenBlockNum = lastWrittenBlockNum
 		}
+
+		err = srv.checkBlockNumRetained(ctx, chosenBlockNum)
 		return
 	}
 
@@ -67,6 +69,10 @@ func (srv *EOSServer) prepareRead(
 		return
 	}
 
+	if err = srv.checkBlockNumRetained(ctx, chosenBlockNum); err != nil {
+		return
+	}
+
 	// If we're between lastWrittenBlockNum and headBlockNum, we need to apply whatever's between
 	zlog.Debug("fetching speculative writes", zap.String("head_block_id", headBlock.ID()), zap.Uint32("chosen_block_num", chosenBlockNum))
 	speculativeWrites = srv.db.SpeculativeWritesFetcher(ctx, headBlock.ID(), chosenBlockNum)
@@ -82,6 +88,20 @@ func (srv *EOSServer) prepareRead(
 	return
 }
 
+// checkBlockNumRetained rejects reads at a block num whose history has been pruned.
+func (srv *EOSServer) checkBlockNumRetained(ctx context.Context, blockNum uint32) error {
+	prunedBlockNum, err := srv.db.FetchPrunedBlockNum(ctx)
+	if err != nil {
+		return derr.Wrap(err, "unable to retrieve pruned block num")
+	}
+
+	if blockNum < prunedBlockNum {
+		return fluxdb.AppBlockNumPrunedError(ctx, blockNum, prunedBlockNum)
+	}
+
+	return nil
+}
+
 func (srv *EOSServer) fetchHeadBlock(ctx context.Context, zlog *zap.Logger) (headBlock bstream.BlockRef) {
 	headBlock = srv.db.HeadBlock(ctx)
 	zlog.Debug("retrieved head block id", zap.String("head_block_id", headBlock.ID()), zap.Uint64("head_block_num", headBlock.Num()))
@@ -96,6 +116,7 @@ func (srv *EOSServer) readTable(
 	table string,
 	scope string,
 	request *readRequestCommon,
+	indexQuery *tableIndexQuery,
 	keyConverter KeyConverter,
 	speculativeWrites []*fluxdb.WriteRequest,
 ) (*readTableResponse, error) {
@@ -105,13 +126,32 @@ func (srv *EOSServer) readTable(
 	zlog := logging.Logger(ctx, zlog)
 	zlog.Debug("reading rows", zap.String("account", account), zap.String("table", table), zap.String("scope", scope))
 
-	resp, err := srv.db.ReadTable(ctx, &fluxdb.ReadTableRequest{
+	readRequest := &fluxdb.ReadTableRequest{
 		Account:           fluxdb.N(account),
 		Scope:             fluxdb.EN(scope),
 		Table:             fluxdb.N(table),
 		BlockNum:          blockNum,
 		SpeculativeWrites: speculativeWrites,
-	})
+	}
+
+	if request.Offset > 0 {
+		offset := uint32(request.Offset)
+		readRequest.Offset = &offset
+	}
+
+	if request.Limit > 0 {
+		limit := uint32(request.Limit)
+		readRequest.Limit = &limit
+	}
+
+	if indexQuery != nil {
+		readRequest.IndexPosition = indexQuery.IndexPosition
+		readRequest.LowerBound = indexQuery.LowerBound
+		readRequest.UpperBound = indexQuery.UpperBound
+		readRequest.Reverse = indexQuery.Reverse
+	}
+
+	resp, err := srv.db.ReadTable(ctx, readRequest)
 
 	if err != nil {
 		return nil, derr.Wrap(err, "unable to retrieve rows from database")
@@ -119,20 +159,88 @@ func (srv *EOSServer) readTable(
 
 	zlog.Debug("read rows results", zap.Int("row_count", len(resp.Rows)))
 
-	var abiObj *eos.ABI
-	if err := eos.UnmarshalBinary(resp.ABI.PackedABI, &abiObj); err != nil {
-		return nil, derr.Wrapf(err, "unable to decode packed ABI %q to JSON", resp.ABI.PackedABI)
+	out, err := srv.toReadTableResponse(ctx, table, resp, request, keyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	span.Annotate([]trace.Attribute{
+		trace.Int64Attribute("rows", int64(len(out.Rows))),
+	}, "read operation")
+
+	return out, nil
+}
+
+// readTableRows reads only the rows of the given primary keys, rows deleted or
+// that never existed are skipped. The database is queried once for all keys.
+func (srv *EOSServer) readTableRows(
+	ctx context.Context,
+	blockNum uint32,
+	account string,
+	table string,
+	scope string,
+	primaryKeys []string,
+	request *readRequestCommon,
+	keyConverter KeyConverter,
+	speculativeWrites []*fluxdb.WriteRequest,
+) (*readTableResponse, error) {
+	ctx, span := dtracing.StartSpan(ctx, "read table rows")
+	defer span.End()
+
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("reading table rows", zap.String("account", account), zap.String("table", table), zap.String("scope", scope), zap.Int("key_count", len(primaryKeys)))
+
+	primaryKeyValues := make([]uint64, len(primaryKeys))
+	for i, primaryKey := range primaryKeys {
+		value, err := keyConverter.FromString(primaryKey)
+		if err != nil {
+			return nil, derr.Wrapf(err, "unable to convert key %q to uint64", primaryKey)
+		}
+
+		primaryKeyValues[i] = value
+	}
+
+	resp, err := srv.db.ReadTableRows(ctx, &fluxdb.ReadTableRowsRequest{
+		ReadTableRequest: fluxdb.ReadTableRequest{
+			Account:           fluxdb.N(account),
+			Scope:             fluxdb.EN(scope),
+			Table:             fluxdb.N(table),
+			BlockNum:          blockNum,
+			SpeculativeWrites: speculativeWrites,
+		},
+
+		PrimaryKeys: primaryKeyValues,
+	})
+
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to retrieve rows from database")
+	}
+
+	return srv.toReadTableResponse(ctx, table, resp, request, keyConverter)
+}
+
+func (srv *EOSServer) toReadTableResponse(
+	ctx context.Context,
+	table string,
+	resp *fluxdb.ReadTableResponse,
+	request *readRequestCommon,
+	keyConverter KeyConverter,
+) (*readTableResponse, error) {
+	zlog := logging.Logger(ctx, zlog)
+
+	abiDecoder, err := srv.abiDecoder(resp.ABI)
+	if err != nil {
+		return nil, err
 	}
 
 	out := &readTableResponse{}
 	if request.WithABI {
-		out.ABI = abiObj
+		out.ABI = abiDecoder.abi
 	}
 
-	tableName := eos.TableName(table)
-	tableDef := abiObj.TableForName(tableName)
-	if tableDef == nil {
-		return nil, fluxdb.DataTableNotFoundError(ctx, eos.AccountName(account), tableName)
+	decoder, err := abiDecoder.mustTableDecoder(ctx, eos.TableName(table))
+	if err != nil {
+		return nil, err
 	}
 
 	zlog.Debug("post-processing each row (maybe convert to JSON)")
@@ -140,10 +248,10 @@ func (srv *EOSServer) readTable(
 		var data interface{}
 		if request.ToJSON {
 			data = &onTheFlyABISerializer{
-				abi:        abiObj,
-				abiRow:     resp.ABI,
-				structType: tableDef.Type,
-				data:       row.Data,
+				decoder:     decoder,
+				rowBlockNum: row.BlockNum,
+				primaryKey:  row.Key,
+				data:        row.Data,
 			}
 		} else {
 			data = row.Data
@@ -154,7 +262,7 @@ func (srv *EOSServer) readTable(
 			blockNum = row.BlockNum
 		}
 
-		rowKey, err := keyConverter.ToString(row.Key)
+		rowKey, err := rowKeyToString(keyConverter, decoder, row)
 		if err != nil {
 			return nil, fmt.Errorf("unable to convert key: %s", err)
 		}
@@ -167,10 +275,6 @@ func (srv *EOSServer) readTable(
 		})
 	}
 
-	span.Annotate([]trace.Attribute{
-		trace.Int64Attribute("rows", int64(len(out.Rows))),
-	}, "read operation")
-
 	return out, nil
 }
 
@@ -212,20 +316,19 @@ func (srv *EOSServer) readTableRow(
 		return nil, derr.Wrap(err, "unable to retrieve single row from database")
 	}
 
-	var abiObj *eos.ABI
-	if err := eos.UnmarshalBinary(resp.ABI.PackedABI, &abiObj); err != nil {
-		return nil, derr.Wrapf(err, "unable to decode packed ABI %q to JSON", resp.ABI.PackedABI)
+	abiDecoder, err := srv.abiDecoder(resp.ABI)
+	if err != nil {
+		return nil, err
 	}
 
 	out := &readTableRowResponse{}
 	if request.WithABI {
-		out.ABI = abiObj
+		out.ABI = abiDecoder.abi
 	}
 
-	tableName := eos.TableName(table)
-	tableDef := abiObj.TableForName(tableName)
-	if tableDef == nil {
-		return nil, fluxdb.DataTableNotFoundError(ctx, eos.AccountName(account), tableName)
+	decoder, err := abiDecoder.mustTableDecoder(ctx, eos.TableName(table))
+	if err != nil {
+		return nil, err
 	}
 
 	if resp.Row == nil {
@@ -233,7 +336,7 @@ func (srv *EOSServer) readTableRow(
 		return out, nil
 	}
 
-	rowKey, err := keyConverter.ToString(resp.Row.Key)
+	rowKey, err := rowKeyToString(keyConverter, decoder, resp.Row)
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert key: %s", err)
 	}
@@ -247,10 +350,10 @@ func (srv *EOSServer) readTableRow(
 
 	if request.ToJSON {
 		out.Row.Data = &onTheFlyABISerializer{
-			abi:        abiObj,
-			abiRow:     resp.ABI,
-			structType: tableDef.Type,
-			data:       resp.Row.Data,
+			decoder:     decoder,
+			rowBlockNum: resp.Row.BlockNum,
+			primaryKey:  resp.Row.Key,
+			data:        resp.Row.Data,
 		}
 	}
 
@@ -261,6 +364,292 @@ func (srv *EOSServer) readTableRow(
 	return out, nil
 }
 
+func (srv *EOSServer) readTableRowHistory(
+	ctx context.Context,
+	fromBlockNum uint32,
+	toBlockNum uint32,
+	account string,
+	table string,
+	scope string,
+	primaryKey string,
+	request *readRequestCommon,
+	keyConverter KeyConverter,
+	speculativeWrites []*fluxdb.WriteRequest,
+) ([]*tableRowMutation, error) {
+	ctx, span := dtracing.StartSpan(ctx, "read table row history")
+	defer span.End()
+
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("reading table row history",
+		zap.String("account", account),
+		zap.String("table", table),
+		zap.String("scope", scope),
+		zap.String("primary_key", primaryKey),
+		zap.Uint32("from_block_num", fromBlockNum),
+		zap.Uint32("to_block_num", toBlockNum),
+	)
+
+	primaryKeyValue, err := keyConverter.FromString(primaryKey)
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to convert key %q to uint64", primaryKey)
+	}
+
+	resp, err := srv.db.ReadTableRowHistory(ctx, &fluxdb.ReadTableRowHistoryRequest{
+		Account:           fluxdb.N(account),
+		Scope:             fluxdb.EN(scope),
+		Table:             fluxdb.N(table),
+		PrimaryKey:        primaryKeyValue,
+		FromBlockNum:      fromBlockNum,
+		ToBlockNum:        toBlockNum,
+		SpeculativeWrites: speculativeWrites,
+	})
+
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to retrieve row history from database")
+	}
+
+	rowKey, err := keyConverter.ToString(primaryKeyValue)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert key: %s", err)
+	}
+
+	// The ABI can change over the requested block range, each mutation is decoded with the
+	// ABI active at its block.
+	tableName := eos.TableName(table)
+
+	zlog.Debug("post-processing each mutation (maybe convert to JSON)", zap.Int("mutation_count", len(resp.Mutations)))
+	var out []*tableRowMutation
+	for _, mutation := range resp.Mutations {
+		row := &tableRow{Key: rowKey}
+		out = append(out, &tableRowMutation{
+			BlockNum: mutation.BlockNum,
+			Deleted:  mutation.Deletion,
+			Row:      row,
+		})
+
+		if mutation.Deletion {
+			continue
+		}
+
+		row.Payer = fluxdb.NameToString(mutation.Row.Payer)
+		row.Data = mutation.Row.Data
+
+		_, rowKeyFromData := keyConverter.(RowKeyConverter)
+		if !request.ToJSON && !rowKeyFromData {
+			continue
+		}
+
+		abiRow, err := srv.db.GetABI(ctx, mutation.BlockNum, fluxdb.N(account), fluxdb.SpeculativeWritesUpTo(speculativeWrites, mutation.BlockNum))
+		if err != nil {
+			return nil, derr.Wrapf(err, "unable to retrieve ABI at block %d", mutation.BlockNum)
+		}
+
+		abiDecoder, err := srv.abiDecoder(abiRow)
+		if err != nil {
+			return nil, err
+		}
+
+		decoder := abiDecoder.tableDecoder(tableName)
+		if decoder == nil {
+			zlog.Debug("table not present in ABI at mutation block, keeping binary data", zap.Uint32("block_num", mutation.BlockNum))
+			continue
+		}
+
+		if rowKeyFromData {
+			row.Key, err = rowKeyToString(keyConverter, decoder, &fluxdb.TableRow{Key: primaryKeyValue, Data: mutation.Row.Data, BlockNum: mutation.BlockNum})
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert key: %s", err)
+			}
+		}
+
+		if !request.ToJSON {
+			continue
+		}
+
+		row.Data = &onTheFlyABISerializer{
+			decoder:     decoder,
+			rowBlockNum: mutation.BlockNum,
+			primaryKey:  primaryKeyValue,
+			data:        mutation.Row.Data,
+		}
+	}
+
+	span.Annotate([]trace.Attribute{
+		trace.Int64Attribute("mutations", int64(len(out))),
+	}, "read operation")
+
+	return out, nil
+}
+
+func (srv *EOSServer) diffTable(
+	ctx context.Context,
+	fromBlockNum uint32,
+	toBlockNum uint32,
+	account string,
+	table string,
+	scope string,
+	request *readRequestCommon,
+	keyConverter KeyConverter,
+	speculativeWrites []*fluxdb.WriteRequest,
+) (*diffTableResponse, error) {
+	ctx, span := dtracing.StartSpan(ctx, "diff table")
+	defer span.End()
+
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("diffing table",
+		zap.String("account", account),
+		zap.String("table", table),
+		zap.String("scope", scope),
+		zap.Uint32("from_block_num", fromBlockNum),
+		zap.Uint32("to_block_num", toBlockNum),
+	)
+
+	resp, err := srv.db.DiffTable(ctx, &fluxdb.DiffTableRequest{
+		Account:           fluxdb.N(account),
+		Scope:             fluxdb.EN(scope),
+		Table:             fluxdb.N(table),
+		FromBlockNum:      fromBlockNum,
+		ToBlockNum:        toBlockNum,
+		SpeculativeWrites: speculativeWrites,
+	})
+
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to diff table from database")
+	}
+
+	tableName := eos.TableName(table)
+
+	// Removed rows and the old side of updated rows are decoded with the ABI active at
+	// `fromBlockNum` while the others use the ABI active at `toBlockNum`.
+	toRowConverter, err := srv.newTableRowConverter(ctx, resp.ToABI, tableName, request, keyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	fromRowConverter := toRowConverter
+	if resp.FromABI != nil && resp.FromABI.BlockNum != resp.ToABI.BlockNum {
+		fromRowConverter, err = srv.newTableRowConverter(ctx, resp.FromABI, tableName, request, keyConverter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out := &diffTableResponse{}
+	if request.WithABI {
+		out.ABI = toRowConverter.decoder.abiDecoder.abi
+	}
+
+	for _, row := range resp.Inserted {
+		converted, err := toRowConverter.convert(row)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Inserted = append(out.Inserted, converted)
+	}
+
+	for _, update := range resp.Updated {
+		oldRow, err := fromRowConverter.convert(update.Old)
+		if err != nil {
+			return nil, err
+		}
+
+		newRow, err := toRowConverter.convert(update.New)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Updated = append(out.Updated, &tableRowUpdate{Old: oldRow, New: newRow})
+	}
+
+	for _, row := range resp.Removed {
+		converted, err := fromRowConverter.convert(row)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Removed = append(out.Removed, converted)
+	}
+
+	span.Annotate([]trace.Attribute{
+		trace.Int64Attribute("inserted", int64(len(out.Inserted))),
+		trace.Int64Attribute("updated", int64(len(out.Updated))),
+		trace.Int64Attribute("removed", int64(len(out.Removed))),
+	}, "diff operation")
+
+	return out, nil
+}
+
+// tableRowConverter turns database table rows into their API representation
+// using a fixed ABI, decoding the row data to JSON when requested.
+type tableRowConverter struct {
+	decoder      *tableDecoder
+	request      *readRequestCommon
+	keyConverter KeyConverter
+}
+
+func (srv *EOSServer) newTableRowConverter(ctx context.Context, abiRow *fluxdb.ABIRow, tableName eos.TableName, request *readRequestCommon, keyConverter KeyConverter) (*tableRowConverter, error) {
+	abiDecoder, err := srv.abiDecoder(abiRow)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder, err := abiDecoder.mustTableDecoder(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tableRowConverter{
+		decoder:      decoder,
+		request:      request,
+		keyConverter: keyConverter,
+	}, nil
+}
+
+func (c *tableRowConverter) convert(row *fluxdb.TableRow) (*tableRow, error) {
+	rowKey, err := rowKeyToString(c.keyConverter, c.decoder, row)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert key: %s", err)
+	}
+
+	out := &tableRow{
+		Key:   rowKey,
+		Payer: fluxdb.NameToString(row.Payer),
+		Data:  row.Data,
+	}
+
+	if c.request.ToJSON {
+		out.Data = &onTheFlyABISerializer{
+			decoder:     c.decoder,
+			rowBlockNum: row.BlockNum,
+			primaryKey:  row.Key,
+			data:        row.Data,
+		}
+	}
+
+	if c.request.WithBlockNum {
+		out.BlockNum = row.BlockNum
+	}
+
+	return out, nil
+}
+
+// rowKeyToString renders the row's key, from the row's decoded data when the key
+// converter supports it and the row can be decoded, from the key alone otherwise.
+func rowKeyToString(keyConverter KeyConverter, decoder *tableDecoder, row *fluxdb.TableRow) (string, error) {
+	rowKeyConverter, ok := keyConverter.(RowKeyConverter)
+	if !ok || decoder == nil {
+		return keyConverter.ToString(row.Key)
+	}
+
+	rowJSON, err := decoder.decode(row.BlockNum, row.Key, row.Data)
+	if err != nil {
+		return keyConverter.ToString(row.Key)
+	}
+
+	return rowKeyConverter.RowToString(row.Key, rowJSON)
+}
+
 func (srv *EOSServer) listKeyAccounts(
 	ctx context.Context,
 	publicKey string,
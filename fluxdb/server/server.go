@@ -45,6 +45,11 @@ type EOSServer struct {
 	db         *fluxdb.FluxDB
 	addr       string
 	mux        *mux.Router
+
+	abiDecoders *lruCache
+	decodedRows *lruCache
+
+	secondaryIndexReads bool
 }
 
 func New(addr string, db *fluxdb.FluxDB) *EOSServer {
@@ -53,6 +58,9 @@ func New(addr string, db *fluxdb.FluxDB) *EOSServer {
 		addr: addr,
 		mux:  router,
 		db:   db,
+
+		abiDecoders: newLRUCache(defaultABIDecoderCacheSize),
+		decodedRows: newLRUCache(defaultDecodedRowCacheBytes),
 	}
 
 	metricsRouter := router.PathPrefix("/").Subrouter()
@@ -69,12 +77,21 @@ func New(addr string, db *fluxdb.FluxDB) *EOSServer {
 
 	coreRouter.Methods("GET").Path("/v0/state/abi").HandlerFunc(srv.getABIHandler)
 	coreRouter.Methods("POST").Path("/v0/state/abi/bin_to_json").HandlerFunc(srv.decodeABIHandler)
+	coreRouter.Methods("GET").Path("/v0/state/abi/history").HandlerFunc(srv.getABIHistoryHandler)
+	coreRouter.Methods("GET").Path("/v0/state/account_stats").HandlerFunc(srv.getAccountStatsHandler)
 	coreRouter.Methods("GET", "POST").Path("/v0/state/key_accounts").HandlerFunc(srv.listKeyAccountsHandler)
 	coreRouter.Methods("GET").Path("/v0/state/permission_links").HandlerFunc(srv.listLinkedPermissionsHandler)
+	coreRouter.Methods("GET").Path("/v0/state/permissions").HandlerFunc(srv.listPermissionsHandler)
+	coreRouter.Methods("GET").Path("/v0/state/ram_payer_rows").HandlerFunc(srv.listRAMPayerRowsHandler)
+	coreRouter.Methods("GET").Path("/v0/state/resources").HandlerFunc(srv.getResourcesHandler)
 	coreRouter.Methods("GET").Path("/v0/state/table").HandlerFunc(srv.listTableRowsHandler)
+	coreRouter.Methods("GET").Path("/v0/state/table/diff").HandlerFunc(srv.getTableDiffHandler)
 	coreRouter.Methods("GET").Path("/v0/state/table/row").HandlerFunc(srv.getTableRowHandler)
+	coreRouter.Methods("GET").Path("/v0/state/table/row/history").HandlerFunc(srv.getTableRowHistoryHandler)
+	coreRouter.Methods("GET").Path("/v0/state/table/stats").HandlerFunc(srv.getTableStatsHandler)
 	coreRouter.Methods("GET").Path("/v0/state/table_scopes").HandlerFunc(srv.listTableScopesHandler)
 	coreRouter.Methods("GET", "POST").Path("/v0/state/tables/accounts").HandlerFunc(srv.listTablesRowsForAccountsHandler)
+	coreRouter.Methods("POST").Path("/v0/state/tables/batch").HandlerFunc(srv.listTablesRowsBatchHandler)
 	coreRouter.Methods("GET", "POST").Path("/v0/state/tables/scopes").HandlerFunc(srv.listTablesRowsForScopesHandler)
 
 	db.OnTerminating(func(e error) {
@@ -90,6 +107,24 @@ func New(addr string, db *fluxdb.FluxDB) *EOSServer {
 	return srv
 }
 
+// SetDecodingCacheSizes replaces the ABI decoders and decoded rows caches, the first
+// one holding at most `abiDecoderCount` decoders and the second one at most
+// `decodedRowBytes` bytes of rows. A size of 0 disables the cache. It must be called
+// before serving.
+func (srv *EOSServer) SetDecodingCacheSizes(abiDecoderCount int, decodedRowBytes int) {
+	srv.abiDecoders = newLRUCache(abiDecoderCount)
+	srv.decodedRows = newLRUCache(decodedRowBytes)
+}
+
+// EnableSecondaryIndexReads lets `/v0/state/table` read tables through their secondary
+// indexes (`index_position` above 1), which are rejected otherwise. Secondary indexes are
+// only recorded from `SEC_IDX_OP` deep-mind lines, emitted by instrumented nodeos only, so
+// this must be enabled only when the blocks come from such a node. It must be called
+// before serving.
+func (srv *EOSServer) EnableSecondaryIndexReads() {
+	srv.secondaryIndexReads = true
+}
+
 func (srv *EOSServer) Handler() http.Handler {
 	return srv.mux
 }
@@ -0,0 +1,552 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbfluxdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/fluxdb/v1"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var streamSnapshotChunkSize = 500
+var streamSubscriptionBufferSize = 1000
+
+// StreamTableDeltas sends the rows of a table at the requested start block, followed by
+// the database operations of each subsequent block. Blocks already irreversible when
+// streamed are sent once with `STEP_IRREVERSIBLE`, the others are sent with `STEP_NEW`,
+// then either with `STEP_UNDO` (operations must be reverted) or with `STEP_IRREVERSIBLE`
+// without any operation. Blocks without any operation on the table are skipped.
+func (srv *GRPCServer) StreamTableDeltas(req *pbfluxdb.StreamTableDeltasRequest, stream pbfluxdb.State_StreamTableDeltasServer) error {
+	ctx := stream.Context()
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("streaming table deltas", zap.Reflect("request", req))
+
+	if err := validateStreamTableDeltasRequest(req); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Subscribing before anything is read ensures no block is missed between the snapshot and the live blocks
+	sub := srv.handler.SubscribeWrites(streamSubscriptionBufferSize)
+	defer sub.Close()
+
+	lastIrreversibleBlockNum, headBlockNum := sub.LastWrittenBlockNum, sub.LastWrittenBlockNum
+	for _, step := range sub.Pending {
+		if step.Step == forkable.StepIrreversible {
+			lastIrreversibleBlockNum = uint32(step.Block.Num())
+		}
+		headBlockNum = uint32(step.Block.Num())
+	}
+
+	maxBlockNum := headBlockNum
+	if req.IrreversibleOnly {
+		maxBlockNum = lastIrreversibleBlockNum
+	}
+
+	startBlockNum := req.StartBlock
+	if startBlockNum == 0 {
+		startBlockNum = maxBlockNum
+	}
+
+	if startBlockNum > maxBlockNum {
+		return status.Errorf(codes.InvalidArgument, "start block %d is higher than the last streamable block %d", startBlockNum, maxBlockNum)
+	}
+
+	prunedBlockNum, err := srv.db.FetchPrunedBlockNum(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to retrieve pruned block num: %s", err)
+	}
+
+	if startBlockNum < prunedBlockNum {
+		return status.Errorf(codes.OutOfRange, "start block %d is older than the retained history, it has been pruned before block %d", startBlockNum, prunedBlockNum)
+	}
+
+	var snapshotWrites []*fluxdb.WriteRequest
+	for _, step := range sub.Pending {
+		if uint32(step.Block.Num()) <= startBlockNum {
+			snapshotWrites = append(snapshotWrites, step.Write)
+		}
+	}
+
+	streamer := newTableDeltasStreamer(srv.db, stream, req, startBlockNum, snapshotWrites)
+	if err := streamer.sendSnapshot(ctx); err != nil {
+		return err
+	}
+
+	if startBlockNum < sub.LastWrittenBlockNum {
+		zlog.Debug("catching up with irreversible blocks", zap.Uint32("start_block_num", startBlockNum), zap.Uint32("last_written_block_num", sub.LastWrittenBlockNum))
+		err := srv.db.ScanTableWrites(ctx, &fluxdb.ScanTableWritesRequest{
+			Account:      streamer.account,
+			Scope:        streamer.scope,
+			Table:        streamer.table,
+			FromBlockNum: startBlockNum + 1,
+			ToBlockNum:   sub.LastWrittenBlockNum,
+		}, func(blockNum uint32, rows []*fluxdb.TableDataRow) error {
+			return streamer.sendIrreversible(ctx, bstream.NewBlockRef("", uint64(blockNum)), rows)
+		})
+
+		if err != nil {
+			return derr.Wrap(err, "unable to catch up with irreversible blocks")
+		}
+
+		streamer.irreversibleBlockNum = sub.LastWrittenBlockNum
+		streamer.baseBlockNum = sub.LastWrittenBlockNum
+	}
+
+	for _, step := range sub.Pending {
+		if err := streamer.handleStep(ctx, step); err != nil {
+			return err
+		}
+	}
+
+	zlog.Debug("streaming live blocks", zap.Uint32("head_block_num", headBlockNum))
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case step, ok := <-sub.Steps():
+			if !ok {
+				if sub.Err() != nil {
+					return status.Error(codes.ResourceExhausted, sub.Err().Error())
+				}
+
+				return nil
+			}
+
+			if err := streamer.handleStep(ctx, step); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func validateStreamTableDeltasRequest(req *pbfluxdb.StreamTableDeltasRequest) error {
+	if req.Account == "" {
+		return errors.New("the account field is required")
+	}
+
+	if req.Table == "" {
+		return errors.New("the table field is required")
+	}
+
+	if err := validator.EOSNameRule("account", "fluxdb.eos.name", "", req.Account); err != nil {
+		return err
+	}
+
+	if err := validator.EOSNameRule("table", "fluxdb.eos.name", "", req.Table); err != nil {
+		return err
+	}
+
+	return validator.EOSExtendedNameRule("scope", "fluxdb.eos.extendedName", "", req.Scope)
+}
+
+// tableDeltasStreamer streams the deltas of a single table without keeping the table
+// in memory. The previous value of a row, needed to build its database operation, comes
+// from the reversible blocks streamed so far when one of them touched it, or else is
+// read from the database at the base block, the last irreversible block (or the snapshot
+// block when higher).
+type tableDeltasStreamer struct {
+	db               *fluxdb.FluxDB
+	stream           pbfluxdb.State_StreamTableDeltasServer
+	irreversibleOnly bool
+
+	account, scope, table uint64
+
+	snapshotBlockNum     uint32
+	irreversibleBlockNum uint32
+
+	// The table writes of the blocks up to `baseBlockNum` that might not be in the
+	// database yet, they are dropped once the database has written their block
+	baseBlockNum uint32
+	baseWrites   []*fluxdb.WriteRequest
+
+	reversibleBlocks []*streamedBlock
+}
+
+// streamedBlock is a reversible block that affected the table, kept until it
+// becomes irreversible so it can be undone. Its database operations hold the
+// previous values of the rows it touched.
+type streamedBlock struct {
+	ref        bstream.BlockRef
+	inSnapshot bool
+	dbOps      []*pbcodec.DBOp
+}
+
+func newTableDeltasStreamer(db *fluxdb.FluxDB, stream pbfluxdb.State_StreamTableDeltasServer, req *pbfluxdb.StreamTableDeltasRequest, startBlockNum uint32, snapshotWrites []*fluxdb.WriteRequest) *tableDeltasStreamer {
+	streamer := &tableDeltasStreamer{
+		db:                   db,
+		stream:               stream,
+		irreversibleOnly:     req.IrreversibleOnly,
+		account:              fluxdb.N(req.Account),
+		scope:                fluxdb.EN(req.Scope),
+		table:                fluxdb.N(req.Table),
+		snapshotBlockNum:     startBlockNum,
+		irreversibleBlockNum: startBlockNum,
+		baseBlockNum:         startBlockNum,
+	}
+
+	for _, write := range snapshotWrites {
+		if rows := streamer.tableRows(write.TableDatas); len(rows) > 0 {
+			streamer.baseWrites = append(streamer.baseWrites, &fluxdb.WriteRequest{BlockNum: write.BlockNum, TableDatas: rows})
+		}
+	}
+
+	return streamer
+}
+
+// sendSnapshot sends the rows of the table at the snapshot block, reading them from
+// the database one chunk at a time.
+func (s *tableDeltasStreamer) sendSnapshot(ctx context.Context) error {
+	// One more row than a chunk is read to know if another chunk follows
+	limit := uint32(streamSnapshotChunkSize + 1)
+
+	var lowerBound []byte
+	for {
+		resp, err := s.db.ReadTable(ctx, &fluxdb.ReadTableRequest{
+			Account:           s.account,
+			Scope:             s.scope,
+			Table:             s.table,
+			BlockNum:          s.snapshotBlockNum,
+			Limit:             &limit,
+			LowerBound:        lowerBound,
+			SpeculativeWrites: s.baseWrites,
+		})
+		if err != nil {
+			return derr.Wrap(err, "unable to read table snapshot")
+		}
+
+		chunk := resp.Rows
+		complete := len(chunk) <= streamSnapshotChunkSize
+		if !complete {
+			lowerBound = make([]byte, 8)
+			binary.BigEndian.PutUint64(lowerBound, chunk[streamSnapshotChunkSize].Key)
+			chunk = chunk[:streamSnapshotChunkSize]
+		}
+
+		rows := make([]*pbfluxdb.TableRow, len(chunk))
+		for i, row := range chunk {
+			rows[i] = &pbfluxdb.TableRow{
+				PrimaryKey: fluxdb.NameToString(row.Key),
+				Payer:      fluxdb.NameToString(row.Payer),
+				Data:       row.Data,
+			}
+		}
+
+		if err := s.sendSnapshotChunk(rows, complete); err != nil {
+			return err
+		}
+
+		if complete {
+			return nil
+		}
+	}
+}
+
+func (s *tableDeltasStreamer) sendSnapshotChunk(rows []*pbfluxdb.TableRow, complete bool) error {
+	return s.stream.Send(&pbfluxdb.TableDeltasResponse{
+		Step:             pbfluxdb.Step_STEP_SNAPSHOT,
+		BlockNum:         s.snapshotBlockNum,
+		SnapshotRows:     rows,
+		SnapshotComplete: complete,
+	})
+}
+
+func (s *tableDeltasStreamer) handleStep(ctx context.Context, step *fluxdb.WriteStep) error {
+	blockNum := uint32(step.Block.Num())
+
+	switch step.Step {
+	case forkable.StepNew:
+		if s.irreversibleOnly || !s.touchesTable(step.Write.TableDatas) {
+			return nil
+		}
+
+		if blockNum <= s.snapshotBlockNum {
+			s.reversibleBlocks = append(s.reversibleBlocks, &streamedBlock{ref: step.Block, inSnapshot: true})
+			return nil
+		}
+
+		dbOps, err := s.apply(ctx, step.Write.TableDatas)
+		if err != nil {
+			return err
+		}
+
+		s.reversibleBlocks = append(s.reversibleBlocks, &streamedBlock{ref: step.Block, dbOps: dbOps})
+		return s.send(pbfluxdb.Step_STEP_NEW, step.Block, dbOps)
+
+	case forkable.StepUndo:
+		if s.irreversibleOnly {
+			return nil
+		}
+
+		block := s.popReversibleBlock(step.Block)
+		if block == nil {
+			return nil
+		}
+
+		if block.inSnapshot {
+			return status.Errorf(codes.Aborted, "block %s included in the snapshot has been undone, the stream must be restarted", step.Block)
+		}
+
+		return s.send(pbfluxdb.Step_STEP_UNDO, step.Block, block.dbOps)
+
+	case forkable.StepIrreversible:
+		block := s.popReversibleBlock(step.Block)
+		if blockNum <= s.irreversibleBlockNum {
+			return nil
+		}
+		s.irreversibleBlockNum = blockNum
+
+		if block != nil {
+			if block.inSnapshot {
+				return nil
+			}
+
+			if err := s.advanceBase(ctx, blockNum, step.Write.TableDatas); err != nil {
+				return err
+			}
+
+			return s.send(pbfluxdb.Step_STEP_IRREVERSIBLE, step.Block, nil)
+		}
+
+		return s.sendIrreversible(ctx, step.Block, step.Write.TableDatas)
+	}
+
+	return nil
+}
+
+func (s *tableDeltasStreamer) sendIrreversible(ctx context.Context, ref bstream.BlockRef, rows []*fluxdb.TableDataRow) error {
+	dbOps, err := s.apply(ctx, rows)
+	if err != nil {
+		return err
+	}
+
+	if err := s.advanceBase(ctx, uint32(ref.Num()), rows); err != nil {
+		return err
+	}
+
+	if len(dbOps) == 0 {
+		return nil
+	}
+
+	return s.send(pbfluxdb.Step_STEP_IRREVERSIBLE, ref, dbOps)
+}
+
+// advanceBase moves the base block to the irreversible `blockNum`, keeping the table
+// rows it wrote until the database has written them.
+func (s *tableDeltasStreamer) advanceBase(ctx context.Context, blockNum uint32, rows []*fluxdb.TableDataRow) error {
+	if blockNum <= s.baseBlockNum {
+		return nil
+	}
+	s.baseBlockNum = blockNum
+
+	if tableRows := s.tableRows(rows); len(tableRows) > 0 {
+		s.baseWrites = append(s.baseWrites, &fluxdb.WriteRequest{BlockNum: blockNum, TableDatas: tableRows})
+	}
+
+	if len(s.baseWrites) == 0 {
+		return nil
+	}
+
+	lastWrittenBlock, err := s.db.FetchLastWrittenBlock(ctx)
+	if err != nil {
+		return derr.Wrap(err, "unable to retrieve last written block")
+	}
+
+	written := 0
+	for written < len(s.baseWrites) && uint64(s.baseWrites[written].BlockNum) <= lastWrittenBlock.Num() {
+		written++
+	}
+	s.baseWrites = s.baseWrites[written:]
+
+	return nil
+}
+
+func (s *tableDeltasStreamer) send(step pbfluxdb.Step, ref bstream.BlockRef, dbOps []*pbcodec.DBOp) error {
+	return s.stream.Send(&pbfluxdb.TableDeltasResponse{
+		Step:     step,
+		BlockNum: uint32(ref.Num()),
+		BlockId:  ref.ID(),
+		DbOps:    dbOps,
+	})
+}
+
+func (s *tableDeltasStreamer) popReversibleBlock(ref bstream.BlockRef) *streamedBlock {
+	for i, block := range s.reversibleBlocks {
+		if block.ref.ID() == ref.ID() {
+			s.reversibleBlocks = append(s.reversibleBlocks[:i], s.reversibleBlocks[i+1:]...)
+			return block
+		}
+	}
+
+	return nil
+}
+
+func (s *tableDeltasStreamer) isStreamedTable(row *fluxdb.TableDataRow) bool {
+	return row.Account == s.account && row.Scope == s.scope && row.Table == s.table
+}
+
+func (s *tableDeltasStreamer) touchesTable(rows []*fluxdb.TableDataRow) bool {
+	for _, row := range rows {
+		if s.isStreamedTable(row) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *tableDeltasStreamer) tableRows(rows []*fluxdb.TableDataRow) (out []*fluxdb.TableDataRow) {
+	for _, row := range rows {
+		if s.isStreamedTable(row) {
+			out = append(out, row)
+		}
+	}
+
+	return
+}
+
+// apply returns the database operations seen by the client for the rows written by
+// a block, each one holding the previous value of its row.
+func (s *tableDeltasStreamer) apply(ctx context.Context, rows []*fluxdb.TableDataRow) (dbOps []*pbcodec.DBOp, err error) {
+	rows = s.tableRows(rows)
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	previousRows, err := s.previousRows(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		previous := previousRows[row.PrimKey]
+		if row.Deletion && previous == nil {
+			continue
+		}
+
+		dbOp := &pbcodec.DBOp{
+			Code:       fluxdb.NameToString(s.account),
+			Scope:      fluxdb.NameToString(s.scope),
+			TableName:  fluxdb.NameToString(s.table),
+			PrimaryKey: fluxdb.NameToString(row.PrimKey),
+		}
+
+		if previous != nil {
+			dbOp.OldPayer = fluxdb.NameToString(previous.Payer)
+			dbOp.OldData = previous.Data
+		}
+
+		switch {
+		case row.Deletion:
+			dbOp.Operation = pbcodec.DBOp_OPERATION_REMOVE
+			delete(previousRows, row.PrimKey)
+		default:
+			dbOp.Operation = pbcodec.DBOp_OPERATION_UPDATE
+			if previous == nil {
+				dbOp.Operation = pbcodec.DBOp_OPERATION_INSERT
+			}
+
+			dbOp.NewPayer = fluxdb.NameToString(row.Payer)
+			dbOp.NewData = row.Data
+			previousRows[row.PrimKey] = &fluxdb.TableRow{Key: row.PrimKey, Payer: row.Payer, Data: row.Data}
+		}
+
+		dbOps = append(dbOps, dbOp)
+	}
+
+	return dbOps, nil
+}
+
+// previousRows returns the current value, as seen by the client, of the rows about to
+// be written, rows absent from the returned map not existing.
+func (s *tableDeltasStreamer) previousRows(ctx context.Context, rows []*fluxdb.TableDataRow) (map[uint64]*fluxdb.TableRow, error) {
+	out := make(map[uint64]*fluxdb.TableRow, len(rows))
+
+	var unresolved []uint64
+	seen := make(map[uint64]bool, len(rows))
+	for _, row := range rows {
+		if seen[row.PrimKey] {
+			continue
+		}
+		seen[row.PrimKey] = true
+
+		if reversibleRow, found := s.reversibleRow(row.PrimKey); found {
+			if reversibleRow != nil {
+				out[row.PrimKey] = reversibleRow
+			}
+			continue
+		}
+
+		unresolved = append(unresolved, row.PrimKey)
+	}
+
+	if len(unresolved) == 0 {
+		return out, nil
+	}
+
+	resp, err := s.db.ReadTableRows(ctx, &fluxdb.ReadTableRowsRequest{
+		ReadTableRequest: fluxdb.ReadTableRequest{
+			Account:           s.account,
+			Scope:             s.scope,
+			Table:             s.table,
+			BlockNum:          s.baseBlockNum,
+			SpeculativeWrites: s.baseWrites,
+		},
+		PrimaryKeys: unresolved,
+	})
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to read previous rows")
+	}
+
+	for _, row := range resp.Rows {
+		out[row.Key] = row
+	}
+
+	return out, nil
+}
+
+// reversibleRow returns the value of the row written by the most recent reversible block
+// that touched it, `found` being false when none of them did. A nil row means it was removed.
+func (s *tableDeltasStreamer) reversibleRow(primaryKey uint64) (row *fluxdb.TableRow, found bool) {
+	name := fluxdb.NameToString(primaryKey)
+	for i := len(s.reversibleBlocks) - 1; i >= 0; i-- {
+		dbOps := s.reversibleBlocks[i].dbOps
+		for j := len(dbOps) - 1; j >= 0; j-- {
+			dbOp := dbOps[j]
+			if dbOp.PrimaryKey != name {
+				continue
+			}
+
+			if dbOp.Operation == pbcodec.DBOp_OPERATION_REMOVE {
+				return nil, true
+			}
+
+			return &fluxdb.TableRow{Key: primaryKey, Payer: fluxdb.N(dbOp.NewPayer), Data: dbOp.NewData}, true
+		}
+	}
+
+	return nil, false
+}
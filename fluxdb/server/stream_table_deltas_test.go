@@ -0,0 +1,197 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbfluxdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/fluxdb/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTableDeltasStreamer(t *testing.T) {
+	db := newTestStreamDB(t, testTableData("a", "p", 0x01))
+	stream := &testTableDeltasStream{}
+	streamer := newTableDeltasStreamer(db, stream, &pbfluxdb.StreamTableDeltasRequest{Account: "eosio", Scope: "s", Table: "t"}, 10, nil)
+
+	steps := []*fluxdb.WriteStep{
+		testWriteStep(forkable.StepNew, "0000000ba", testTableData("b", "p", 0x02)),
+		testWriteStep(forkable.StepNew, "0000000ca", testTableData("a", "p", 0x03)),
+		testWriteStep(forkable.StepNew, "0000000da", &fluxdb.TableDataRow{Account: fluxdb.N("other"), Scope: fluxdb.N("s"), Table: fluxdb.N("t"), PrimKey: fluxdb.N("a")}),
+		testWriteStep(forkable.StepUndo, "0000000da"),
+		testWriteStep(forkable.StepUndo, "0000000ca"),
+		testWriteStep(forkable.StepNew, "0000000cb", testTableDeletion("a")),
+		testWriteStep(forkable.StepIrreversible, "0000000ba", testTableData("b", "p", 0x02)),
+		testWriteStep(forkable.StepIrreversible, "0000000cb", testTableDeletion("a")),
+	}
+
+	for _, step := range steps {
+		require.NoError(t, streamer.handleStep(context.Background(), step))
+	}
+
+	require.Len(t, stream.responses, 6)
+	assertTableDeltas(t, stream.responses[0], pbfluxdb.Step_STEP_NEW, 11, pbcodec.DBOp_OPERATION_INSERT)
+	assertTableDeltas(t, stream.responses[1], pbfluxdb.Step_STEP_NEW, 12, pbcodec.DBOp_OPERATION_UPDATE)
+	assertTableDeltas(t, stream.responses[2], pbfluxdb.Step_STEP_UNDO, 12, pbcodec.DBOp_OPERATION_UPDATE)
+	assertTableDeltas(t, stream.responses[3], pbfluxdb.Step_STEP_NEW, 12, pbcodec.DBOp_OPERATION_REMOVE)
+	assertTableDeltas(t, stream.responses[4], pbfluxdb.Step_STEP_IRREVERSIBLE, 11)
+	assertTableDeltas(t, stream.responses[5], pbfluxdb.Step_STEP_IRREVERSIBLE, 12)
+
+	assert.Equal(t, []byte{0x01}, stream.responses[2].DbOps[0].OldData)
+	assert.Equal(t, []byte{0x01}, stream.responses[3].DbOps[0].OldData, "update must have been forgotten on undo")
+	assert.Empty(t, streamer.reversibleBlocks)
+
+	// Irreversible blocks are not written to the database yet, so their rows are kept
+	assert.Equal(t, uint32(12), streamer.baseBlockNum)
+	require.Len(t, streamer.baseWrites, 2)
+
+	require.NoError(t, streamer.handleStep(context.Background(), testWriteStep(forkable.StepNew, "0000000da", testTableData("b", "p", 0x05))))
+	require.Len(t, stream.responses, 7)
+	assertTableDeltas(t, stream.responses[6], pbfluxdb.Step_STEP_NEW, 13, pbcodec.DBOp_OPERATION_UPDATE)
+	assert.Equal(t, []byte{0x02}, stream.responses[6].DbOps[0].OldData)
+}
+
+func TestTableDeltasStreamer_Snapshot(t *testing.T) {
+	defer func(chunkSize int) { streamSnapshotChunkSize = chunkSize }(streamSnapshotChunkSize)
+	streamSnapshotChunkSize = 2
+
+	db := newTestStreamDB(t, testTableData("a", "p", 0x01), testTableData("b", "p", 0x02), testTableData("c", "p", 0x03))
+	stream := &testTableDeltasStream{}
+	streamer := newTableDeltasStreamer(db, stream, &pbfluxdb.StreamTableDeltasRequest{Account: "eosio", Scope: "s", Table: "t"}, 11, []*fluxdb.WriteRequest{
+		{BlockNum: 11, TableDatas: []*fluxdb.TableDataRow{testTableDeletion("b"), testTableData("d", "p", 0x04), testTableData("e", "p", 0x05)}},
+	})
+
+	require.NoError(t, streamer.sendSnapshot(context.Background()))
+
+	require.Len(t, stream.responses, 2)
+	assert.False(t, stream.responses[0].SnapshotComplete)
+	assert.True(t, stream.responses[1].SnapshotComplete)
+
+	var primaryKeys []string
+	for _, response := range stream.responses {
+		assert.Equal(t, pbfluxdb.Step_STEP_SNAPSHOT, response.Step)
+		assert.Equal(t, uint32(11), response.BlockNum)
+		for _, row := range response.SnapshotRows {
+			primaryKeys = append(primaryKeys, row.PrimaryKey)
+		}
+	}
+
+	assert.Equal(t, []string{"a", "c", "d", "e"}, primaryKeys)
+}
+
+func TestTableDeltasStreamer_IrreversibleOnly(t *testing.T) {
+	stream := &testTableDeltasStream{}
+	streamer := newTableDeltasStreamer(newTestStreamDB(t), stream, &pbfluxdb.StreamTableDeltasRequest{Account: "eosio", Scope: "s", Table: "t", IrreversibleOnly: true}, 10, nil)
+
+	steps := []*fluxdb.WriteStep{
+		testWriteStep(forkable.StepIrreversible, "0000000aa", testTableData("a", "p", 0x01)),
+		testWriteStep(forkable.StepIrreversible, "0000000ba", testTableData("a", "p", 0x02)),
+		testWriteStep(forkable.StepNew, "0000000ca", testTableData("a", "p", 0x03)),
+		testWriteStep(forkable.StepUndo, "0000000ca"),
+		testWriteStep(forkable.StepIrreversible, "0000000cb", testTableData("b", "p", 0x04)),
+		testWriteStep(forkable.StepIrreversible, "0000000db", &fluxdb.TableDataRow{Account: fluxdb.N("other"), Scope: fluxdb.N("s"), Table: fluxdb.N("t"), PrimKey: fluxdb.N("a")}),
+	}
+
+	for _, step := range steps {
+		require.NoError(t, streamer.handleStep(context.Background(), step))
+	}
+
+	require.Len(t, stream.responses, 2)
+	assertTableDeltas(t, stream.responses[0], pbfluxdb.Step_STEP_IRREVERSIBLE, 11, pbcodec.DBOp_OPERATION_INSERT)
+	assertTableDeltas(t, stream.responses[1], pbfluxdb.Step_STEP_IRREVERSIBLE, 12, pbcodec.DBOp_OPERATION_INSERT)
+}
+
+func TestTableDeltasStreamer_UndoSnapshotBlock(t *testing.T) {
+	stream := &testTableDeltasStream{}
+	streamer := newTableDeltasStreamer(newTestStreamDB(t), stream, &pbfluxdb.StreamTableDeltasRequest{Account: "eosio", Scope: "s", Table: "t"}, 10, nil)
+
+	ctx := context.Background()
+	require.NoError(t, streamer.handleStep(ctx, testWriteStep(forkable.StepNew, "0000000aa", testTableData("a", "p", 0x01))))
+	require.NoError(t, streamer.handleStep(ctx, testWriteStep(forkable.StepNew, "0000000ba", testTableData("a", "p", 0x02))))
+	require.NoError(t, streamer.handleStep(ctx, testWriteStep(forkable.StepUndo, "0000000ba")))
+
+	err := streamer.handleStep(ctx, testWriteStep(forkable.StepUndo, "0000000aa"))
+	require.Error(t, err)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
+
+// newTestStreamDB returns a database holding the ABI of `eosio` and the given rows, all
+// written irreversibly at block 10.
+func newTestStreamDB(t *testing.T, rows ...*fluxdb.TableDataRow) *fluxdb.FluxDB {
+	kvStore, err := fluxdb.NewKVStore("memory://")
+	require.NoError(t, err)
+
+	abi, err := eos.MarshalBinary(&eos.ABI{Version: "eosio::abi/1.1"})
+	require.NoError(t, err)
+
+	db := fluxdb.New(kvStore)
+	require.NoError(t, db.WriteBatch(context.Background(), []*fluxdb.WriteRequest{{
+		BlockNum:   10,
+		BlockID:    []byte{0x00, 0x00, 0x00, 0x0a, 0xaa},
+		ABIs:       []*fluxdb.ABIRow{{Account: fluxdb.N("eosio"), BlockNum: 10, PackedABI: abi}},
+		TableDatas: rows,
+	}}))
+
+	return db
+}
+
+type testTableDeltasStream struct {
+	grpc.ServerStream
+	responses []*pbfluxdb.TableDeltasResponse
+}
+
+func (s *testTableDeltasStream) Send(response *pbfluxdb.TableDeltasResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func assertTableDeltas(t *testing.T, response *pbfluxdb.TableDeltasResponse, step pbfluxdb.Step, blockNum uint32, operations ...pbcodec.DBOp_Operation) {
+	t.Helper()
+
+	assert.Equal(t, step, response.Step)
+	assert.Equal(t, blockNum, response.BlockNum)
+	require.Len(t, response.DbOps, len(operations))
+	for i, operation := range operations {
+		assert.Equal(t, operation, response.DbOps[i].Operation)
+	}
+}
+
+func testWriteStep(step forkable.StepType, blockID string, rows ...*fluxdb.TableDataRow) *fluxdb.WriteStep {
+	ref := bstream.BlockRefFromID(blockID)
+
+	return &fluxdb.WriteStep{
+		Step:  step,
+		Block: ref,
+		Write: &fluxdb.WriteRequest{BlockNum: uint32(ref.Num()), TableDatas: rows},
+	}
+}
+
+func testTableData(primaryKey, payer string, data ...byte) *fluxdb.TableDataRow {
+	return &fluxdb.TableDataRow{Account: fluxdb.N("eosio"), Scope: fluxdb.N("s"), Table: fluxdb.N("t"), PrimKey: fluxdb.N(primaryKey), Payer: fluxdb.N(payer), Data: data}
+}
+
+func testTableDeletion(primaryKey string) *fluxdb.TableDataRow {
+	return &fluxdb.TableDataRow{Account: fluxdb.N("eosio"), Scope: fluxdb.N("s"), Table: fluxdb.N("t"), PrimKey: fluxdb.N(primaryKey), Deletion: true}
+}
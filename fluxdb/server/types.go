@@ -66,16 +66,24 @@ type readTableRowResponse struct {
 	Row *tableRow `json:"row"`
 }
 
+// tableRowMutation is one change of a table row, `Row` only holds the key
+// when the mutation is a deletion.
+type tableRowMutation struct {
+	BlockNum uint32
+	Deleted  bool
+	Row      *tableRow
+}
+
 type readTableResponse struct {
 	ABI  *eos.ABI    `json:"abi"`
 	Rows []*tableRow `json:"rows"`
 }
 
 type onTheFlyABISerializer struct {
-	abi        *eos.ABI
-	abiRow     *fluxdb.ABIRow
-	structType string
-	data       []byte
+	decoder     *tableDecoder
+	rowBlockNum uint32
+	primaryKey  uint64
+	data        []byte
 }
 
 type getTableRowsResponse struct {
@@ -92,5 +100,6 @@ type getMultiTableRowsResponse struct {
 type getTableResponse struct {
 	Account string `json:"account"`
 	Scope   string `json:"scope"`
+	Table   string `json:"table,omitempty"`
 	*readTableResponse
 }
@@ -16,7 +16,9 @@ package server
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
 	"github.com/dfuse-io/validator"
 	"github.com/eoscanada/eos-go/ecc"
 	"github.com/thedevsaddam/govalidator"
@@ -25,14 +27,17 @@ import (
 
 const maxAccountCount = 1500
 const maxScopeCount = 1500
+const maxBatchTableCount = 500
 
 func init() {
 	govalidator.AddCustomRule("fluxdb.eos.accountsList", validator.EOSNamesListRuleFactory("|", maxAccountCount))
 	govalidator.AddCustomRule("fluxdb.eos.blockNum", validator.EOSBlockNumRule)
 	govalidator.AddCustomRule("fluxdb.eos.hexRows", validator.HexRowsRule)
+	govalidator.AddCustomRule("fluxdb.eos.keyType", keyTypeRule)
 	govalidator.AddCustomRule("fluxdb.eos.name", validator.EOSNameRule)
 	govalidator.AddCustomRule("fluxdb.eos.extendedName", validator.EOSExtendedNameRule)
 	govalidator.AddCustomRule("fluxdb.eos.publicKey", eosPublicKeyRule)
+	govalidator.AddCustomRule("fluxdb.eos.ramPayerCursor", ramPayerCursorRule)
 	govalidator.AddCustomRule("fluxdb.eos.scopesList", validator.EOSExtendedNamesListRuleFactory("|", maxScopeCount))
 }
 
@@ -54,6 +59,32 @@ func eosPublicKeyRule(field string, rule string, message string, value interface
 	}
 }
 
+func ramPayerCursorRule(field string, rule string, message string, value interface{}) error {
+	cursor, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("The %s field must be a string", field)
+	}
+
+	if cursor == "" || fluxdb.IsValidRAMPayerCursor(cursor) {
+		return nil
+	}
+
+	return fmt.Errorf("The %s field must be a cursor returned by a previous request", field)
+}
+
+func keyTypeRule(field string, rule string, message string, value interface{}) error {
+	keyType, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("The %s field must be a string", field)
+	}
+
+	if keyType == "" || isValidKeyType(keyType) {
+		return nil
+	}
+
+	return fmt.Errorf("The %s field must be one of %s or a struct:<field>[,<field>...] expression", field, strings.Join(registeredKeyTypes(), ", "))
+}
+
 func withCommonValidationRules(extraRules validator.Rules) validator.Rules {
 	rules := commonReadValidationRules()
 	for key, validators := range extraRules {
@@ -68,7 +99,7 @@ func commonReadValidationRules() validator.Rules {
 		"block_num":      []string{"fluxdb.eos.blockNum"},
 		"offset":         []string{"numeric"},
 		"limit":          []string{"numeric"},
-		"key_type":       []string{"in:hex,hex_be,uint64,name,symbol,symbol_code"},
+		"key_type":       []string{"fluxdb.eos.keyType"},
 		"json":           []string{"bool"},
 		"with_abi":       []string{"bool"},
 		"with_block_num": []string{"bool"},
@@ -163,11 +163,79 @@ func TestValidateGetTableRequest(t *testing.T) {
 		{"scope not name", "account=c&scope=0&table=a", url.Values{
 			"scope": []string{"The scope field must be a valid EOS name"},
 		}},
+
+		{"index_position name", "account=c&scope=b&table=a&index_position=secondary", url.Values{}},
+
+		{"index_position numeric", "account=c&scope=b&table=a&index_position=3", url.Values{}},
+
+		{"bounds on secondary index", "account=c&scope=b&table=a&index_position=secondary&index_key_type=name&lower_bound=eosio&upper_bound=eosio.token", url.Values{}},
+
+		{"bounds on primary index", "account=c&scope=b&table=a&lower_bound=eosio&upper_bound=eosio.token&reverse=true", url.Values{}},
+
+		{"bounds on primary index with key_type", "account=c&scope=b&table=a&key_type=uint64&lower_bound=10", url.Values{}},
+
+		{"bounds invalid primary key", "account=c&scope=b&table=a&key_type=uint64&lower_bound=abc", url.Values{
+			"lower_bound": []string{"The lower_bound field must be a valid uint64 key"},
+		}},
+
+		{"reverse not bool", "account=c&scope=b&table=a&reverse=abc", url.Values{
+			"reverse": []string{"The reverse may only contain boolean value, string or int 0, 1"},
+		}},
+
+		{"bounds invalid secondary key", "account=c&scope=b&table=a&index_position=2&index_key_type=i64&upper_bound=abc", url.Values{
+			"upper_bound": []string{"The upper_bound field must be a valid i64 key"},
+		}},
 	}
 
 	runQueryValidatorTests(t, "TestValidateGetTableRequest", tests, validateGetTableRequest)
 }
 
+func TestValidateGetTableRowHistoryRequest(t *testing.T) {
+	validateCommonReadRequest(t, "row_history", "table=a&account=c&scope=b&primary_key=11", validateGetTableRowHistoryRequest)
+
+	tests := []queryValidatorTestCase{
+		{"block range", "account=c&scope=b&table=a&primary_key=11&from_block=10&to_block=20", url.Values{}},
+
+		{"open ended block range", "account=c&scope=b&table=a&primary_key=11&from_block=10", url.Values{}},
+
+		{"primary_key required", "account=c&scope=b&table=a", url.Values{
+			"primary_key": []string{"The primary_key field is required"},
+		}},
+
+		{"primary_key invalid for key_type", "account=c&scope=b&table=a&primary_key=d&key_type=uint64", url.Values{
+			"primary_key": []string{"The primary_key field must be a valid key for the requested key_type"},
+		}},
+
+		{"from_block higher than to_block", "account=c&scope=b&table=a&primary_key=11&from_block=20&to_block=10", url.Values{
+			"from_block": []string{"The from_block field must be lower or equal to to_block (10)"},
+		}},
+	}
+
+	runQueryValidatorTests(t, "TestValidateGetTableRowHistoryRequest", tests, validateGetTableRowHistoryRequest)
+}
+
+func TestValidateGetTableDiffRequest(t *testing.T) {
+	validateCommonReadRequest(t, "table_diff", "table=a&account=c&scope=b&from_block=10", validateGetTableDiffRequest)
+
+	tests := []queryValidatorTestCase{
+		{"block range", "account=c&scope=b&table=a&from_block=10&to_block=20", url.Values{}},
+
+		{"from_block required", "account=c&scope=b&table=a&to_block=20", url.Values{
+			"from_block": []string{"The from_block field is required", "The from_block field must be a valid EOS block num"},
+		}},
+
+		{"from_block not valid", "account=c&scope=b&table=a&from_block=a", url.Values{
+			"from_block": []string{"The from_block field must be a valid EOS block num"},
+		}},
+
+		{"from_block higher than to_block", "account=c&scope=b&table=a&from_block=20&to_block=10", url.Values{
+			"from_block": []string{"The from_block field must be lower or equal to to_block (10)"},
+		}},
+	}
+
+	runQueryValidatorTests(t, "TestValidateGetTableDiffRequest", tests, validateGetTableDiffRequest)
+}
+
 func TestValidateListTablesRowsForAccountsRequest(t *testing.T) {
 	validateCommonReadRequest(t, "multi_accounts", "accounts=a&table=t&scope=s", validateListTablesRowsForAccountsRequest)
 
@@ -258,6 +326,48 @@ func TestValidateListTablesRowsForScopesRequest(t *testing.T) {
 	runQueryValidatorTests(t, "TestValidateListTablesRowsForScopesRequest", tests, validateListTablesRowsForScopesRequest)
 }
 
+func TestValidateListTablesRowsBatchRequest(t *testing.T) {
+	tests := []bodyValidatorTestCase{
+		{"all valid", `{"tables":[{"account":"a","scope":"s","table":"t"},{"account":"b","scope":"","table":"t","primary_keys":["k1","k2"]}],"block_num":11,"json":true}`, url.Values{}},
+
+		{"scope symbol", `{"tables":[{"account":"a","scope":"4,EOS","table":"t"}]}`, url.Values{}},
+
+		{"primary keys with key_type", `{"tables":[{"account":"a","scope":"s","table":"t","primary_keys":["10"]}],"key_type":"uint64"}`, url.Values{}},
+
+		{"tables required", `{"block_num":11}`, url.Values{
+			"tables": []string{"The tables field is required"},
+		}},
+
+		{"account not name", `{"tables":[{"account":"9","scope":"s","table":"t"}]}`, url.Values{
+			"tables[0].account": []string{"The tables[0].account field must be a valid EOS name"},
+		}},
+
+		{"table not name", `{"tables":[{"account":"a","scope":"s","table":"t"},{"account":"a","scope":"s","table":"9999"}]}`, url.Values{
+			"tables[1].table": []string{"The tables[1].table field must be a valid EOS name"},
+		}},
+
+		{"primary key invalid for key_type", `{"tables":[{"account":"a","scope":"s","table":"t","primary_keys":["10","d"]}],"key_type":"uint64"}`, url.Values{
+			"tables[0].primary_keys[1]": []string{"The tables[0].primary_keys[1] field must be a valid key for the requested key_type"},
+		}},
+
+		{"tables above max", `{"tables":[` + strings.Repeat(`{"account":"a","scope":"s","table":"t"},`, maxBatchTableCount) + `{"account":"a","scope":"s","table":"t"}]}`, url.Values{
+			"tables": []string{fmt.Sprintf("The tables field must have at most %d elements", maxBatchTableCount)},
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := &listTablesRowsBatchRequest{}
+
+			req, err := http.NewRequest("POST", "/", strings.NewReader(test.body))
+			require.NoError(t, err)
+
+			errors := validateListTablesRowsBatchRequest(req, request)
+			assert.Equal(t, test.errors, errors)
+		})
+	}
+}
+
 func TestValidateGetLinkedPermssionsRequest(t *testing.T) {
 	validQuery := func(rest string) string {
 		return "account=a&" + rest
@@ -295,6 +405,9 @@ func validateCommonReadRequest(t *testing.T, tag string, validQueryPrefix string
 		{"key_type hex_be", validQuery("key_type=hex_be"), url.Values{}},
 		{"key_type name", validQuery("key_type=name"), url.Values{}},
 		{"key_type uint64", validQuery("key_type=uint64"), url.Values{}},
+		{"key_type i64", validQuery("key_type=i64"), url.Values{}},
+		{"key_type checksum256", validQuery("key_type=checksum256"), url.Values{}},
+		{"key_type struct", validQuery("key_type=struct:account,symbol"), url.Values{}},
 		{"json valid 0", validQuery("json=0"), url.Values{}},
 		{"json valid true", validQuery("json=true"), url.Values{}},
 		{"with_abi valid 0", validQuery("with_abi=0"), url.Values{}},
@@ -315,7 +428,11 @@ func validateCommonReadRequest(t *testing.T, tag string, validQueryPrefix string
 		}},
 
 		{"key_type invalid", validQuery("key_type=a"), url.Values{
-			"key_type": []string{"The key_type field must be one of hex, hex_be, uint64, name, symbol, symbol_code"},
+			"key_type": []string{"The key_type field must be one of checksum256, hex, hex_be, i64, name, symbol, symbol_code, uint64 or a struct:<field>[,<field>...] expression"},
+		}},
+
+		{"key_type struct without fields", validQuery("key_type=struct:"), url.Values{
+			"key_type": []string{"The key_type field must be one of checksum256, hex, hex_be, i64, name, symbol, symbol_code, uint64 or a struct:<field>[,<field>...] expression"},
 		}},
 
 		{"json not boolean", validQuery("json=a"), url.Values{
@@ -16,19 +16,18 @@ package fluxdb
 
 import (
 	"bytes"
-	"encoding/gob"
 	"fmt"
 
 	"github.com/abourget/llerrgroup"
 	"github.com/dfuse-io/bstream"
 	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dbin"
+	pbfluxdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/fluxdb/v1"
 	"github.com/dfuse-io/dstore"
 	"github.com/minio/highwayhash"
 	"go.uber.org/zap"
 )
 
-var fluxShardContentType = "FSR"
-
 type Sharder struct {
 	shardsStore dstore.Store
 	startBlock  uint32
@@ -37,14 +36,14 @@ type Sharder struct {
 
 	// A slice of shards, each shard is itself a slice of WriteRequest, one per block processed in this batch.
 	// So, assuming 2 shards with 5 blocs, that would yield `[0][#5, #6, #7, #8, #9], [1][#5, #6, #7, #8, #9]`.
-	buffers     []*bytes.Buffer
-	gobEncoders []*gob.Encoder
+	buffers []*bytes.Buffer
+	writers []*dbin.Writer
 }
 
-func NewSharder(shardsStore dstore.Store, shardCount int, startBlock, stopBlock uint32) *Sharder {
+func NewSharder(shardsStore dstore.Store, shardCount int, startBlock, stopBlock uint32) (*Sharder, error) {
 	s := &Sharder{
 		buffers:     make([]*bytes.Buffer, shardCount),
-		gobEncoders: make([]*gob.Encoder, shardCount),
+		writers:     make([]*dbin.Writer, shardCount),
 		shardCount:  shardCount,
 		shardsStore: shardsStore,
 		startBlock:  startBlock,
@@ -52,10 +51,25 @@ func NewSharder(shardsStore dstore.Store, shardCount int, startBlock, stopBlock
 	}
 	for i := 0; i < shardCount; i++ {
 		buf := bytes.NewBuffer(nil)
+		writer := dbin.NewWriter(buf)
+		if err := writer.WriteHeader(fluxShardContentType, fluxShardVersion); err != nil {
+			return nil, fmt.Errorf("unable to write shard %d file header: %w", i, err)
+		}
+
+		header := &pbfluxdb.ShardHeader{
+			ShardIndex: uint32(i),
+			ShardCount: uint32(shardCount),
+			StartBlock: startBlock,
+			StopBlock:  stopBlock,
+		}
+		if err := writeShardMessage(writer, header); err != nil {
+			return nil, fmt.Errorf("unable to write shard %d header: %w", i, err)
+		}
+
 		s.buffers[i] = buf
-		s.gobEncoders[i] = gob.NewEncoder(buf)
+		s.writers[i] = writer
 	}
-	return s
+	return s, nil
 }
 
 func (s *Sharder) ProcessBlock(rawBlk *bstream.Block, rawObj interface{}) error {
@@ -93,7 +107,7 @@ func (s *Sharder) ProcessBlock(rawBlk *bstream.Block, rawObj interface{}) error
 	}
 
 	// Loop over N shards computed above, and assign them correctly to the global shards slice
-	for shardIndex, encoder := range s.gobEncoders {
+	for shardIndex, writer := range s.writers {
 		shardedRequest := shardedRequests[shardIndex]
 		if shardedRequest == nil {
 			shardedRequest = &WriteRequest{}
@@ -106,7 +120,7 @@ func (s *Sharder) ProcessBlock(rawBlk *bstream.Block, rawObj interface{}) error
 		shardedRequest.BlockNum = unshardedRequest.BlockNum
 		shardedRequest.BlockID = unshardedRequest.BlockID
 
-		if err := encoder.Encode(shardedRequest); err != nil {
+		if err := writeShardMessage(writer, writeRequestToProto(shardedRequest)); err != nil {
 			return fmt.Errorf("encoding sharded request: %s", err)
 		}
 	}
@@ -132,7 +146,7 @@ func (s *Sharder) writeShards() error {
 		shardIndex := shardIndex
 		buffer := buffer
 		eg.Go(func() error {
-			baseName := fmt.Sprintf("%03d/%010d-%010d", shardIndex, s.startBlock, s.stopBlock)
+			baseName := shardFilename(shardIndex, s.startBlock, s.stopBlock)
 
 			zlog.Info("encoding shard", zap.Int("shard_index", shardIndex), zap.Uint32("start", s.startBlock), zap.Uint32("stop", s.stopBlock))
 
@@ -148,3 +162,9 @@ func (s *Sharder) writeShards() error {
 	}
 	return eg.Wait()
 }
+
+// shardFilename is the name of the file holding the write requests of shard
+// `shardIndex` for the inclusive `[startBlock, stopBlock]` range.
+func shardFilename(shardIndex int, startBlock, stopBlock uint32) string {
+	return fmt.Sprintf("%03d/%010d-%010d", shardIndex, startBlock, stopBlock)
+}
@@ -0,0 +1,233 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dfuse-io/dbin"
+	pbfluxdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/fluxdb/v1"
+	"github.com/golang/protobuf/proto"
+)
+
+// Shard files hold the write requests of a single shard for a contiguous range of
+// blocks, one write request per block, in block order.
+//
+// The current format (version 2) is a dbin file of content type `FSR` at version 2.
+// The dbin header is followed by a `pbfluxdb.ShardHeader` message identifying the
+// shard and its block range, then by one `pbfluxdb.ShardWriteRequest` message per
+// block. The messages are defined in `pb/dfuse/eosio/fluxdb/v1/fluxdb.proto`.
+//
+// The legacy format (version 1) is a plain stream of gob encoded `WriteRequest`,
+// without any header. It's still readable but is not written anymore.
+var fluxShardContentType = "FSR"
+
+const (
+	fluxShardLegacyVersion = 1
+	fluxShardVersion       = 2
+)
+
+func writeShardMessage(writer *dbin.Writer, message proto.Message) error {
+	bytes, err := proto.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("unable to marshal shard message: %w", err)
+	}
+
+	return writer.WriteMessage(bytes)
+}
+
+func readShardMessage(reader *dbin.Reader, message proto.Message) error {
+	bytes, err := reader.ReadMessage()
+	if err == io.EOF {
+		return io.EOF
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed reading next dbin message: %w", err)
+	}
+
+	return proto.Unmarshal(bytes, message)
+}
+
+func writeRequestToProto(req *WriteRequest) *pbfluxdb.ShardWriteRequest {
+	out := &pbfluxdb.ShardWriteRequest{
+		BlockNum: req.BlockNum,
+		BlockId:  req.BlockID,
+	}
+
+	for _, row := range req.ABIs {
+		out.Abis = append(out.Abis, &pbfluxdb.ShardABIRow{Account: row.Account, PackedAbi: row.PackedABI})
+	}
+
+	for _, row := range req.AccountPermissions {
+		out.AccountPermissions = append(out.AccountPermissions, &pbfluxdb.ShardAccountPermissionRow{
+			Account:    row.Account,
+			Permission: row.Permission,
+			Deletion:   row.Deletion,
+			Data:       row.Data,
+		})
+	}
+
+	for _, row := range req.AccountResourceLimits {
+		out.AccountResourceLimits = append(out.AccountResourceLimits, &pbfluxdb.ShardAccountResourceLimitRow{
+			Account: row.Account,
+			Kind:    uint32(row.Kind),
+			Data:    row.Data,
+		})
+	}
+
+	for _, row := range req.AuthLinks {
+		out.AuthLinks = append(out.AuthLinks, &pbfluxdb.ShardAuthLinkRow{
+			Account:        row.Account,
+			Contract:       row.Contract,
+			Action:         row.Action,
+			PermissionName: row.PermissionName,
+			Deletion:       row.Deletion,
+		})
+	}
+
+	for _, row := range req.KeyAccounts {
+		out.KeyAccounts = append(out.KeyAccounts, &pbfluxdb.ShardKeyAccountRow{
+			PublicKey:  row.PublicKey,
+			Account:    row.Account,
+			Permission: row.Permission,
+			Deletion:   row.Deletion,
+		})
+	}
+
+	for _, row := range req.TableDatas {
+		out.TableDatas = append(out.TableDatas, &pbfluxdb.ShardTableDataRow{
+			Account:  row.Account,
+			Scope:    row.Scope,
+			Table:    row.Table,
+			PrimKey:  row.PrimKey,
+			Payer:    row.Payer,
+			OldPayer: row.OldPayer,
+			Deletion: row.Deletion,
+			Data:     row.Data,
+		})
+	}
+
+	for _, row := range req.TableScopes {
+		out.TableScopes = append(out.TableScopes, &pbfluxdb.ShardTableScopeRow{
+			Account:  row.Account,
+			Scope:    row.Scope,
+			Table:    row.Table,
+			Payer:    row.Payer,
+			Deletion: row.Deletion,
+		})
+	}
+
+	for _, row := range req.SecondaryIndexes {
+		out.SecondaryIndexes = append(out.SecondaryIndexes, &pbfluxdb.ShardSecondaryIndexRow{
+			Account:       row.Account,
+			Scope:         row.Scope,
+			Table:         row.Table,
+			PrimKey:       row.PrimKey,
+			IndexPosition: uint32(row.IndexPosition),
+			Kind:          uint32(row.Kind),
+			Deletion:      row.Deletion,
+			Key:           row.Key,
+		})
+	}
+
+	return out
+}
+
+func writeRequestFromProto(in *pbfluxdb.ShardWriteRequest) *WriteRequest {
+	req := &WriteRequest{
+		BlockNum: in.BlockNum,
+		BlockID:  in.BlockId,
+	}
+
+	for _, row := range in.Abis {
+		req.ABIs = append(req.ABIs, &ABIRow{Account: row.Account, PackedABI: row.PackedAbi})
+	}
+
+	for _, row := range in.AccountPermissions {
+		req.AccountPermissions = append(req.AccountPermissions, &AccountPermissionRow{
+			Account:    row.Account,
+			Permission: row.Permission,
+			Deletion:   row.Deletion,
+			Data:       row.Data,
+		})
+	}
+
+	for _, row := range in.AccountResourceLimits {
+		req.AccountResourceLimits = append(req.AccountResourceLimits, &AccountResourceLimitRow{
+			Account: row.Account,
+			Kind:    AccountResourceLimitKind(row.Kind),
+			Data:    row.Data,
+		})
+	}
+
+	for _, row := range in.AuthLinks {
+		req.AuthLinks = append(req.AuthLinks, &AuthLinkRow{
+			Account:        row.Account,
+			Contract:       row.Contract,
+			Action:         row.Action,
+			PermissionName: row.PermissionName,
+			Deletion:       row.Deletion,
+		})
+	}
+
+	for _, row := range in.KeyAccounts {
+		req.KeyAccounts = append(req.KeyAccounts, &KeyAccountRow{
+			PublicKey:  row.PublicKey,
+			Account:    row.Account,
+			Permission: row.Permission,
+			Deletion:   row.Deletion,
+		})
+	}
+
+	for _, row := range in.TableDatas {
+		req.TableDatas = append(req.TableDatas, &TableDataRow{
+			Account:  row.Account,
+			Scope:    row.Scope,
+			Table:    row.Table,
+			PrimKey:  row.PrimKey,
+			Payer:    row.Payer,
+			OldPayer: row.OldPayer,
+			Deletion: row.Deletion,
+			Data:     row.Data,
+		})
+	}
+
+	for _, row := range in.TableScopes {
+		req.TableScopes = append(req.TableScopes, &TableScopeRow{
+			Account:  row.Account,
+			Scope:    row.Scope,
+			Table:    row.Table,
+			Payer:    row.Payer,
+			Deletion: row.Deletion,
+		})
+	}
+
+	for _, row := range in.SecondaryIndexes {
+		req.SecondaryIndexes = append(req.SecondaryIndexes, &SecondaryIndexRow{
+			Account:       row.Account,
+			Scope:         row.Scope,
+			Table:         row.Table,
+			PrimKey:       row.PrimKey,
+			IndexPosition: uint8(row.IndexPosition),
+			Kind:          SecondaryIndexKind(row.Kind),
+			Deletion:      row.Deletion,
+			Key:           row.Key,
+		})
+	}
+
+	return req
+}
@@ -15,11 +15,15 @@
 package fluxdb
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/gob"
 	"fmt"
 	"io"
 
+	"github.com/dfuse-io/dbin"
+	pbfluxdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/fluxdb/v1"
 	"github.com/dfuse-io/dstore"
 	"go.uber.org/zap"
 )
@@ -66,9 +70,82 @@ func (s *ShardInjector) Run() (err error) {
 }
 
 func readWriteRequestsForBatch(reader io.Reader) ([]*WriteRequest, error) {
+	_, shardRequests, err := ReadShard(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*WriteRequest, len(shardRequests))
+	for i, shardRequest := range shardRequests {
+		requests[i] = writeRequestFromProto(shardRequest)
+	}
+
+	return requests, nil
+}
+
+var dbinMagic = []byte("dbin")
+
+// ReadShard reads a shard file produced by the `Sharder`, returning its header and its
+// write requests in block order. The format is negotiated from the start of the file,
+// dbin files are read according to their content version while files without a dbin
+// header are legacy gob shards, for which the returned header is nil as the legacy
+// format doesn't record one.
+func ReadShard(reader io.Reader) (*pbfluxdb.ShardHeader, []*pbfluxdb.ShardWriteRequest, error) {
+	bufferedReader := bufio.NewReader(reader)
+	magic, err := bufferedReader.Peek(len(dbinMagic))
+	if err != nil && err != io.EOF {
+		return nil, nil, fmt.Errorf("unable to peek shard format: %w", err)
+	}
+
+	if !bytes.Equal(magic, dbinMagic) {
+		requests, err := readLegacyShard(bufferedReader)
+		return nil, requests, err
+	}
+
+	dbinReader := dbin.NewReader(bufferedReader)
+	contentType, version, err := dbinReader.ReadHeader()
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read shard file header: %w", err)
+	}
+
+	if contentType != fluxShardContentType {
+		return nil, nil, fmt.Errorf("expected shard of kind %s, got %s", fluxShardContentType, contentType)
+	}
+
+	switch version {
+	case fluxShardVersion:
+		return readShard(dbinReader)
+	default:
+		return nil, nil, fmt.Errorf("unsupported shard version %d, only version %d and legacy version %d are supported", version, fluxShardVersion, fluxShardLegacyVersion)
+	}
+}
+
+func readShard(reader *dbin.Reader) (*pbfluxdb.ShardHeader, []*pbfluxdb.ShardWriteRequest, error) {
+	header := &pbfluxdb.ShardHeader{}
+	if err := readShardMessage(reader, header); err != nil {
+		return nil, nil, fmt.Errorf("unable to read shard header: %w", err)
+	}
+
+	var requests []*pbfluxdb.ShardWriteRequest
+	for {
+		request := &pbfluxdb.ShardWriteRequest{}
+		err := readShardMessage(reader, request)
+		if err == io.EOF {
+			return header, requests, nil
+		}
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to read ShardWriteRequest: %w", err)
+		}
+
+		requests = append(requests, request)
+	}
+}
+
+func readLegacyShard(reader io.Reader) ([]*pbfluxdb.ShardWriteRequest, error) {
 	decoder := gob.NewDecoder(reader)
 
-	var requests []*WriteRequest
+	var requests []*pbfluxdb.ShardWriteRequest
 	for {
 		req := &WriteRequest{}
 		err := decoder.Decode(req)
@@ -78,6 +155,6 @@ func readWriteRequestsForBatch(reader io.Reader) ([]*WriteRequest, error) {
 		if err != nil {
 			return nil, fmt.Errorf("unable to read WriteRequest: %s", err)
 		}
-		requests = append(requests, req)
+		requests = append(requests, writeRequestToProto(req))
 	}
 }
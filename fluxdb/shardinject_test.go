@@ -0,0 +1,148 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dbin"
+	"github.com/dfuse-io/dstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharderRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fluxdb-sharder")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	shardsStore, err := dstore.NewSimpleStore("file://" + dir)
+	require.NoError(t, err)
+
+	sharder, err := NewSharder(shardsStore, 2, 1, 2)
+	require.NoError(t, err)
+
+	requests := []*WriteRequest{
+		{
+			BlockNum: 1,
+			BlockID:  []byte{0x00, 0x00, 0x00, 0x01, 0xaa},
+			ABIs:     []*ABIRow{{Account: 1, PackedABI: []byte("abi")}},
+			TableDatas: []*TableDataRow{
+				{1, 2, 3, 4, 5, 0, false, []byte{0x01}},
+				{6, 7, 8, 9, 10, 0, false, []byte{0x02}},
+			},
+			AuthLinks: []*AuthLinkRow{{false, 1, 2, 3, 4}},
+		},
+		{
+			BlockNum:         2,
+			BlockID:          []byte{0x00, 0x00, 0x00, 0x02, 0xaa},
+			TableDatas:       []*TableDataRow{{1, 2, 3, 4, 0, 5, true, nil}},
+			KeyAccounts:      []*KeyAccountRow{{"EOS5MHPYyhjBjnQZejzZHqHewPWhGTfQWSVTWYEhDmJu4SXkzgweP", 1, 2, false}},
+			SecondaryIndexes: []*SecondaryIndexRow{{1, 2, 3, 4, 1, SecondaryIndexKindUint64, false, []byte{0x01}}},
+		},
+	}
+
+	for _, request := range requests {
+		require.NoError(t, sharder.ProcessBlock(&bstream.Block{Number: uint64(request.BlockNum)}, &forkable.ForkableObject{Step: forkable.StepIrreversible, Obj: request}))
+	}
+
+	err = sharder.ProcessBlock(&bstream.Block{Number: 3}, &forkable.ForkableObject{Step: forkable.StepIrreversible, Obj: &WriteRequest{BlockNum: 3}})
+	require.Equal(t, ErrCleanSourceStop, err)
+
+	rowKeysByBlock := map[uint32][]string{}
+	for shardIndex := 0; shardIndex < 2; shardIndex++ {
+		reader, err := shardsStore.OpenObject(shardFilename(shardIndex, 1, 2))
+		require.NoError(t, err)
+
+		header, shardRequests, err := ReadShard(reader)
+		reader.Close()
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(shardIndex), header.ShardIndex)
+		assert.Equal(t, uint32(2), header.ShardCount)
+		assert.Equal(t, uint32(1), header.StartBlock)
+		assert.Equal(t, uint32(2), header.StopBlock)
+		require.Len(t, shardRequests, 2)
+
+		for i, shardRequest := range shardRequests {
+			request := writeRequestFromProto(shardRequest)
+			assert.Equal(t, requests[i].BlockNum, request.BlockNum)
+			assert.Equal(t, requests[i].BlockID, request.BlockID)
+
+			if shardIndex == 0 {
+				assert.Equal(t, requests[i].ABIs, request.ABIs)
+			} else {
+				assert.Empty(t, request.ABIs)
+			}
+
+			for _, row := range request.AllWritableRows() {
+				rowKeysByBlock[request.BlockNum] = append(rowKeysByBlock[request.BlockNum], row.rowKey(request.BlockNum)+"="+string(row.buildData()))
+			}
+
+			for _, row := range request.ramPayerRows() {
+				rowKeysByBlock[request.BlockNum] = append(rowKeysByBlock[request.BlockNum], row.rowKey(request.BlockNum)+"="+fmt.Sprint(row.isDeletion()))
+			}
+		}
+	}
+
+	for _, request := range requests {
+		var expected []string
+		for _, row := range request.AllWritableRows() {
+			expected = append(expected, row.rowKey(request.BlockNum)+"="+string(row.buildData()))
+		}
+
+		for _, row := range request.ramPayerRows() {
+			expected = append(expected, row.rowKey(request.BlockNum)+"="+fmt.Sprint(row.isDeletion()))
+		}
+
+		assert.ElementsMatch(t, expected, rowKeysByBlock[request.BlockNum])
+	}
+}
+
+func TestReadShard_Legacy(t *testing.T) {
+	requests := []*WriteRequest{
+		tableDataRows(1, &TableDataRow{1, 2, 3, 4, 5, 0, false, []byte{0x01}}),
+		tableDataRows(2, &TableDataRow{1, 2, 3, 4, 0, 0, true, nil}),
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	encoder := gob.NewEncoder(buffer)
+	for _, request := range requests {
+		require.NoError(t, encoder.Encode(request))
+	}
+
+	header, _, err := ReadShard(bytes.NewReader(buffer.Bytes()))
+	require.NoError(t, err)
+	assert.Nil(t, header)
+
+	actual, err := readWriteRequestsForBatch(bytes.NewReader(buffer.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, requests, actual)
+}
+
+func TestReadShard_UnsupportedVersion(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	require.NoError(t, dbin.NewWriter(buffer).WriteHeader(fluxShardContentType, 3))
+
+	_, _, err := ReadShard(buffer)
+	assert.EqualError(t, err, "unsupported shard version 3, only version 2 and legacy version 1 are supported")
+}
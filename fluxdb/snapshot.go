@@ -0,0 +1,390 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/dbin"
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	pbfluxdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/fluxdb/v1"
+	"github.com/dfuse-io/dstore"
+	"github.com/dfuse-io/dtracing"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+var fluxSnapshotContentType = "FSN"
+
+var ErrNoSnapshot = errors.New("no snapshot found")
+
+// ExportSnapshot writes to `writer` the state of all tables, scopes, key accounts,
+// auth links, resource limits and ABIs as they were at `block`. Only the latest
+// version of each row is kept, which makes the snapshot compact but means the
+// history preceding the snapshot block is not part of it.
+//
+// The block must have been written already, rows and ABIs are immutable once
+// irreversible so the export is consistent even while blocks are being written.
+func (fdb *FluxDB) ExportSnapshot(ctx context.Context, block bstream.BlockRef, writer io.Writer) error {
+	ctx, span := dtracing.StartSpan(ctx, "export snapshot", "block_num", block.Num())
+	defer span.End()
+
+	lastWrittenBlock, err := fdb.FetchLastWrittenBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	if block.Num() > lastWrittenBlock.Num() {
+		return fmt.Errorf("block %d is not written yet, last written block is %d", block.Num(), lastWrittenBlock.Num())
+	}
+
+	blockNum := uint32(block.Num())
+	dbinWriter := dbin.NewWriter(writer)
+	if err := dbinWriter.WriteHeader(fluxSnapshotContentType, 1); err != nil {
+		return derr.Wrap(err, "unable to write snapshot header")
+	}
+
+	if err := writeSnapshotMessage(dbinWriter, &pbfluxdb.SnapshotHeader{BlockNum: blockNum, BlockId: block.ID()}); err != nil {
+		return err
+	}
+
+	abiCount := 0
+	lastAccountKey := ""
+	err = fdb.store.ScanABIs(ctx, "", "", func(key string, rawABI []byte) error {
+		accountKey := key[:strings.Index(key, ":")+1]
+		if accountKey == lastAccountKey {
+			return nil
+		}
+
+		abiBlockNum, err := chunkKeyRevBlockNum(key, accountKey)
+		if err != nil {
+			return fmt.Errorf("couldn't infer block num in abi key %q: %w", key, err)
+		}
+
+		// Keys of an account are ordered from most recent to oldest ABI
+		if abiBlockNum > blockNum {
+			return nil
+		}
+
+		lastAccountKey = accountKey
+		abiCount++
+
+		return writeSnapshotMessage(dbinWriter, &pbfluxdb.SnapshotEntry{Type: pbfluxdb.SnapshotEntry_TYPE_ABI, Key: key, Value: rawABI})
+	})
+	if err != nil {
+		return derr.Wrap(err, "unable to export abis")
+	}
+
+	rowCount := 0
+	err = fdb.forEachTablet(ctx, func(tableKey string) error {
+		latestRows := map[string]*pbfluxdb.SnapshotEntry{}
+		rowUpdated := func(rowBlockNum uint32, primaryKey string, value []byte) error {
+			key := fmt.Sprintf("%s:%08x:%s", tableKey, rowBlockNum, primaryKey)
+			latestRows[primaryKey] = &pbfluxdb.SnapshotEntry{Type: pbfluxdb.SnapshotEntry_TYPE_ROW, Key: key, Value: value}
+			return nil
+		}
+
+		rowDeleted := func(rowBlockNum uint32, primaryKey string) error {
+			delete(latestRows, primaryKey)
+			return nil
+		}
+
+		// Reading at the snapshot block goes through the table index, only the rows
+		// written since the index was taken are scanned
+		if err := fdb.read(ctx, tableKey, blockNum, rowUpdated, rowDeleted); err != nil {
+			return derr.Wrapf(err, "unable to read rows of table key %q", tableKey)
+		}
+
+		primaryKeys := make([]string, 0, len(latestRows))
+		for primaryKey := range latestRows {
+			primaryKeys = append(primaryKeys, primaryKey)
+		}
+		sort.Strings(primaryKeys)
+
+		for _, primaryKey := range primaryKeys {
+			if err := writeSnapshotMessage(dbinWriter, latestRows[primaryKey]); err != nil {
+				return err
+			}
+		}
+
+		rowCount += len(primaryKeys)
+		return nil
+	})
+	if err != nil {
+		return derr.Wrap(err, "unable to export rows")
+	}
+
+	zlog.Info("exported snapshot", zap.Stringer("block", block), zap.Int("abi_count", abiCount), zap.Int("row_count", rowCount))
+	return nil
+}
+
+// forEachTablet calls `onTablet` with the key of each tablet present in the
+// store, in key order. Only the first row of each tablet is read, the scan then
+// jumps past all the other rows of the tablet.
+func (fdb *FluxDB) forEachTablet(ctx context.Context, onTablet func(tableKey string) error) error {
+	startKey := ""
+	for {
+		tableKey := ""
+		err := fdb.store.ScanTabletRows(ctx, startKey, "", func(key string, _ []byte) (err error) {
+			tableKey, _, _, err = explodeWritableRowKey(key)
+			if err != nil {
+				return fmt.Errorf("couldn't parse row key %q: %w", key, err)
+			}
+
+			return store.BreakScan
+		})
+		if err != nil && err != store.BreakScan {
+			return err
+		}
+
+		if tableKey == "" {
+			return nil
+		}
+
+		if err := onTablet(tableKey); err != nil {
+			return err
+		}
+
+		// `;` directly follows `:` so this skips all the `<tableKey>:...` rows
+		startKey = tableKey + ";"
+	}
+}
+
+// ImportSnapshot loads in an empty database a snapshot produced by `ExportSnapshot`,
+// returning the snapshot block which is then marked as the last written block, so
+// the pipeline resumes right after it.
+func (fdb *FluxDB) ImportSnapshot(ctx context.Context, reader io.Reader) (bstream.BlockRef, error) {
+	ctx, span := dtracing.StartSpan(ctx, "import snapshot")
+	defer span.End()
+
+	_, err := fdb.store.FetchLastWrittenBlock(ctx, fdb.lastBlockKey())
+	if err == nil {
+		return nil, errors.New("last written block marker present, a snapshot can only be imported in an empty database")
+	}
+
+	if err != store.ErrNotFound {
+		return nil, derr.Wrap(err, "unable to check last written block")
+	}
+
+	dbinReader := dbin.NewReader(reader)
+	contentType, version, err := dbinReader.ReadHeader()
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to read snapshot header")
+	}
+
+	if contentType != fluxSnapshotContentType || version != 1 {
+		return nil, fmt.Errorf("expected snapshot of kind %s at version 1, got %s at version %d", fluxSnapshotContentType, contentType, version)
+	}
+
+	header := &pbfluxdb.SnapshotHeader{}
+	if err := readSnapshotMessage(dbinReader, header); err != nil {
+		return nil, derr.Wrap(err, "unable to read snapshot block")
+	}
+
+	block := bstream.NewBlockRef(header.BlockId, uint64(header.BlockNum))
+	zlog.Info("importing snapshot", zap.Stringer("block", block))
+
+	abiCount, rowCount := 0, 0
+	batch := fdb.store.NewBatch(zlog)
+	for {
+		entry := &pbfluxdb.SnapshotEntry{}
+		err := readSnapshotMessage(dbinReader, entry)
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, derr.Wrap(err, "unable to read snapshot entry")
+		}
+
+		switch entry.Type {
+		case pbfluxdb.SnapshotEntry_TYPE_ABI:
+			batch.SetABI(entry.Key, entry.Value)
+			abiCount++
+
+		case pbfluxdb.SnapshotEntry_TYPE_ROW:
+			tableKey, _, _, err := explodeWritableRowKey(entry.Key)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't parse row key %q: %w", entry.Key, err)
+			}
+
+			batch.SetRow(entry.Key, entry.Value)
+			rowCount++
+
+			fdb.idxCache.IncCount(tableKey)
+			if fdb.idxCache.shouldTriggerIndexing(tableKey) {
+				fdb.idxCache.ScheduleIndex(tableKey, header.BlockNum)
+			}
+
+		default:
+			return nil, fmt.Errorf("unknown snapshot entry type %s for key %q", entry.Type, entry.Key)
+		}
+
+		if err := batch.FlushIfFull(ctx); err != nil {
+			return nil, derr.Wrap(err, "flushing if full")
+		}
+	}
+
+	if err := batch.Flush(ctx); err != nil {
+		return nil, derr.Wrap(err, "flush")
+	}
+
+	if sched := fdb.idxCache.IndexingSchedule(); len(sched) != 0 {
+		if err := fdb.IndexTables(ctx); err != nil {
+			return nil, derr.Wrap(err, "index tables")
+		}
+	}
+
+	// The markers are written last, a partially imported snapshot must not look like a valid database.
+	// There is no history before the snapshot block, reads below it are rejected like on a pruned database.
+	batch.SetMarker(prunedBlockMarkerKey, []byte(HexBlockNum(header.BlockNum)))
+	batch.SetLast(fdb.lastBlockKey(), []byte(header.BlockId))
+	if err := batch.Flush(ctx); err != nil {
+		return nil, derr.Wrap(err, "flushing pruned and last block markers")
+	}
+
+	zlog.Info("imported snapshot", zap.Stringer("block", block), zap.Int("abi_count", abiCount), zap.Int("row_count", rowCount))
+	return block, nil
+}
+
+func writeSnapshotMessage(writer *dbin.Writer, message proto.Message) error {
+	bytes, err := proto.Marshal(message)
+	if err != nil {
+		return derr.Wrap(err, "unable to marshal snapshot message")
+	}
+
+	return writer.WriteMessage(bytes)
+}
+
+func readSnapshotMessage(reader *dbin.Reader, message proto.Message) error {
+	bytes, err := reader.ReadMessage()
+	if err == io.EOF {
+		return io.EOF
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed reading next dbin message: %w", err)
+	}
+
+	return proto.Unmarshal(bytes, message)
+}
+
+// Snapshotter periodically exports snapshots of the database to a store, one
+// file per snapshot named after its zero-padded block num.
+type Snapshotter struct {
+	snapshotsStore dstore.Store
+	db             *FluxDB
+	intervalBlocks uint32
+
+	running int32
+}
+
+func NewSnapshotter(snapshotsStore dstore.Store, db *FluxDB, intervalBlocks uint32) *Snapshotter {
+	return &Snapshotter{
+		snapshotsStore: snapshotsStore,
+		db:             db,
+		intervalBlocks: intervalBlocks,
+	}
+}
+
+// OnBlocksWritten triggers an export in the background when the written range
+// `[fromBlockNum, lastBlock]` crosses a multiple of the interval. The export is
+// skipped when the previous one is still running.
+func (s *Snapshotter) OnBlocksWritten(fromBlockNum uint32, lastBlock bstream.BlockRef) {
+	if !s.crossesInterval(fromBlockNum, uint32(lastBlock.Num())) {
+		return
+	}
+
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		zlog.Info("previous snapshot still running, skipping this one", zap.Stringer("block", lastBlock))
+		return
+	}
+
+	go func() {
+		defer atomic.StoreInt32(&s.running, 0)
+
+		if err := s.Export(context.Background(), lastBlock); err != nil {
+			zlog.Error("unable to export snapshot", zap.Stringer("block", lastBlock), zap.Error(err))
+		}
+	}()
+}
+
+func (s *Snapshotter) crossesInterval(fromBlockNum uint32, lastBlockNum uint32) bool {
+	if s.intervalBlocks == 0 {
+		return false
+	}
+
+	// Block 0 is a multiple of any interval
+	if fromBlockNum == 0 {
+		return true
+	}
+
+	return (fromBlockNum-1)/s.intervalBlocks != lastBlockNum/s.intervalBlocks
+}
+
+func (s *Snapshotter) Export(ctx context.Context, block bstream.BlockRef) error {
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(s.db.ExportSnapshot(ctx, block, writer))
+	}()
+
+	filename := snapshotFilename(uint32(block.Num()))
+	if err := s.snapshotsStore.WriteObject(filename, reader); err != nil {
+		// Unblocks the exporter if the store gave up before consuming everything
+		reader.CloseWithError(err)
+		return fmt.Errorf("unable to write snapshot %q: %w", filename, err)
+	}
+
+	return nil
+}
+
+// ImportLatest imports the most recent snapshot of the store, returning
+// `ErrNoSnapshot` when the store is empty.
+func (s *Snapshotter) ImportLatest(ctx context.Context) (bstream.BlockRef, error) {
+	var latest string
+	err := s.snapshotsStore.Walk("", "", func(filename string) error {
+		// Block nums are zero-padded so the last file listed is the most recent
+		latest = filename
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking snapshots store: %w", err)
+	}
+
+	if latest == "" {
+		return nil, ErrNoSnapshot
+	}
+
+	zlog.Info("importing latest snapshot", zap.String("filename", latest))
+	reader, err := s.snapshotsStore.OpenObject(latest)
+	if err != nil {
+		return nil, fmt.Errorf("opening snapshot %q: %w", latest, err)
+	}
+	defer reader.Close()
+
+	return s.db.ImportSnapshot(ctx, reader)
+}
+
+func snapshotFilename(blockNum uint32) string {
+	return fmt.Sprintf("%010d", blockNum)
+}
@@ -0,0 +1,138 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotExportImport(t *testing.T) {
+	tests := []struct {
+		name            string
+		indexAtBlockNum uint32
+	}{
+		{"without index", 0},
+		{"index before snapshot block", 10},
+		{"index at snapshot block", 11},
+		{"index after snapshot block", 12},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testSnapshotExportImport(t, test.indexAtBlockNum)
+		})
+	}
+}
+
+func testSnapshotExportImport(t *testing.T, indexAtBlockNum uint32) {
+	source, sourceCloser := NewTestDB(t)
+	defer sourceCloser()
+
+	account, scope, table := uint64(0), uint64(1), uint64(2)
+
+	block := func(blockNum uint32, abi []byte, rows ...*TableDataRow) *WriteRequest {
+		req := tableDataRows(blockNum, rows...)
+		req.BlockID, _ = hex.DecodeString(fmt.Sprintf("%08xaa", blockNum))
+		if abi != nil {
+			req.ABIs = []*ABIRow{&ABIRow{account, blockNum, abi}}
+		}
+
+		return req
+	}
+
+	executeWriteRequests(t, source,
+		block(10, []byte("first"),
+			&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01}},
+			&TableDataRow{account, scope, table, 2, 5, 0, false, []byte{0x02}},
+		),
+		block(11, nil,
+			&TableDataRow{account, scope, table, 1, 6, 0, false, []byte{0x11}},
+			&TableDataRow{account, scope, table, 2, 0, 0, true, nil},
+			&TableDataRow{account, scope, table, 3, 5, 0, false, []byte{0x03}},
+		),
+		block(12, []byte("second"),
+			&TableDataRow{account, scope, table, 3, 0, 0, true, nil},
+			&TableDataRow{account, scope, table, 4, 5, 0, false, []byte{0x04}},
+		),
+	)
+
+	if indexAtBlockNum != 0 {
+		tableKey := (&ReadTableRequest{Account: account, Scope: scope, Table: table}).tableKey()
+		source.idxCache.ScheduleIndex(tableKey, indexAtBlockNum)
+		require.NoError(t, source.IndexTables(context.Background()))
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	require.NoError(t, source.ExportSnapshot(context.Background(), bstream.BlockRefFromID("0000000baa"), buffer))
+
+	target, targetCloser := NewTestDB(t)
+	defer targetCloser()
+
+	snapshotBlock, err := target.ImportSnapshot(context.Background(), buffer)
+	require.NoError(t, err)
+	assert.Equal(t, "0000000baa", snapshotBlock.ID())
+	assert.Equal(t, uint64(11), snapshotBlock.Num())
+
+	lastWrittenBlock, err := target.FetchLastWrittenBlock(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "0000000baa", lastWrittenBlock.ID())
+
+	prunedBlockNum, err := target.FetchPrunedBlockNum(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint32(11), prunedBlockNum, "history before the snapshot block is not available")
+
+	resp, err := target.ReadTable(context.Background(), &ReadTableRequest{Account: account, Scope: scope, Table: table, BlockNum: 11})
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte("first"), resp.ABI.PackedABI)
+	require.Len(t, resp.Rows, 2)
+	assert.Equal(t, &TableRow{Key: 1, Payer: 6, Data: []byte{0x11}, BlockNum: 11}, resp.Rows[0])
+	assert.Equal(t, &TableRow{Key: 3, Payer: 5, Data: []byte{0x03}, BlockNum: 11}, resp.Rows[1])
+
+	_, err = target.ImportSnapshot(context.Background(), bytes.NewBuffer(nil))
+	assert.Error(t, err, "importing in a non-empty database should fail")
+}
+
+func TestSnapshotter_CrossesInterval(t *testing.T) {
+	tests := []struct {
+		name           string
+		intervalBlocks uint32
+		fromBlockNum   uint32
+		lastBlockNum   uint32
+		expected       bool
+	}{
+		{"disabled", 0, 1, 1000, false},
+		{"within interval", 100, 101, 199, false},
+		{"reaching boundary", 100, 101, 200, true},
+		{"starting at boundary", 100, 200, 250, true},
+		{"after boundary", 100, 201, 250, false},
+		{"starting at block 0", 100, 0, 10, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			snapshotter := NewSnapshotter(nil, nil, test.intervalBlocks)
+			assert.Equal(t, test.expected, snapshotter.crossesInterval(test.fromBlockNum, test.lastBlockNum))
+		})
+	}
+}
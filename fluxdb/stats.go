@@ -0,0 +1,343 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/dfuse-io/dtracing"
+	"github.com/dfuse-io/logging"
+	"go.uber.org/zap"
+)
+
+// TableStats is the number of rows of a contract table at a given block along
+// with the total size of their payload, the payload being the row value as
+// stored, that is the payer followed by the row data.
+type TableStats struct {
+	Account          uint64
+	Scope            uint64
+	Table            uint64
+	RowCount         uint64
+	PayloadByteCount uint64
+}
+
+// ReadTableStats computes the stats of a contract table at `blockNum`, starting
+// from the stats of the closest index and applying the rows written since then
+// along with the speculative writes.
+func (fdb *FluxDB) ReadTableStats(ctx context.Context, blockNum uint32, account, scope, table uint64, speculativeWrites []*WriteRequest) (*TableStats, error) {
+	ctx, span := dtracing.StartSpan(ctx, "read table stats", "block_num", blockNum)
+	defer span.End()
+
+	zlog := logging.Logger(ctx, zlog)
+
+	tableKey := (&TableDataRow{Account: account, Scope: scope, Table: table}).tableKey()
+	zlog.Debug("reading table stats", zap.String("table_key", tableKey), zap.Uint32("block_num", blockNum))
+
+	index, err := fdb.getIndex(ctx, tableKey, blockNum)
+	if err != nil {
+		return nil, derr.Wrapf(err, "get index %s (%d)", tableKey, blockNum)
+	}
+
+	firstRowKey := tableKey + ":00000000"
+	if index != nil {
+		firstRowKey = tableKey + ":" + HexBlockNum(index.AtBlockNum+1)
+	} else {
+		index = NewTableIndex()
+	}
+
+	stats := newIndexStatsUpdater(fdb, tableKey, index)
+	lastRowKey := tableKey + ":" + HexBlockNum(blockNum+1)
+
+	err = fdb.store.ScanTabletRows(ctx, firstRowKey, lastRowKey, func(key string, value []byte) error {
+		_, rowBlockNum, primaryKey, err := explodeWritableRowKey(key)
+		if err != nil {
+			return fmt.Errorf("couldn't parse row key %q: %w", key, err)
+		}
+
+		stats.apply(rowBlockNum, primaryKey, value)
+		return nil
+	})
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to read rows for table key %q", tableKey)
+	}
+
+	zlog.Debug("handling speculative writes", zap.Int("write_count", len(speculativeWrites)))
+	for _, blockWrite := range speculativeWrites {
+		for _, row := range blockWrite.TableDatas {
+			if row.Account != account || row.Scope != scope || row.Table != table {
+				continue
+			}
+
+			var value []byte
+			if !row.Deletion {
+				value = row.buildData()
+			}
+
+			stats.apply(blockWrite.BlockNum, row.primKey(), value)
+		}
+	}
+
+	if err := stats.finish(ctx); err != nil {
+		return nil, derr.Wrapf(err, "unable to compute stats for table key %q", tableKey)
+	}
+
+	return &TableStats{
+		Account:          account,
+		Scope:            scope,
+		Table:            table,
+		RowCount:         uint64(index.RowCount),
+		PayloadByteCount: index.PayloadByteCount,
+	}, nil
+}
+
+// ReadAccountTableStats returns the stats of every contract table of the account
+// holding at least one row at `blockNum`, sorted by table and then by scope.
+func (fdb *FluxDB) ReadAccountTableStats(ctx context.Context, blockNum uint32, account uint64, speculativeWrites []*WriteRequest) (out []*TableStats, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "read account table stats", "block_num", blockNum)
+	defer span.End()
+
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("reading account table stats", zap.String("account", NameToString(account)), zap.Uint32("block_num", blockNum))
+
+	type tableID struct{ scope, table uint64 }
+	tables := map[tableID]bool{}
+
+	// Rows of the account's tables all start with `td:<account>:`, and `;` follows `:`
+	accountKey := fmt.Sprintf("td:%016x", account)
+	startKey, endKey := accountKey+":", accountKey+";"
+	for {
+		tableKey := ""
+		err := fdb.store.ScanTabletRows(ctx, startKey, endKey, func(key string, _ []byte) error {
+			var err error
+			tableKey, _, _, err = explodeWritableRowKey(key)
+			if err != nil {
+				return fmt.Errorf("couldn't parse row key %q: %w", key, err)
+			}
+
+			return store.BreakScan
+		})
+		if err != nil && err != store.BreakScan {
+			return nil, derr.Wrap(err, "finding next table")
+		}
+
+		if tableKey == "" {
+			break
+		}
+
+		table, scope, err := explodeTableDataTableKey(tableKey)
+		if err != nil {
+			return nil, err
+		}
+
+		tables[tableID{scope, table}] = true
+		startKey = tableKey + ";"
+	}
+
+	for _, blockWrite := range speculativeWrites {
+		for _, row := range blockWrite.TableDatas {
+			if row.Account == account {
+				tables[tableID{row.Scope, row.Table}] = true
+			}
+		}
+	}
+
+	zlog.Debug("reading stats of account tables", zap.Int("table_count", len(tables)))
+	for id := range tables {
+		stats, err := fdb.ReadTableStats(ctx, blockNum, account, id.scope, id.table, speculativeWrites)
+		if err != nil {
+			return nil, err
+		}
+
+		// The table has rows at some other block, but none at the requested one
+		if stats.RowCount == 0 {
+			continue
+		}
+
+		out = append(out, stats)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Table == out[j].Table {
+			return out[i].Scope < out[j].Scope
+		}
+
+		return out[i].Table < out[j].Table
+	})
+
+	return out, nil
+}
+
+// explodeTableDataTableKey extracts the table and the scope out of a table data
+// table key, in the form `td:<account>:<table>:<scope>`.
+func explodeTableDataTableKey(tableKey string) (table, scope uint64, err error) {
+	parts := strings.Split(tableKey, ":")
+	if len(parts) != 4 {
+		return 0, 0, fmt.Errorf("table data table key should have 4 parts, got %d", len(parts))
+	}
+
+	if table, err = strconv.ParseUint(parts[2], 16, 64); err != nil {
+		return 0, 0, derr.Wrapf(err, "invalid table in table key %q", tableKey)
+	}
+
+	if scope, err = strconv.ParseUint(parts[3], 16, 64); err != nil {
+		return 0, 0, derr.Wrapf(err, "invalid scope in table key %q", tableKey)
+	}
+
+	return table, scope, nil
+}
+
+// indexStatsUpdater applies row writes to a table index while keeping its
+// `RowCount` and `PayloadByteCount` in sync. The size of a row version being
+// overwritten is only known once fetched from the store, so those versions are
+// gathered while writes are applied and all fetched at once by `finish`.
+type indexStatsUpdater struct {
+	fdb      *FluxDB
+	tableKey string
+	index    *TableIndex
+
+	// Whether the index stats could be trusted before any write was applied, the
+	// index map no longer tells once rows are added or removed.
+	hadStats bool
+
+	// Block num of the indexed version of each row, recorded before the first
+	// write to the row.
+	replaced map[string]uint32
+
+	// Byte count of the latest version of each row written, -1 for a deletion.
+	written map[string]int
+}
+
+func newIndexStatsUpdater(fdb *FluxDB, tableKey string, index *TableIndex) *indexStatsUpdater {
+	return &indexStatsUpdater{
+		fdb:      fdb,
+		tableKey: tableKey,
+		index:    index,
+		hadStats: index.hasStats(),
+		replaced: map[string]uint32{},
+		written:  map[string]int{},
+	}
+}
+
+// apply records a row write, an empty value being a deletion.
+func (u *indexStatsUpdater) apply(blockNum uint32, primaryKey string, value []byte) {
+	if _, found := u.written[primaryKey]; !found {
+		if indexedBlockNum, found := u.index.Map[primaryKey]; found {
+			u.replaced[primaryKey] = indexedBlockNum
+		}
+	}
+
+	if len(value) == 0 {
+		delete(u.index.Map, primaryKey)
+		u.written[primaryKey] = -1
+		return
+	}
+
+	u.index.Map[primaryKey] = blockNum
+	u.written[primaryKey] = len(value)
+}
+
+// finish updates the index stats with the writes applied. When the index had no
+// stats to start with, the size of every row it maps needs to be fetched.
+func (u *indexStatsUpdater) finish(ctx context.Context) error {
+	zlog := logging.Logger(ctx, zlog)
+
+	hadStats := u.hadStats
+	if !hadStats {
+		zlog.Debug("index has no stats, computing them from all its rows", zap.String("table_key", u.tableKey), zap.Int("row_count", len(u.index.Map)))
+
+		rows := map[string]uint32{}
+		for primaryKey, blockNum := range u.index.Map {
+			if _, found := u.written[primaryKey]; !found {
+				rows[primaryKey] = blockNum
+			}
+		}
+
+		u.replaced = rows
+		u.index.PayloadByteCount = 0
+	}
+
+	replacedByteCount, err := u.fetchByteCount(ctx, u.replaced)
+	if err != nil {
+		return err
+	}
+
+	byteCount := int64(u.index.PayloadByteCount)
+	if hadStats {
+		byteCount -= int64(replacedByteCount)
+	} else {
+		byteCount += int64(replacedByteCount)
+	}
+
+	for _, writtenByteCount := range u.written {
+		if writtenByteCount > 0 {
+			byteCount += int64(writtenByteCount)
+		}
+	}
+
+	if byteCount < 0 {
+		return fmt.Errorf("payload byte count of table key %q went negative (%d), index stats are inconsistent", u.tableKey, byteCount)
+	}
+
+	u.index.RowCount = uint32(len(u.index.Map))
+	u.index.PayloadByteCount = uint64(byteCount)
+
+	u.hadStats = true
+	u.replaced = map[string]uint32{}
+	u.written = map[string]int{}
+
+	return nil
+}
+
+func (u *indexStatsUpdater) fetchByteCount(ctx context.Context, rows map[string]uint32) (byteCount uint64, err error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	keys := make([]string, 0, len(rows))
+	for primaryKey, blockNum := range rows {
+		keys = append(keys, fmt.Sprintf("%s:%08x:%s", u.tableKey, blockNum, primaryKey))
+	}
+
+	// Same batching as when reading an index, a single fetch of too many keys could blow up
+	chunkSize := 5000
+	for chunkStart := 0; chunkStart < len(keys); chunkStart += chunkSize {
+		chunkEnd := chunkStart + chunkSize
+		if chunkEnd > len(keys) {
+			chunkEnd = len(keys)
+		}
+
+		fetchedCount := 0
+		err := u.fdb.store.FetchTabletRows(ctx, keys[chunkStart:chunkEnd], func(rowKey string, value []byte) error {
+			byteCount += uint64(len(value))
+			fetchedCount++
+			return nil
+		})
+		if err != nil {
+			return 0, derr.Wrap(err, "fetching indexed rows")
+		}
+
+		if fetchedCount != chunkEnd-chunkStart {
+			return 0, fmt.Errorf("fetching %d indexed rows of table key %q yielded only %d rows", chunkEnd-chunkStart, u.tableKey, fetchedCount)
+		}
+	}
+
+	return byteCount, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadTableStats(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	ctx := context.Background()
+	account, scope, table, otherTable := N("eosio"), N("eosio"), N("voters"), N("global")
+	tableKey := (&TableDataRow{Account: account, Scope: scope, Table: table}).tableKey()
+
+	executeWriteRequests(t, db,
+		tableDataRows(2,
+			&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01, 0x02}},
+			&TableDataRow{account, scope, table, 2, 5, 0, false, []byte{0x01}},
+			&TableDataRow{account, scope, otherTable, 1, 5, 0, false, []byte{0x01, 0x02, 0x03}},
+		),
+		tableDataRows(3,
+			&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01, 0x02, 0x03, 0x04}},
+			&TableDataRow{account, scope, table, 3, 5, 0, false, []byte{0x01}},
+		),
+	)
+
+	db.idxCache.ScheduleIndex(tableKey, 3)
+	require.NoError(t, db.IndexTables(ctx))
+
+	index, err := db.getIndex(ctx, tableKey, 3)
+	require.NoError(t, err)
+	require.NotNil(t, index)
+	assert.Equal(t, uint32(3), index.RowCount)
+	assert.Equal(t, uint64(12+9+9), index.PayloadByteCount)
+
+	executeWriteRequests(t, db,
+		tableDataRows(4,
+			&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01}},
+			&TableDataRow{account, scope, table, 2, 0, 0, true, nil},
+		),
+	)
+
+	stats, err := db.ReadTableStats(ctx, 4, account, scope, table, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &TableStats{account, scope, table, 2, 9 + 9}, stats)
+
+	stats, err = db.ReadTableStats(ctx, 2, account, scope, table, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &TableStats{account, scope, table, 2, 10 + 9}, stats)
+
+	speculativeWrites := []*WriteRequest{
+		tableDataRows(5, &TableDataRow{account, scope, table, 4, 5, 0, false, []byte{0x01, 0x02}}),
+	}
+
+	stats, err = db.ReadTableStats(ctx, 4, account, scope, table, speculativeWrites)
+	require.NoError(t, err)
+	assert.Equal(t, &TableStats{account, scope, table, 3, 9 + 9 + 10}, stats)
+
+	// Indexing again only fetches the replaced row versions, the outcome must match a full read
+	fetching := &fetchRecordingKVStore{KVStore: db.store}
+	db.store = fetching
+	db.idxCache.ScheduleIndex(tableKey, 4)
+	require.NoError(t, db.IndexTables(ctx))
+	assert.ElementsMatch(t, []string{
+		tableKey + ":00000003:0000000000000001",
+		tableKey + ":00000002:0000000000000002",
+	}, fetching.fetchedKeys)
+
+	index, err = db.getIndex(ctx, tableKey, 4)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4), index.AtBlockNum)
+	assert.Equal(t, uint32(2), index.RowCount)
+	assert.Equal(t, uint64(9+9), index.PayloadByteCount)
+
+	accountStats, err := db.ReadAccountTableStats(ctx, 4, account, speculativeWrites)
+	require.NoError(t, err)
+	assert.Equal(t, []*TableStats{
+		{account, scope, otherTable, 1, 11},
+		{account, scope, table, 3, 9 + 9 + 10},
+	}, accountStats)
+}
+
+func TestReadTableStats_IndexWithoutStats(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	ctx := context.Background()
+	account, scope, table := N("eosio"), N("eosio"), N("voters")
+	tableKey := (&TableDataRow{Account: account, Scope: scope, Table: table}).tableKey()
+
+	executeWriteRequests(t, db,
+		tableDataRows(2,
+			&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01, 0x02}},
+			&TableDataRow{account, scope, table, 2, 5, 0, false, []byte{0x01}},
+		),
+	)
+
+	// An index written before stats were tracked has zeroes in place of them
+	index := &TableIndex{AtBlockNum: 2, Squelched: 2, Map: map[string]uint32{
+		"0000000000000001": 2,
+		"0000000000000002": 2,
+	}}
+	snapshot, err := index.MarshalBinary(ctx, tableKey)
+	require.NoError(t, err)
+
+	batch := db.store.NewBatch(zlog)
+	batch.SetIndex(tableKey+":"+HexRevBlockNum(2), snapshot)
+	require.NoError(t, batch.Flush(ctx))
+
+	executeWriteRequests(t, db,
+		tableDataRows(3, &TableDataRow{account, scope, table, 2, 0, 0, true, nil}),
+	)
+
+	stats, err := db.ReadTableStats(ctx, 3, account, scope, table, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &TableStats{account, scope, table, 1, 10}, stats)
+}
+
+func TestIndexTables_IndexWithoutStatsAllRowsDeleted(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	ctx := context.Background()
+	account, scope, table := N("eosio"), N("eosio"), N("voters")
+	tableKey := (&TableDataRow{Account: account, Scope: scope, Table: table}).tableKey()
+
+	executeWriteRequests(t, db,
+		tableDataRows(2,
+			&TableDataRow{account, scope, table, 1, 5, 0, false, []byte{0x01, 0x02}},
+			&TableDataRow{account, scope, table, 2, 5, 0, false, []byte{0x01}},
+		),
+	)
+
+	// An index written before stats were tracked, all its rows are then deleted
+	index := &TableIndex{AtBlockNum: 2, Squelched: 2, Map: map[string]uint32{
+		"0000000000000001": 2,
+		"0000000000000002": 2,
+	}}
+	snapshot, err := index.MarshalBinary(ctx, tableKey)
+	require.NoError(t, err)
+
+	batch := db.store.NewBatch(zlog)
+	batch.SetIndex(tableKey+":"+HexRevBlockNum(2), snapshot)
+	require.NoError(t, batch.Flush(ctx))
+
+	executeWriteRequests(t, db,
+		tableDataRows(3,
+			&TableDataRow{account, scope, table, 1, 0, 0, true, nil},
+			&TableDataRow{account, scope, table, 2, 0, 0, true, nil},
+		),
+	)
+
+	db.idxCache.ScheduleIndex(tableKey, 3)
+	require.NoError(t, db.IndexTables(ctx))
+
+	index, err = db.getIndex(ctx, tableKey, 3)
+	require.NoError(t, err)
+	require.NotNil(t, index)
+	assert.Equal(t, uint32(3), index.AtBlockNum)
+	assert.Equal(t, uint32(0), index.RowCount)
+	assert.Equal(t, uint64(0), index.PayloadByteCount)
+
+	stats, err := db.ReadTableStats(ctx, 3, account, scope, table, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &TableStats{account, scope, table, 0, 0}, stats)
+}
+
+// fetchRecordingKVStore records the keys of the tablet rows fetched by key
+type fetchRecordingKVStore struct {
+	store.KVStore
+
+	fetchedKeys []string
+}
+
+func (s *fetchRecordingKVStore) FetchTabletRows(ctx context.Context, keys []string, onTabletRow store.OnTabletRow) error {
+	s.fetchedKeys = append(s.fetchedKeys, keys...)
+	return s.KVStore.FetchTabletRows(ctx, keys, onTabletRow)
+}
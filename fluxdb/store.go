@@ -21,7 +21,9 @@ import (
 
 	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
 	"github.com/dfuse-io/dfuse-eosio/fluxdb/store/bigt"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store/hidalgo"
 	"github.com/dfuse-io/dfuse-eosio/fluxdb/store/kv"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store/memory"
 	"go.uber.org/zap"
 )
 
@@ -45,6 +47,10 @@ func NewKVStore(dsnString string) (store.KVStore, error) {
 		return bigt.NewKVStore(ctx, dsnString)
 	case "badger", "tikv", "bigkv":
 		return kv.NewStore(ctx, dsnString)
+	case "bbolt":
+		return hidalgo.NewKVStore(ctx, dsnString)
+	case "memory":
+		return memory.NewKVStore(ctx, dsnString)
 	default:
 		return nil, fmt.Errorf("unknown scheme %q from dsn %q", dsn.Scheme, dsnString)
 	}
@@ -33,10 +33,11 @@ type KVStore struct {
 	client      *bigtable.Client
 	tablePrefix string
 
-	tblRows  *bigtable.Table
-	tblIndex *bigtable.Table
-	tblABIs  *bigtable.Table
-	tblLast  *bigtable.Table
+	tblRows    *bigtable.Table
+	tblIndex   *bigtable.Table
+	tblABIs    *bigtable.Table
+	tblLast    *bigtable.Table
+	tblMarkers *bigtable.Table
 }
 
 func NewKVStore(ctx context.Context, dsnString string, opts ...option.ClientOption) (*KVStore, error) {
@@ -65,17 +66,20 @@ func NewKVStore(ctx context.Context, dsnString string, opts ...option.ClientOpti
 	fdb.tblABIs = client.Open(tblABIs)
 	tblLast := fmt.Sprintf("flux-%s-last", dsn.TablePrefix)
 	fdb.tblLast = client.Open(tblLast)
+	tblMarkers := fmt.Sprintf("flux-%s-markers", dsn.TablePrefix)
+	fdb.tblMarkers = client.Open(tblMarkers)
 
 	if dsn.CreateTables {
 		adminClient, err := bigtable.NewAdminClient(ctx, dsn.Project, dsn.Instance, opts...)
 		if err != nil {
 			zlog.Warn("couldn't do admin tasks", zap.Error(err))
 		} else {
-			zlog.Info("creating tables", zap.Strings("tables", []string{tblRows, tblIndex, tblLast, tblABIs}))
+			zlog.Info("creating tables", zap.Strings("tables", []string{tblRows, tblIndex, tblLast, tblABIs, tblMarkers}))
 			createTable(ctx, adminClient, tblRows, rowFamilyName)
 			createTable(ctx, adminClient, tblIndex, indexFamilyName)
 			createTable(ctx, adminClient, tblLast, lastBlockFamilyName)
 			createTable(ctx, adminClient, tblABIs, abiFamilyName)
+			createTable(ctx, adminClient, tblMarkers, markerFamilyName)
 		}
 	}
 
@@ -86,6 +90,10 @@ func (s *KVStore) Close() error {
 	return s.client.Close()
 }
 
+func (s *KVStore) SupportsDeletions() bool {
+	return true
+}
+
 func (s *KVStore) NewBatch(logger *zap.Logger) store.Batch {
 	return newbatch(s, logger)
 }
@@ -125,6 +133,35 @@ func (s *KVStore) FetchABI(ctx context.Context, prefixKey, keyStart, keyEnd stri
 	return rowKey, rawABI, nil
 }
 
+func (s *KVStore) ScanABIs(ctx context.Context, keyStart, keyEnd string, onABI store.OnABI) error {
+	var err2 error
+	err := s.tblABIs.ReadRows(ctx, bigtable.NewRange(keyStart, keyEnd), func(row bigtable.Row) bool {
+		item, ok := btRowItem(row, abiFamilyName, abiColumnName)
+		if !ok {
+			err2 = fmt.Errorf("expected abi family and column give no data: %q", item)
+			return false
+		}
+
+		err2 = onABI(row.Key(), item.Value)
+		if err2 == store.BreakScan {
+			return false
+		}
+
+		if err2 != nil {
+			err2 = fmt.Errorf("on abi for key %q failed: %w", row.Key(), err2)
+			return false
+		}
+
+		return true
+	})
+
+	if err2 != nil && err2 != store.BreakScan {
+		return err2
+	}
+
+	return err
+}
+
 func (s *KVStore) FetchIndex(ctx context.Context, tableKey, prefixKey, keyStart string) (rowKey string, rawIndex []byte, err error) {
 	var err2 error
 	err = s.tblIndex.ReadRows(ctx, bigtable.InfiniteRange(keyStart), func(row bigtable.Row) bool {
@@ -218,7 +255,7 @@ func (s *KVStore) FetchTabletRows(ctx context.Context, keys []string, onTabletRo
 		return true
 	})
 
-	if err2 != nil {
+	if err2 != nil && err2 != store.BreakScan {
 		return err2
 	}
 
@@ -247,7 +284,7 @@ func (s *KVStore) ScanTabletRows(ctx context.Context, keyStart, keyEnd string, o
 		return true
 	})
 
-	if err2 != nil {
+	if err2 != nil && err2 != store.BreakScan {
 		return err2
 	}
 
@@ -288,13 +325,27 @@ func (s *KVStore) ScanLastShardsWrittenBlock(ctx context.Context, keyPrefix stri
 		return true
 	}, latestCellFilter)
 
-	if err2 != nil {
+	if err2 != nil && err2 != store.BreakScan {
 		return err2
 	}
 
 	return err
 }
 
+func (s *KVStore) FetchMarker(ctx context.Context, key string) ([]byte, error) {
+	row, err := s.tblMarkers.ReadRow(ctx, key, latestCellFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	item, ok := btRowItem(row, markerFamilyName, markerColumnName)
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+
+	return item.Value, nil
+}
+
 type batch struct {
 	store          *KVStore
 	size           int
@@ -313,10 +364,11 @@ func newbatch(store *KVStore, logger *zap.Logger) *batch {
 func (b *batch) Reset() {
 	b.size = 0
 	b.tableMutations = map[string]map[string]*bigtable.Mutation{
-		"abi":   make(map[string]*bigtable.Mutation),
-		"row":   make(map[string]*bigtable.Mutation),
-		"index": make(map[string]*bigtable.Mutation),
-		"last":  make(map[string]*bigtable.Mutation),
+		"marker": make(map[string]*bigtable.Mutation),
+		"abi":    make(map[string]*bigtable.Mutation),
+		"row":    make(map[string]*bigtable.Mutation),
+		"index":  make(map[string]*bigtable.Mutation),
+		"last":   make(map[string]*bigtable.Mutation),
 	}
 }
 
@@ -348,6 +400,9 @@ func (b *batch) Flush(ctx context.Context) error {
 	b.zlog.Info("flushing batch set")
 
 	tableNames := []string{
+		// Markers describe the operation the other mutations are part of, they come first
+		"marker",
+
 		"abi",
 		"row",
 		"index",
@@ -362,6 +417,8 @@ func (b *batch) Flush(ctx context.Context) error {
 
 		var tbl *bigtable.Table
 		switch tblName {
+		case "marker":
+			tbl = b.store.tblMarkers
 		case "abi":
 			tbl = b.store.tblABIs
 		case "row":
@@ -414,6 +471,13 @@ func (b *batch) setTable(table string, key, family, column string, value []byte)
 	b.size += len(value) + 100 /* 100 = overhead */
 }
 
+func (b *batch) deleteFromTable(table string, key string) {
+	mut := bigtable.NewMutation()
+	mut.DeleteRow()
+	b.tableMutations[table][key] = mut
+	b.size += 100 /* 100 = overhead */
+}
+
 func (b *batch) SetABI(key string, value []byte) {
 	b.setTable("abi", key, abiFamilyName, abiColumnName, value)
 }
@@ -430,6 +494,22 @@ func (b *batch) SetIndex(key string, tableSnapshot []byte) {
 	b.setTable("index", key, indexFamilyName, indexColumnName, tableSnapshot)
 }
 
+func (b *batch) SetMarker(key string, value []byte) {
+	b.setTable("marker", key, markerFamilyName, markerColumnName, value)
+}
+
+func (b *batch) DeleteABI(key string) {
+	b.deleteFromTable("abi", key)
+}
+
+func (b *batch) DeleteRow(key string) {
+	b.deleteFromTable("row", key)
+}
+
+func (b *batch) DeleteIndex(key string) {
+	b.deleteFromTable("index", key)
+}
+
 func createTable(ctx context.Context, admin *bigtable.AdminClient, tableName, familyName string) {
 	if err := admin.CreateTable(ctx, tableName); err != nil {
 		zlog.Warn("failed creating table", zap.String("table_name", tableName), zap.Error(err))
@@ -0,0 +1,44 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigt
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigtable/bttest"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store/storetest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+)
+
+func TestAll(t *testing.T) {
+	storetest.TestAll(t, "bigtable", func() (store.KVStore, storetest.StoreCleanupFunc) {
+		srv, err := bttest.NewServer("localhost:0")
+		require.NoError(t, err)
+		conn, err := grpc.Dial(srv.Addr, grpc.WithInsecure())
+		require.NoError(t, err)
+
+		kvStore, err := NewKVStore(context.Background(), "bigtable://dev.dev/test?createTables=true", option.WithGRPCConn(conn))
+		require.NoError(t, err)
+
+		return kvStore, func() {
+			kvStore.Close()
+			srv.Close()
+		}
+	})
+}
@@ -28,6 +28,9 @@ const indexColumnName = "snapshot"
 const lastBlockFamilyName = "state"
 const lastBlockColumnName = "block_id"
 
+const markerFamilyName = "marker"
+const markerColumnName = "value"
+
 var latestCellOnly = bigtable.LatestNFilter(1)
 var latestCellFilter = bigtable.RowFilter(latestCellOnly)
 
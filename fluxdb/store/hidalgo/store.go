@@ -34,10 +34,11 @@ import (
 type KVStore struct {
 	db kv.KV
 
-	tblRows  string
-	tblIndex string
-	tblABIs  string
-	tblLast  string
+	tblRows    string
+	tblIndex   string
+	tblABIs    string
+	tblLast    string
+	tblMarkers string
 }
 
 type KVStoreDsn struct {
@@ -66,15 +67,16 @@ func NewKVStore(ctx context.Context, dsnString string) (*KVStore, error) {
 	}
 
 	store := &KVStore{
-		db:       db,
-		tblRows:  "tablet",
-		tblIndex: "index",
-		tblABIs:  "abi",
-		tblLast:  "block",
+		db:         db,
+		tblRows:    "tablet",
+		tblIndex:   "index",
+		tblABIs:    "abi",
+		tblLast:    "block",
+		tblMarkers: "marker",
 	}
 
 	if kvdns.createTable {
-		tables := []string{store.tblRows, store.tblIndex, store.tblABIs, store.tblLast}
+		tables := []string{store.tblRows, store.tblIndex, store.tblABIs, store.tblLast, store.tblMarkers}
 		zlog.Info("creating buckets", zap.Strings("tables", tables))
 		for _, table := range tables {
 			err := createBucket(ctx, db, table)
@@ -91,6 +93,10 @@ func (s *KVStore) Close() error {
 	return s.db.Close()
 }
 
+func (s *KVStore) SupportsDeletions() bool {
+	return true
+}
+
 func (s *KVStore) NewBatch(logger *zap.Logger) store.Batch {
 	return newBatch(s, logger)
 }
@@ -119,6 +125,27 @@ func (s *KVStore) FetchABI(ctx context.Context, prefixKey, keyStart, keyEnd stri
 	return rowKey, rawABI, nil
 }
 
+func (s *KVStore) ScanABIs(ctx context.Context, keyStart, keyEnd string, onABI store.OnABI) error {
+	err := s.scanRange(ctx, s.tblABIs, keyStart, keyEnd, func(key string, value []byte) error {
+		err := onABI(key, value)
+		if err == store.BreakScan {
+			return store.BreakScan
+		}
+
+		if err != nil {
+			return fmt.Errorf("on abi for key %q failed: %w", key, err)
+		}
+
+		return nil
+	})
+
+	if err != nil && err != store.BreakScan {
+		return fmt.Errorf("unable to scan abis [%q, %q[: %w", keyStart, keyEnd, err)
+	}
+
+	return nil
+}
+
 func (s *KVStore) FetchIndex(ctx context.Context, tableKey, prefixKey, keyStart string) (rowKey string, rawIndex []byte, err error) {
 	err = s.scanInfiniteRange(ctx, s.tblIndex, keyStart, func(key string, value []byte) error {
 		if !strings.HasPrefix(key, prefixKey) {
@@ -246,6 +273,10 @@ func (s *KVStore) ScanLastShardsWrittenBlock(ctx context.Context, keyPrefix stri
 	return nil
 }
 
+func (s *KVStore) FetchMarker(ctx context.Context, key string) (value []byte, err error) {
+	return s.fetchKey(ctx, s.tblMarkers, key)
+}
+
 func (s *KVStore) fetchKey(ctx context.Context, table, key string) (out []byte, err error) {
 	err = kv.View(s.db, func(tx kv.Tx) error {
 		out, err = tx.Get(ctx, kv.SKey(table, key))
@@ -320,7 +351,7 @@ func (s *KVStore) scanRange(ctx context.Context, table, keyStart, keyEnd string,
 		c := tx.Bucket([]byte(table)).Cursor()
 
 		zlog.Info("scanning range cursor")
-		for k, v := c.Seek(min); k != nil && (openEnded || bytes.Compare(k, max) <= 0); k, v = c.Next() {
+		for k, v := c.Seek(min); k != nil && (openEnded || bytes.Compare(k, max) < 0); k, v = c.Next() {
 			zlog.Info("got key scanned", zap.String("key", string(k)))
 			err := onRow(string(k), v)
 			if err != nil {
@@ -383,6 +414,7 @@ type batch struct {
 	store          *KVStore
 	count          int
 	tableMutations map[string]map[string][]byte
+	tableDeletions map[string]map[string]bool
 
 	zlog *zap.Logger
 }
@@ -397,10 +429,18 @@ func newBatch(store *KVStore, logger *zap.Logger) *batch {
 func (b *batch) Reset() {
 	b.count = 0
 	b.tableMutations = map[string]map[string][]byte{
-		b.store.tblABIs:  make(map[string][]byte),
-		b.store.tblRows:  make(map[string][]byte),
-		b.store.tblIndex: make(map[string][]byte),
-		b.store.tblLast:  make(map[string][]byte),
+		b.store.tblMarkers: make(map[string][]byte),
+		b.store.tblABIs:    make(map[string][]byte),
+		b.store.tblRows:    make(map[string][]byte),
+		b.store.tblIndex:   make(map[string][]byte),
+		b.store.tblLast:    make(map[string][]byte),
+	}
+	b.tableDeletions = map[string]map[string]bool{
+		b.store.tblMarkers: make(map[string]bool),
+		b.store.tblABIs:    make(map[string]bool),
+		b.store.tblRows:    make(map[string]bool),
+		b.store.tblIndex:   make(map[string]bool),
+		b.store.tblLast:    make(map[string]bool),
 	}
 }
 
@@ -430,6 +470,9 @@ func (b *batch) Flush(ctx context.Context) error {
 	b.zlog.Info("flushing batch set")
 
 	tableNames := []string{
+		// Markers describe the operation the other mutations are part of, they come first
+		b.store.tblMarkers,
+
 		b.store.tblABIs,
 		b.store.tblRows,
 		b.store.tblIndex,
@@ -441,13 +484,14 @@ func (b *batch) Flush(ctx context.Context) error {
 	// TODO: We could eventually parallelize this, but remember, last would need to be processed last, after all others!
 	for _, tblName := range tableNames {
 		muts := b.tableMutations[tblName]
+		deletions := b.tableDeletions[tblName]
 
-		if len(muts) <= 0 {
+		if len(muts) <= 0 && len(deletions) <= 0 {
 			continue
 		}
 
-		b.zlog.Info("applying bulk update", zap.String("table_name", tblName), zap.Int("mutation_count", len(muts)))
-		ctx, span := dtracing.StartSpan(ctx, "apply bulk updates", "table", tblName, "mutation_count", len(muts))
+		b.zlog.Info("applying bulk update", zap.String("table_name", tblName), zap.Int("mutation_count", len(muts)), zap.Int("deletion_count", len(deletions)))
+		ctx, span := dtracing.StartSpan(ctx, "apply bulk updates", "table", tblName, "mutation_count", len(muts), "deletion_count", len(deletions))
 
 		err := kv.Update(ctx, b.store.db, func(tx kv.Tx) error {
 			for key, value := range muts {
@@ -457,6 +501,13 @@ func (b *batch) Flush(ctx context.Context) error {
 				}
 			}
 
+			for key := range deletions {
+				err := tx.Del(kv.SKey(tblName, key))
+				if err != nil {
+					return fmt.Errorf("unable to delete table %q key %q in tx: %w", tblName, key, err)
+				}
+			}
+
 			return nil
 		})
 		span.End()
@@ -473,6 +524,13 @@ func (b *batch) Flush(ctx context.Context) error {
 
 func (b *batch) setTable(table, key string, value []byte) {
 	b.tableMutations[table][key] = value
+	delete(b.tableDeletions[table], key)
+	b.count++
+}
+
+func (b *batch) deleteFromTable(table, key string) {
+	b.tableDeletions[table][key] = true
+	delete(b.tableMutations[table], key)
 	b.count++
 }
 
@@ -492,6 +550,22 @@ func (b *batch) SetIndex(key string, tableSnapshot []byte) {
 	b.setTable(b.store.tblIndex, key, tableSnapshot)
 }
 
+func (b *batch) SetMarker(key string, value []byte) {
+	b.setTable(b.store.tblMarkers, key, value)
+}
+
+func (b *batch) DeleteABI(key string) {
+	b.deleteFromTable(b.store.tblABIs, key)
+}
+
+func (b *batch) DeleteRow(key string) {
+	b.deleteFromTable(b.store.tblRows, key)
+}
+
+func (b *batch) DeleteIndex(key string) {
+	b.deleteFromTable(b.store.tblIndex, key)
+}
+
 func createBucket(ctx context.Context, db kv.KV, table string) error {
 	err := kv.Update(ctx, db, func(tx kv.Tx) error {
 		return kv.CreateBucket(ctx, tx, kv.SKey(table))
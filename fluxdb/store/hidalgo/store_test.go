@@ -18,9 +18,13 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path"
 	"testing"
 
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store/storetest"
 	"github.com/dfuse-io/logging"
 	"github.com/eoscanada/eos-go"
 	"github.com/hidal-go/hidalgo/kv"
@@ -41,6 +45,21 @@ func init() {
 	testHidalgoDatabasePath = os.Getenv("HIDALGO_DB_PATH")
 }
 
+func TestAll(t *testing.T) {
+	storetest.TestAll(t, "bbolt", func() (store.KVStore, storetest.StoreCleanupFunc) {
+		dir, err := ioutil.TempDir("", "fluxdb-hidalgo")
+		require.NoError(t, err)
+
+		kvStore, err := NewKVStore(context.Background(), fmt.Sprintf("bbolt://%s?createTables=true", path.Join(dir, "db.bbolt")))
+		require.NoError(t, err)
+
+		return kvStore, func() {
+			kvStore.Close()
+			os.RemoveAll(dir)
+		}
+	})
+}
+
 func Test_PrintAllKeys(t *testing.T) {
 	runOnlyIfPathIsSet(t)
 
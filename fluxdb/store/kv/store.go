@@ -29,17 +29,19 @@ import (
 )
 
 var TblPrefixName = map[byte]string{
-	tblPrefixRows:  "tablet",
-	tblPrefixIndex: "index",
-	tblPrefixABIs:  "abi",
-	tblPrefixLast:  "block",
+	tblPrefixRows:    "tablet",
+	tblPrefixIndex:   "index",
+	tblPrefixABIs:    "abi",
+	tblPrefixLast:    "block",
+	tblPrefixMarkers: "marker",
 }
 
 const (
-	tblPrefixRows  = 0x00
-	tblPrefixIndex = 0x01
-	tblPrefixABIs  = 0x02
-	tblPrefixLast  = 0x03
+	tblPrefixRows    = 0x00
+	tblPrefixIndex   = 0x01
+	tblPrefixABIs    = 0x02
+	tblPrefixLast    = 0x03
+	tblPrefixMarkers = 0x04
 )
 
 var TableMapper = map[byte]string{}
@@ -67,6 +69,13 @@ func (s *KVStore) Close() error {
 	return nil
 }
 
+// SupportsDeletions is true only when the kvdb store is able to delete keys, none of
+// them are at the current kvdb version.
+func (s *KVStore) SupportsDeletions() bool {
+	_, ok := s.db.(batchDeleter)
+	return ok
+}
+
 func (s *KVStore) NewBatch(logger *zap.Logger) store.Batch {
 	return newBatch(s, logger)
 }
@@ -95,6 +104,27 @@ func (s *KVStore) FetchABI(ctx context.Context, prefixKey, keyStart, keyEnd stri
 	return rowKey, rawABI, nil
 }
 
+func (s *KVStore) ScanABIs(ctx context.Context, keyStart, keyEnd string, onABI store.OnABI) error {
+	err := s.scanRange(ctx, tblPrefixABIs, keyStart, keyEnd, func(key string, value []byte) error {
+		err := onABI(key, value)
+		if err == store.BreakScan {
+			return store.BreakScan
+		}
+
+		if err != nil {
+			return fmt.Errorf("on abi for key %q failed: %w", key, err)
+		}
+
+		return nil
+	})
+
+	if err != nil && err != store.BreakScan {
+		return fmt.Errorf("unable to scan abis [%q, %q[: %w", keyStart, keyEnd, err)
+	}
+
+	return nil
+}
+
 func (s *KVStore) FetchIndex(ctx context.Context, tableKey, prefixKey, keyStart string) (rowKey string, rawIndex []byte, err error) {
 	err = s.scanInfiniteRange(ctx, tblPrefixIndex, keyStart, func(key string, value []byte) error {
 		if !strings.HasPrefix(key, prefixKey) {
@@ -223,6 +253,11 @@ func (s *KVStore) ScanLastShardsWrittenBlock(ctx context.Context, keyPrefix stri
 	return nil
 }
 
+func (s *KVStore) FetchMarker(ctx context.Context, key string) (value []byte, err error) {
+	zlog.Debug("fetching marker", zap.String("key", key))
+	return s.fetchKey(ctx, tblPrefixMarkers, key)
+}
+
 func (s *KVStore) fetchKey(ctx context.Context, table byte, key string) (out []byte, err error) {
 
 	kvKey := packKey(table, key)
@@ -246,19 +281,40 @@ func (s *KVStore) fetchKeys(ctx context.Context, table byte, keys []string) (out
 		kvKeys[i] = packKey(table, key)
 	}
 
-	var values []*kv.KV
+	valuesByKey := map[string][]byte{}
 	itr := s.db.BatchGet(ctx, kvKeys)
 
 	for itr.Next() {
-		values = append(values, itr.Item())
+		item := itr.Item()
+		_, key := unpackKey(item.Key)
+		valuesByKey[key] = item.Value[1:]
 	}
 	if err := itr.Err(); err != nil {
-		return nil, fmt.Errorf("unable to fetch table %q keys (%d): %w", TblPrefixName[table], len(keys), err)
+		// The batch get stops at the first key not found, with an error specific to the
+		// engine, the keys left are then fetched one by one, skipping the ones not found
+		zlog.Debug("batch get interrupted, fetching keys left one by one", zap.Int("fetched_count", len(valuesByKey)), zap.Error(err))
+		for _, key := range keys {
+			if _, found := valuesByKey[key]; found {
+				continue
+			}
+
+			value, err := s.fetchKey(ctx, table, key)
+			if err == store.ErrNotFound {
+				continue
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch table %q keys (%d): %w", TblPrefixName[table], len(keys), err)
+			}
+
+			valuesByKey[key] = value
+		}
 	}
 
-	out = make([][]byte, len(values))
-	for i, value := range values {
-		out[i] = value.Value[1:]
+	// Values are aligned on the requested keys, a key not found has a `nil` value
+	out = make([][]byte, len(keys))
+	for i, key := range keys {
+		out[i] = valuesByKey[key]
 	}
 
 	return out, nil
@@ -326,6 +382,11 @@ func (s *KVStore) scanInfiniteRange(ctx context.Context, table byte, keyStart st
 	return s.scanRange(ctx, table, keyStart, "", onRow)
 }
 
+// batchDeleter is implemented by the kvdb stores able to delete keys, not all of them are
+type batchDeleter interface {
+	BatchDelete(ctx context.Context, keys [][]byte) error
+}
+
 // There is most probably lots of repetition between this batch and the bigtable version.
 // We should most probably improve the sharing by having a `baseBatch` struct or something
 // like that.
@@ -333,6 +394,7 @@ type batch struct {
 	store          *KVStore
 	count          int
 	tableMutations map[byte]map[string][]byte
+	tableDeletions map[byte]map[string]bool
 
 	zlog *zap.Logger
 }
@@ -347,10 +409,18 @@ func newBatch(store *KVStore, logger *zap.Logger) *batch {
 func (b *batch) Reset() {
 	b.count = 0
 	b.tableMutations = map[byte]map[string][]byte{
-		tblPrefixABIs:  make(map[string][]byte),
-		tblPrefixRows:  make(map[string][]byte),
-		tblPrefixIndex: make(map[string][]byte),
-		tblPrefixLast:  make(map[string][]byte),
+		tblPrefixMarkers: make(map[string][]byte),
+		tblPrefixABIs:    make(map[string][]byte),
+		tblPrefixRows:    make(map[string][]byte),
+		tblPrefixIndex:   make(map[string][]byte),
+		tblPrefixLast:    make(map[string][]byte),
+	}
+	b.tableDeletions = map[byte]map[string]bool{
+		tblPrefixMarkers: make(map[string]bool),
+		tblPrefixABIs:    make(map[string]bool),
+		tblPrefixRows:    make(map[string]bool),
+		tblPrefixIndex:   make(map[string]bool),
+		tblPrefixLast:    make(map[string]bool),
 	}
 }
 
@@ -380,6 +450,9 @@ func (b *batch) Flush(ctx context.Context) error {
 	b.zlog.Info("flushing batch set")
 
 	tableNames := []byte{
+		// Markers describe the operation the other mutations are part of, they come first
+		tblPrefixMarkers,
+
 		tblPrefixABIs,
 		tblPrefixRows,
 		tblPrefixIndex,
@@ -388,6 +461,33 @@ func (b *batch) Flush(ctx context.Context) error {
 		tblPrefixLast,
 	}
 
+	deleter, _ := b.store.db.(batchDeleter)
+	if deleter == nil {
+		for _, tblName := range tableNames {
+			if len(b.tableDeletions[tblName]) > 0 {
+				return store.ErrDeletionsNotSupported
+			}
+		}
+	}
+
+	// Deleted keys are never part of the puts, so deletions can safely be applied first
+	for _, tblName := range tableNames {
+		deletions := b.tableDeletions[tblName]
+		if len(deletions) <= 0 {
+			continue
+		}
+
+		b.zlog.Info("applying bulk deletion", zap.String("table_name", TblPrefixName[tblName]), zap.Int("deletion_count", len(deletions)))
+		keys := make([][]byte, 0, len(deletions))
+		for key := range deletions {
+			keys = append(keys, packKey(tblName, key))
+		}
+
+		if err := deleter.BatchDelete(ctx, keys); err != nil {
+			return derr.Wrap(err, "apply bulk deletion")
+		}
+	}
+
 	// TODO: We could eventually parallelize this, but remember, last would need to be processed last, after all others!
 	for _, tblName := range tableNames {
 		muts := b.tableMutations[tblName]
@@ -421,6 +521,13 @@ func (b *batch) Flush(ctx context.Context) error {
 
 func (b *batch) setTable(table byte, key string, value []byte) {
 	b.tableMutations[table][key] = value
+	delete(b.tableDeletions[table], key)
+	b.count++
+}
+
+func (b *batch) deleteFromTable(table byte, key string) {
+	b.tableDeletions[table][key] = true
+	delete(b.tableMutations[table], key)
 	b.count++
 }
 
@@ -440,6 +547,22 @@ func (b *batch) SetIndex(key string, tableSnapshot []byte) {
 	b.setTable(tblPrefixIndex, key, tableSnapshot)
 }
 
+func (b *batch) SetMarker(key string, value []byte) {
+	b.setTable(tblPrefixMarkers, key, value)
+}
+
+func (b *batch) DeleteABI(key string) {
+	b.deleteFromTable(tblPrefixABIs, key)
+}
+
+func (b *batch) DeleteRow(key string) {
+	b.deleteFromTable(tblPrefixRows, key)
+}
+
+func (b *batch) DeleteIndex(key string) {
+	b.deleteFromTable(tblPrefixIndex, key)
+}
+
 func packKey(table byte, key string) []byte {
 	return append([]byte{table}, []byte(key)...)
 }
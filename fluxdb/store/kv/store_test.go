@@ -0,0 +1,44 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store/storetest"
+	_ "github.com/dfuse-io/kvdb/store/badger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAll(t *testing.T) {
+	storetest.TestAll(t, "badger", func() (store.KVStore, storetest.StoreCleanupFunc) {
+		dir, err := ioutil.TempDir("", "fluxdb-badger")
+		require.NoError(t, err)
+
+		kvStore, err := NewStore(context.Background(), fmt.Sprintf("badger://%s", path.Join(dir, "flux.db")))
+		require.NoError(t, err)
+
+		return kvStore, func() {
+			kvStore.Close()
+			os.RemoveAll(dir)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+type batch struct {
+	store          *KVStore
+	count          int
+	tableMutations map[string]map[string][]byte
+	tableDeletions map[string]map[string]bool
+
+	zlog *zap.Logger
+}
+
+func newBatch(store *KVStore, logger *zap.Logger) *batch {
+	batchSet := &batch{store: store, zlog: logger}
+	batchSet.Reset()
+
+	return batchSet
+}
+
+func (b *batch) Reset() {
+	b.count = 0
+	b.tableMutations = map[string]map[string][]byte{
+		tblABIs:    make(map[string][]byte),
+		tblRows:    make(map[string][]byte),
+		tblIndex:   make(map[string][]byte),
+		tblLast:    make(map[string][]byte),
+		tblMarkers: make(map[string][]byte),
+	}
+	b.tableDeletions = map[string]map[string]bool{
+		tblABIs:    make(map[string]bool),
+		tblRows:    make(map[string]bool),
+		tblIndex:   make(map[string]bool),
+		tblLast:    make(map[string]bool),
+		tblMarkers: make(map[string]bool),
+	}
+}
+
+// Everything is kept in memory, there is no benefit in keeping a large
+// batch around, so we flush as soon as a few mutations are pending.
+var maxMutationCount = 100
+
+func (b *batch) FlushIfFull(ctx context.Context) error {
+	if b.count <= maxMutationCount {
+		// We are not there yet
+		return nil
+	}
+
+	b.zlog.Debug("flushing a full batch set", zap.Int("count", b.count))
+	return b.Flush(ctx)
+}
+
+// Flush applies all pending mutations atomically, readers never see a partially
+// applied batch.
+func (b *batch) Flush(ctx context.Context) error {
+	b.zlog.Debug("flushing batch set", zap.Int("count", b.count))
+
+	b.store.lock.Lock()
+	for tblName, muts := range b.tableMutations {
+		table := b.store.tables[tblName]
+		for key, value := range muts {
+			table[key] = value
+		}
+
+		for key := range b.tableDeletions[tblName] {
+			delete(table, key)
+		}
+	}
+	b.store.lock.Unlock()
+
+	b.Reset()
+
+	return nil
+}
+
+func (b *batch) setTable(table, key string, value []byte) {
+	b.tableMutations[table][key] = copyBytes(value)
+	delete(b.tableDeletions[table], key)
+	b.count++
+}
+
+func (b *batch) deleteFromTable(table, key string) {
+	b.tableDeletions[table][key] = true
+	delete(b.tableMutations[table], key)
+	b.count++
+}
+
+func (b *batch) SetABI(key string, value []byte) {
+	b.setTable(tblABIs, key, value)
+}
+
+func (b *batch) SetRow(key string, value []byte) {
+	b.setTable(tblRows, key, value)
+}
+
+func (b *batch) SetLast(key string, value []byte) {
+	b.setTable(tblLast, key, value)
+}
+
+func (b *batch) SetIndex(key string, tableSnapshot []byte) {
+	b.setTable(tblIndex, key, tableSnapshot)
+}
+
+func (b *batch) SetMarker(key string, value []byte) {
+	b.setTable(tblMarkers, key, value)
+}
+
+func (b *batch) DeleteABI(key string) {
+	b.deleteFromTable(tblABIs, key)
+}
+
+func (b *batch) DeleteRow(key string) {
+	b.deleteFromTable(tblRows, key)
+}
+
+func (b *batch) DeleteIndex(key string) {
+	b.deleteFromTable(tblIndex, key)
+}
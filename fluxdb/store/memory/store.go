@@ -0,0 +1,312 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"go.uber.org/zap"
+)
+
+const (
+	tblRows    = "tablet"
+	tblIndex   = "index"
+	tblABIs    = "abi"
+	tblLast    = "block"
+	tblMarkers = "marker"
+)
+
+// KVStore is a `store.KVStore` keeping everything in memory, nothing is persisted
+// and all data is lost on `Close`. It's meant to be used in unit tests and for short
+// lived local experiments.
+type KVStore struct {
+	lock   sync.RWMutex
+	tables map[string]map[string][]byte
+}
+
+func NewKVStore(ctx context.Context, dsnString string) (*KVStore, error) {
+	zlog.Info("creating in-memory kv store", zap.String("dsn", dsnString))
+
+	return &KVStore{
+		tables: map[string]map[string][]byte{
+			tblRows:    make(map[string][]byte),
+			tblIndex:   make(map[string][]byte),
+			tblABIs:    make(map[string][]byte),
+			tblLast:    make(map[string][]byte),
+			tblMarkers: make(map[string][]byte),
+		},
+	}, nil
+}
+
+func (s *KVStore) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, table := range s.tables {
+		for key := range table {
+			delete(table, key)
+		}
+	}
+
+	return nil
+}
+
+func (s *KVStore) SupportsDeletions() bool {
+	return true
+}
+
+func (s *KVStore) NewBatch(logger *zap.Logger) store.Batch {
+	return newBatch(s, logger)
+}
+
+func (s *KVStore) FetchABI(ctx context.Context, prefixKey, keyStart, keyEnd string) (rowKey string, rawABI []byte, err error) {
+	err = s.scanRange(tblABIs, keyStart, keyEnd, func(key string, value []byte) error {
+		if !strings.HasPrefix(key, prefixKey) {
+			return store.BreakScan
+		}
+
+		rowKey = key
+		rawABI = value
+
+		// We only ever check a single row
+		return store.BreakScan
+	})
+
+	if err != nil && err != store.BreakScan {
+		return "", nil, fmt.Errorf("unable to fetch ABI for key prefix %q: %w", prefixKey, err)
+	}
+
+	if rawABI == nil {
+		return "", nil, store.ErrNotFound
+	}
+
+	return rowKey, rawABI, nil
+}
+
+func (s *KVStore) ScanABIs(ctx context.Context, keyStart, keyEnd string, onABI store.OnABI) error {
+	err := s.scanRange(tblABIs, keyStart, keyEnd, func(key string, value []byte) error {
+		err := onABI(key, value)
+		if err == store.BreakScan {
+			return store.BreakScan
+		}
+
+		if err != nil {
+			return fmt.Errorf("on abi for key %q failed: %w", key, err)
+		}
+
+		return nil
+	})
+
+	if err != nil && err != store.BreakScan {
+		return fmt.Errorf("unable to scan abis [%q, %q[: %w", keyStart, keyEnd, err)
+	}
+
+	return nil
+}
+
+func (s *KVStore) FetchIndex(ctx context.Context, tableKey, prefixKey, keyStart string) (rowKey string, rawIndex []byte, err error) {
+	err = s.scanRange(tblIndex, keyStart, "", func(key string, value []byte) error {
+		if !strings.HasPrefix(key, prefixKey) {
+			return store.BreakScan
+		}
+
+		rowKey = key
+		rawIndex = value
+
+		// We always only check a single row
+		return store.BreakScan
+	})
+
+	if err != nil && err != store.BreakScan {
+		return "", nil, fmt.Errorf("unable to fetch index for key prefix %q: %w", prefixKey, err)
+	}
+
+	if rawIndex == nil {
+		return "", nil, store.ErrNotFound
+	}
+
+	return rowKey, rawIndex, nil
+}
+
+func (s *KVStore) HasTabletRow(ctx context.Context, keyPrefix string) (exists bool, err error) {
+	err = s.scanPrefix(tblRows, keyPrefix, func(key string, _ []byte) error {
+		exists = true
+		return store.BreakScan
+	})
+
+	if err != nil && err != store.BreakScan {
+		return false, fmt.Errorf("unable to determine if table %q has key prefix %q: %w", tblRows, keyPrefix, err)
+	}
+
+	return exists, nil
+}
+
+func (s *KVStore) FetchTabletRow(ctx context.Context, key string, onTabletRow store.OnTabletRow) error {
+	value, found := s.fetchKey(tblRows, key)
+	if !found {
+		return store.ErrNotFound
+	}
+
+	err := onTabletRow(key, value)
+	if err != nil && err != store.BreakScan {
+		return fmt.Errorf("on tablet row for key %q failed: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *KVStore) FetchTabletRows(ctx context.Context, keys []string, onTabletRow store.OnTabletRow) error {
+	for _, key := range keys {
+		value, found := s.fetchKey(tblRows, key)
+		if !found {
+			continue
+		}
+
+		err := onTabletRow(key, value)
+		if err == store.BreakScan {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("on tablet row for key %q failed: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *KVStore) ScanTabletRows(ctx context.Context, keyStart, keyEnd string, onTabletRow store.OnTabletRow) error {
+	err := s.scanRange(tblRows, keyStart, keyEnd, func(key string, value []byte) error {
+		err := onTabletRow(key, value)
+		if err == store.BreakScan {
+			return store.BreakScan
+		}
+
+		if err != nil {
+			return fmt.Errorf("on tablet row for key %q failed: %w", key, err)
+		}
+
+		return nil
+	})
+
+	if err != nil && err != store.BreakScan {
+		return fmt.Errorf("unable to scan tablet rows [%q, %q[: %w", keyStart, keyEnd, err)
+	}
+
+	return nil
+}
+
+func (s *KVStore) FetchLastWrittenBlock(ctx context.Context, key string) (out bstream.BlockRef, err error) {
+	value, found := s.fetchKey(tblLast, key)
+	if !found {
+		return nil, store.ErrNotFound
+	}
+
+	return bstream.BlockRefFromID(string(value)), nil
+}
+
+func (s *KVStore) ScanLastShardsWrittenBlock(ctx context.Context, keyPrefix string, onBlockRef store.OnBlockRef) error {
+	err := s.scanPrefix(tblLast, keyPrefix, func(key string, value []byte) error {
+		err := onBlockRef(key, bstream.BlockRefFromID(string(value)))
+		if err == store.BreakScan {
+			return store.BreakScan
+		}
+
+		if err != nil {
+			return fmt.Errorf("on block ref for table %q key %q failed: %w", tblLast, key, err)
+		}
+
+		return nil
+	})
+
+	if err != nil && err != store.BreakScan {
+		return fmt.Errorf("unable to determine if table %q has key prefix %q: %w", tblLast, keyPrefix, err)
+	}
+
+	return nil
+}
+
+func (s *KVStore) FetchMarker(ctx context.Context, key string) (value []byte, err error) {
+	value, found := s.fetchKey(tblMarkers, key)
+	if !found {
+		return nil, store.ErrNotFound
+	}
+
+	return value, nil
+}
+
+func (s *KVStore) fetchKey(table, key string) (out []byte, found bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	value, found := s.tables[table][key]
+	if !found {
+		return nil, false
+	}
+
+	return copyBytes(value), true
+}
+
+func (s *KVStore) scanPrefix(table, prefixKey string, onRow func(key string, value []byte) error) error {
+	return s.scan(table, prefixKey, func(key string) bool { return strings.HasPrefix(key, prefixKey) }, onRow)
+}
+
+// scanRange iterates over the keys of `[keyStart, keyEnd[`, an empty `keyEnd` meaning
+// the scan continues up to the last key of the table.
+func (s *KVStore) scanRange(table, keyStart, keyEnd string, onRow func(key string, value []byte) error) error {
+	return s.scan(table, keyStart, func(key string) bool { return keyEnd == "" || key < keyEnd }, onRow)
+}
+
+// scan calls `onRow` for each key greater or equal to `keyStart`, in order, until
+// `inRange` returns false. The rows are copied before calling `onRow` so the callback
+// is free to use the store, writing to it for example.
+func (s *KVStore) scan(table, keyStart string, inRange func(key string) bool, onRow func(key string, value []byte) error) error {
+	type row struct {
+		key   string
+		value []byte
+	}
+
+	s.lock.RLock()
+	var rows []row
+	for key, value := range s.tables[table] {
+		if key >= keyStart && inRange(key) {
+			rows = append(rows, row{key, copyBytes(value)})
+		}
+	}
+	s.lock.RUnlock()
+
+	sort.Slice(rows, func(i, j int) bool { return rows[i].key < rows[j].key })
+
+	for _, row := range rows {
+		if err := onRow(row.key, row.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func copyBytes(in []byte) []byte {
+	out := make([]byte, len(in))
+	copy(out, in)
+
+	return out
+}
@@ -27,6 +27,10 @@ var BreakScan = errors.New("break scan")
 
 var ErrNotFound = errors.New("not found")
 
+// ErrDeletionsNotSupported is returned by the flush of a batch holding deletions when the
+// underlying storage engine is unable to delete keys, nothing of the batch is written then
+var ErrDeletionsNotSupported = errors.New("deletions not supported by the storage engine")
+
 type Batch interface {
 	Flush(ctx context.Context) error
 	FlushIfFull(ctx context.Context) error
@@ -39,11 +43,25 @@ type Batch interface {
 	SetLast(key string, value []byte)
 	SetIndex(key string, value []byte)
 
+	// SetMarker sets the marker `key`. Markers are small values FluxDB keeps, apart from
+	// the blocks and rows, to track its own operations spanning many batches (a batch of
+	// blocks being written, history being pruned). They are flushed before the other
+	// mutations of the batch.
+	SetMarker(key string, value []byte)
+
+	// DeleteABI, DeleteRow and DeleteIndex remove the key from its table when the batch
+	// is flushed, deleting a key that does not exist is not an error. Storage engines that
+	// cannot delete keys fail the flush with `ErrDeletionsNotSupported`.
+	DeleteABI(key string)
+	DeleteRow(key string)
+	DeleteIndex(key string)
+
 	Reset()
 }
 
 type OnBlockRef func(key string, blockRef bstream.BlockRef) error
 type OnTabletRow func(key string, value []byte) error
+type OnABI func(key string, rawABI []byte) error
 
 // KVStore represents the abstraction needed by FluxDB to correctly use different
 // underlying KV storage engine.
@@ -55,6 +73,11 @@ type OnTabletRow func(key string, value []byte) error
 type KVStore interface {
 	Close() error
 
+	// SupportsDeletions tells if the store is able to delete keys. When it is not, the
+	// flush of a batch holding deletions fails with `ErrDeletionsNotSupported`, operations
+	// relying on deletions must check it before writing anything.
+	SupportsDeletions() bool
+
 	// NewBatch returns the batch implementation suitable for the underlying store.
 	//
 	// FIXME: For now, we kept the `logger` parameter, not clear the intent was here. Let's
@@ -63,6 +86,10 @@ type KVStore interface {
 
 	FetchABI(ctx context.Context, prefixKey, keyStart, keyEnd string) (rowKey string, rawABI []byte, err error)
 
+	// ScanABIs calls `onABI` for each ABI row in the range `[keyStart, keyEnd[`, an empty
+	// `keyEnd` meaning the scan continues up to the last ABI row.
+	ScanABIs(ctx context.Context, keyStart, keyEnd string, onABI OnABI) error
+
 	FetchIndex(ctx context.Context, tableKey, prefixKey, keyStart string) (rowKey string, rawIndex []byte, err error)
 
 	HasTabletRow(ctx context.Context, keyPrefix string) (exists bool, err error)
@@ -80,4 +107,9 @@ type KVStore interface {
 	FetchLastWrittenBlock(ctx context.Context, key string) (out bstream.BlockRef, err error)
 
 	ScanLastShardsWrittenBlock(ctx context.Context, keyPrefix string, onBlockRef OnBlockRef) error
+
+	// FetchMarker returns the value of the marker `key`, see `Batch.SetMarker`.
+	//
+	// If the marker was never set, this must return `nil, ErrNotFound`.
+	FetchMarker(ctx context.Context, key string) (value []byte, err error)
 }
@@ -0,0 +1,142 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var abiTests = []storeTest{
+	{"TestFetchABI", TestFetchABI},
+	{"TestScanABIs", TestScanABIs},
+	{"TestDeleteABI", TestDeleteABI},
+}
+
+func TestAllABIs(t *testing.T, storeName string, storeFactory StoreFactory) {
+	runAll(t, storeName, storeFactory, abiTests)
+}
+
+// ABI keys are `<account>:<reversed block num>`, the most recent ABI of an account
+// being the first key of the account.
+const (
+	abiAccountA = "0000000000000001:"
+	abiAccountB = "0000000000000002:"
+)
+
+func writeTestABIs(t *testing.T, kvStore store.KVStore) {
+	write(t, kvStore, func(batch store.Batch) {
+		batch.SetABI(abiAccountA+"fffffff5", []byte("a@10"))
+		batch.SetABI(abiAccountA+"ffffffeb", []byte("a@20"))
+		batch.SetABI(abiAccountA+"ffffffe1", []byte("a@30"))
+		batch.SetABI(abiAccountB+"fffffff5", []byte("b@10"))
+	})
+}
+
+func TestFetchABI(t *testing.T, storeFactory StoreFactory) {
+	tests := []struct {
+		name        string
+		prefixKey   string
+		keyStart    string
+		expectKey   string
+		expectABI   string
+		expectError error
+	}{
+		{"exact block", abiAccountA, abiAccountA + "ffffffeb", abiAccountA + "ffffffeb", "a@20", nil},
+		{"between blocks", abiAccountA, abiAccountA + "ffffffe6", abiAccountA + "ffffffeb", "a@20", nil},
+		{"after last block", abiAccountA, abiAccountA + "00000000", abiAccountA + "ffffffe1", "a@30", nil},
+		{"before first block", abiAccountA, abiAccountA + "fffffffa", "", "", store.ErrNotFound},
+		{"other account", abiAccountB, abiAccountB + "ffffffe6", abiAccountB + "fffffff5", "b@10", nil},
+		{"unknown account", "0000000000000003:", "0000000000000003:ffffffe6", "", "", store.ErrNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kvStore, cleanup := storeFactory()
+			defer cleanup()
+
+			writeTestABIs(t, kvStore)
+
+			key, rawABI, err := kvStore.FetchABI(context.Background(), test.prefixKey, test.keyStart, test.prefixKey+"ffffffff")
+			if test.expectError != nil {
+				assert.Equal(t, test.expectError, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectKey, key)
+			assert.Equal(t, test.expectABI, string(rawABI))
+		})
+	}
+}
+
+func TestScanABIs(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	writeTestABIs(t, kvStore)
+
+	assert.Equal(t, []kv{
+		{abiAccountA + "ffffffe1", "a@30"},
+		{abiAccountA + "ffffffeb", "a@20"},
+		{abiAccountA + "fffffff5", "a@10"},
+		{abiAccountB + "fffffff5", "b@10"},
+	}, collect(t, func(onRow func(key string, value []byte) error) error {
+		return kvStore.ScanABIs(ctx, "", "", onRow)
+	}), "open range should return all abis")
+
+	assert.Equal(t, []kv{
+		{abiAccountA + "ffffffeb", "a@20"},
+		{abiAccountA + "fffffff5", "a@10"},
+	}, collect(t, func(onRow func(key string, value []byte) error) error {
+		return kvStore.ScanABIs(ctx, abiAccountA+"ffffffeb", abiAccountB, onRow)
+	}), "range end should be exclusive")
+
+	count := 0
+	require.NoError(t, kvStore.ScanABIs(ctx, "", "", func(key string, _ []byte) error {
+		count++
+		return store.BreakScan
+	}))
+	assert.Equal(t, 1, count, "break scan should stop the scan without error")
+}
+
+func TestDeleteABI(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	writeTestABIs(t, kvStore)
+	write(t, kvStore, func(batch store.Batch) {
+		batch.DeleteABI(abiAccountA + "ffffffe1")
+		batch.DeleteABI(abiAccountA + "00000000")
+	})
+
+	assert.Equal(t, []kv{
+		{abiAccountA + "ffffffeb", "a@20"},
+		{abiAccountA + "fffffff5", "a@10"},
+		{abiAccountB + "fffffff5", "b@10"},
+	}, collect(t, func(onRow func(key string, value []byte) error) error {
+		return kvStore.ScanABIs(ctx, "", "", onRow)
+	}))
+
+	key, _, err := kvStore.FetchABI(ctx, abiAccountA, abiAccountA+"00000000", abiAccountA+"ffffffff")
+	require.NoError(t, err)
+	assert.Equal(t, abiAccountA+"ffffffeb", key)
+}
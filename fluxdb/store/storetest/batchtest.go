@@ -0,0 +1,125 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var batchTests = []storeTest{
+	{"TestBatchReset", TestBatchReset},
+	{"TestBatchSetAndDelete", TestBatchSetAndDelete},
+	{"TestBatchFlushIfFull", TestBatchFlushIfFull},
+	{"TestBatchDeletionsSupport", TestBatchDeletionsSupport},
+}
+
+func TestAllBatches(t *testing.T, storeName string, storeFactory StoreFactory) {
+	runAll(t, storeName, storeFactory, batchTests)
+}
+
+func TestBatchReset(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	batch := kvStore.NewBatch(zap.NewNop())
+	batch.SetRow(rowKeyA10First, []byte("a1@10"))
+	batch.SetLast("block", []byte("0000000aaa"))
+	batch.Reset()
+
+	require.NoError(t, batch.Flush(ctx))
+
+	assert.Empty(t, collect(t, func(onRow func(key string, value []byte) error) error {
+		return kvStore.ScanTabletRows(ctx, "", "", onRow)
+	}), "reset mutations should not be written")
+
+	_, err := kvStore.FetchLastWrittenBlock(ctx, "block")
+	assert.Equal(t, store.ErrNotFound, err)
+}
+
+func TestBatchSetAndDelete(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	writeTestTabletRows(t, kvStore)
+	write(t, kvStore, func(batch store.Batch) {
+		// Last operation on a key within a batch wins
+		batch.SetRow(rowKeyA10First, []byte("a1@10'"))
+		batch.DeleteRow(rowKeyA10First)
+
+		batch.DeleteRow(rowKeyA10Second)
+		batch.SetRow(rowKeyA10Second, []byte("a2@10'"))
+	})
+
+	assert.Equal(t, []kv{
+		{rowKeyA10Second, "a2@10'"},
+		{rowKeyA11First, ""},
+		{rowKeyB10First, "b1@10"},
+	}, collect(t, func(onRow func(key string, value []byte) error) error {
+		return kvStore.ScanTabletRows(ctx, "", "", onRow)
+	}))
+}
+
+func TestBatchFlushIfFull(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	batch := kvStore.NewBatch(zap.NewNop())
+
+	// Whatever the threshold of the implementation, all rows must be written once the batch is flushed
+	var expectedRows []kv
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("%s:0000000a:%016x", tabletKeyA, i)
+		batch.SetRow(key, []byte(fmt.Sprintf("row-%d", i)))
+		require.NoError(t, batch.FlushIfFull(ctx))
+
+		expectedRows = append(expectedRows, kv{key, fmt.Sprintf("row-%d", i)})
+	}
+	require.NoError(t, batch.Flush(ctx))
+
+	assert.Equal(t, expectedRows, collect(t, func(onRow func(key string, value []byte) error) error {
+		return kvStore.ScanTabletRows(ctx, "", "", onRow)
+	}))
+}
+
+func TestBatchDeletionsSupport(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	batch := kvStore.NewBatch(zap.NewNop())
+	batch.SetRow(rowKeyA10First, []byte("a1@10"))
+	batch.DeleteRow(rowKeyA11First)
+
+	err := batch.Flush(ctx)
+	if kvStore.SupportsDeletions() {
+		require.NoError(t, err)
+		return
+	}
+
+	assert.Equal(t, store.ErrDeletionsNotSupported, err)
+	assert.Empty(t, collect(t, func(onRow func(key string, value []byte) error) error {
+		return kvStore.ScanTabletRows(ctx, "", "", onRow)
+	}), "nothing of a batch failing on deletions should be written")
+}
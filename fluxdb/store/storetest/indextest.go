@@ -0,0 +1,108 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var indexTests = []storeTest{
+	{"TestFetchIndex", TestFetchIndex},
+	{"TestDeleteIndex", TestDeleteIndex},
+}
+
+func TestAllIndexes(t *testing.T, storeName string, storeFactory StoreFactory) {
+	runAll(t, storeName, storeFactory, indexTests)
+}
+
+// Index keys are `<table key>:<reversed block num>`, the most recent index of a table
+// being the first key of the table.
+const (
+	indexTableKeyA = "td:0000000000000001:0000000000000002:0000000000000003"
+	indexTableKeyB = "td:0000000000000001:0000000000000002:0000000000000004"
+)
+
+func writeTestIndexes(t *testing.T, kvStore store.KVStore) {
+	write(t, kvStore, func(batch store.Batch) {
+		batch.SetIndex(indexTableKeyA+":fffffff5", []byte("a@10"))
+		batch.SetIndex(indexTableKeyA+":ffffffeb", []byte("a@20"))
+		batch.SetIndex(indexTableKeyB+":fffffff0", []byte("b@15"))
+	})
+}
+
+func TestFetchIndex(t *testing.T, storeFactory StoreFactory) {
+	tests := []struct {
+		name        string
+		tableKey    string
+		keyStart    string
+		expectKey   string
+		expectIndex string
+		expectError error
+	}{
+		{"exact block", indexTableKeyA, indexTableKeyA + ":fffffff5", indexTableKeyA + ":fffffff5", "a@10", nil},
+		{"between blocks", indexTableKeyA, indexTableKeyA + ":fffffff0", indexTableKeyA + ":fffffff5", "a@10", nil},
+		{"after last block", indexTableKeyA, indexTableKeyA + ":00000000", indexTableKeyA + ":ffffffeb", "a@20", nil},
+		{"before first block", indexTableKeyA, indexTableKeyA + ":fffffffa", "", "", store.ErrNotFound},
+		{"other table", indexTableKeyB, indexTableKeyB + ":ffffffeb", indexTableKeyB + ":fffffff0", "b@15", nil},
+		{"before first block of last table", indexTableKeyB, indexTableKeyB + ":fffffffa", "", "", store.ErrNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kvStore, cleanup := storeFactory()
+			defer cleanup()
+
+			writeTestIndexes(t, kvStore)
+
+			key, rawIndex, err := kvStore.FetchIndex(context.Background(), test.tableKey, test.tableKey+":", test.keyStart)
+			if test.expectError != nil {
+				assert.Equal(t, test.expectError, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectKey, key)
+			assert.Equal(t, test.expectIndex, string(rawIndex))
+		})
+	}
+}
+
+func TestDeleteIndex(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	writeTestIndexes(t, kvStore)
+	write(t, kvStore, func(batch store.Batch) {
+		batch.DeleteIndex(indexTableKeyA + ":ffffffeb")
+	})
+
+	key, rawIndex, err := kvStore.FetchIndex(ctx, indexTableKeyA, indexTableKeyA+":", indexTableKeyA+":00000000")
+	require.NoError(t, err)
+	assert.Equal(t, indexTableKeyA+":fffffff5", key)
+	assert.Equal(t, "a@10", string(rawIndex))
+
+	write(t, kvStore, func(batch store.Batch) {
+		batch.DeleteIndex(indexTableKeyA + ":fffffff5")
+	})
+
+	_, _, err = kvStore.FetchIndex(ctx, indexTableKeyA, indexTableKeyA+":", indexTableKeyA+":00000000")
+	assert.Equal(t, store.ErrNotFound, err)
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var lastBlockTests = []storeTest{
+	{"TestFetchLastWrittenBlock", TestFetchLastWrittenBlock},
+	{"TestScanLastShardsWrittenBlock", TestScanLastShardsWrittenBlock},
+}
+
+func TestAllLastBlocks(t *testing.T, storeName string, storeFactory StoreFactory) {
+	runAll(t, storeName, storeFactory, lastBlockTests)
+}
+
+func TestFetchLastWrittenBlock(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+
+	_, err := kvStore.FetchLastWrittenBlock(ctx, "block")
+	assert.Equal(t, store.ErrNotFound, err, "never written block should not be found")
+
+	write(t, kvStore, func(batch store.Batch) {
+		batch.SetLast("block", []byte("0000000aaa"))
+	})
+
+	blockRef, err := kvStore.FetchLastWrittenBlock(ctx, "block")
+	require.NoError(t, err)
+	assert.Equal(t, "0000000aaa", blockRef.ID())
+	assert.Equal(t, uint64(10), blockRef.Num())
+
+	write(t, kvStore, func(batch store.Batch) {
+		batch.SetLast("block", []byte("0000000bbb"))
+	})
+
+	blockRef, err = kvStore.FetchLastWrittenBlock(ctx, "block")
+	require.NoError(t, err)
+	assert.Equal(t, "0000000bbb", blockRef.ID(), "last write should replace the previous value")
+}
+
+func TestScanLastShardsWrittenBlock(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	write(t, kvStore, func(batch store.Batch) {
+		batch.SetLast("block", []byte("0000000aaa"))
+		batch.SetLast("shard-000", []byte("00000005aa"))
+		batch.SetLast("shard-001", []byte("00000007aa"))
+	})
+
+	var blockRefs []string
+	err := kvStore.ScanLastShardsWrittenBlock(ctx, "shard-", func(key string, blockRef bstream.BlockRef) error {
+		blockRefs = append(blockRefs, key+"="+blockRef.ID())
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"shard-000=00000005aa", "shard-001=00000007aa"}, blockRefs)
+
+	count := 0
+	require.NoError(t, kvStore.ScanLastShardsWrittenBlock(ctx, "shard-", func(key string, _ bstream.BlockRef) error {
+		count++
+		return store.BreakScan
+	}))
+	assert.Equal(t, 1, count, "break scan should stop the scan without error")
+}
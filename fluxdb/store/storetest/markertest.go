@@ -0,0 +1,76 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var markerTests = []storeTest{
+	{"TestFetchMarker", TestFetchMarker},
+	{"TestMarkersApartFromLastBlocks", TestMarkersApartFromLastBlocks},
+}
+
+func TestAllMarkers(t *testing.T, storeName string, storeFactory StoreFactory) {
+	runAll(t, storeName, storeFactory, markerTests)
+}
+
+func TestFetchMarker(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+
+	_, err := kvStore.FetchMarker(ctx, "marker")
+	assert.Equal(t, store.ErrNotFound, err, "never set marker should not be found")
+
+	write(t, kvStore, func(batch store.Batch) {
+		batch.SetMarker("marker", []byte("first"))
+	})
+
+	value, err := kvStore.FetchMarker(ctx, "marker")
+	require.NoError(t, err)
+	assert.Equal(t, "first", string(value))
+
+	write(t, kvStore, func(batch store.Batch) {
+		batch.SetMarker("marker", []byte("second"))
+	})
+
+	value, err = kvStore.FetchMarker(ctx, "marker")
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(value), "last write should replace the previous value")
+}
+
+func TestMarkersApartFromLastBlocks(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	write(t, kvStore, func(batch store.Batch) {
+		batch.SetMarker("shard-000", []byte("marker"))
+		batch.SetLast("block", []byte("0000000aaa"))
+	})
+
+	_, err := kvStore.FetchMarker(ctx, "block")
+	assert.Equal(t, store.ErrNotFound, err, "last written block should not be a marker")
+
+	_, err = kvStore.FetchLastWrittenBlock(ctx, "shard-000")
+	assert.Equal(t, store.ErrNotFound, err, "marker should not be a last written block")
+}
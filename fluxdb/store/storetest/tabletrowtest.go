@@ -0,0 +1,186 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tabletRowTests = []storeTest{
+	{"TestHasTabletRow", TestHasTabletRow},
+	{"TestFetchTabletRow", TestFetchTabletRow},
+	{"TestFetchTabletRows", TestFetchTabletRows},
+	{"TestScanTabletRows", TestScanTabletRows},
+	{"TestDeleteRow", TestDeleteRow},
+}
+
+func TestAllTabletRows(t *testing.T, storeName string, storeFactory StoreFactory) {
+	runAll(t, storeName, storeFactory, tabletRowTests)
+}
+
+// Tablet row keys are `<tablet key>:<block num>:<primary key>`, an empty value
+// being a deletion of the row at this block.
+const (
+	tabletKeyA = "td:0000000000000001:0000000000000002:0000000000000003"
+	tabletKeyB = "td:0000000000000001:0000000000000002:0000000000000004"
+
+	rowKeyA10First  = tabletKeyA + ":0000000a:0000000000000001"
+	rowKeyA10Second = tabletKeyA + ":0000000a:0000000000000002"
+	rowKeyA11First  = tabletKeyA + ":0000000b:0000000000000001"
+	rowKeyB10First  = tabletKeyB + ":0000000a:0000000000000001"
+)
+
+func writeTestTabletRows(t *testing.T, kvStore store.KVStore) {
+	write(t, kvStore, func(batch store.Batch) {
+		batch.SetRow(rowKeyA10First, []byte("a1@10"))
+		batch.SetRow(rowKeyA10Second, []byte("a2@10"))
+		batch.SetRow(rowKeyA11First, nil)
+		batch.SetRow(rowKeyB10First, []byte("b1@10"))
+	})
+}
+
+func TestHasTabletRow(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	writeTestTabletRows(t, kvStore)
+
+	tests := []struct {
+		keyPrefix    string
+		expectExists bool
+	}{
+		{tabletKeyA + ":", true},
+		{tabletKeyB + ":0000000a:", true},
+		{tabletKeyB + ":0000000b:", false},
+		{"td:0000000000000001:0000000000000002:", true},
+		{"td:0000000000000001:0000000000000005:", false},
+	}
+
+	for _, test := range tests {
+		exists, err := kvStore.HasTabletRow(ctx, test.keyPrefix)
+		require.NoError(t, err)
+		assert.Equal(t, test.expectExists, exists, "prefix %q", test.keyPrefix)
+	}
+}
+
+func TestFetchTabletRow(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	writeTestTabletRows(t, kvStore)
+
+	assert.Equal(t, []kv{{rowKeyA10Second, "a2@10"}}, collect(t, func(onRow func(key string, value []byte) error) error {
+		return kvStore.FetchTabletRow(ctx, rowKeyA10Second, onRow)
+	}))
+
+	err := kvStore.FetchTabletRow(ctx, tabletKeyA+":0000000c:0000000000000001", func(key string, _ []byte) error {
+		t.Errorf("unexpected tablet row %q", key)
+		return nil
+	})
+	assert.Equal(t, store.ErrNotFound, err)
+}
+
+func TestFetchTabletRows(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	writeTestTabletRows(t, kvStore)
+
+	assert.Equal(t, []kv{
+		{rowKeyA10First, "a1@10"},
+		{rowKeyA10Second, "a2@10"},
+		{rowKeyB10First, "b1@10"},
+	}, collect(t, func(onRow func(key string, value []byte) error) error {
+		return kvStore.FetchTabletRows(ctx, []string{rowKeyA10First, rowKeyA10Second, tabletKeyA + ":0000000c:0000000000000001", rowKeyB10First}, onRow)
+	}), "keys not found should be skipped")
+
+	count := 0
+	require.NoError(t, kvStore.FetchTabletRows(ctx, []string{rowKeyA10First, rowKeyA10Second}, func(key string, _ []byte) error {
+		count++
+		return store.BreakScan
+	}))
+	assert.Equal(t, 1, count, "break scan should stop the fetch without error")
+}
+
+func TestScanTabletRows(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	writeTestTabletRows(t, kvStore)
+
+	tests := []struct {
+		name       string
+		keyStart   string
+		keyEnd     string
+		expectRows []kv
+	}{
+		{"single tablet", tabletKeyA + ":", tabletKeyA + ";", []kv{{rowKeyA10First, "a1@10"}, {rowKeyA10Second, "a2@10"}, {rowKeyA11First, ""}}},
+		{"exclusive end", tabletKeyA + ":0000000a", tabletKeyA + ":0000000b", []kv{{rowKeyA10First, "a1@10"}, {rowKeyA10Second, "a2@10"}}},
+		{"end on existing key", rowKeyA10First, rowKeyA11First, []kv{{rowKeyA10First, "a1@10"}, {rowKeyA10Second, "a2@10"}}},
+		{"open end", rowKeyA11First, "", []kv{{rowKeyA11First, ""}, {rowKeyB10First, "b1@10"}}},
+		{"empty range", tabletKeyA + ":0000000c", tabletKeyA + ";", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectRows, collect(t, func(onRow func(key string, value []byte) error) error {
+				return kvStore.ScanTabletRows(ctx, test.keyStart, test.keyEnd, onRow)
+			}))
+		})
+	}
+
+	count := 0
+	require.NoError(t, kvStore.ScanTabletRows(ctx, tabletKeyA+":", "", func(key string, _ []byte) error {
+		count++
+		return store.BreakScan
+	}))
+	assert.Equal(t, 1, count, "break scan should stop the scan without error")
+}
+
+func TestDeleteRow(t *testing.T, storeFactory StoreFactory) {
+	kvStore, cleanup := storeFactory()
+	defer cleanup()
+
+	ctx := context.Background()
+	writeTestTabletRows(t, kvStore)
+	write(t, kvStore, func(batch store.Batch) {
+		batch.DeleteRow(rowKeyA10First)
+		batch.DeleteRow(rowKeyA11First)
+		batch.DeleteRow(tabletKeyA + ":0000000c:0000000000000001")
+	})
+
+	assert.Equal(t, []kv{
+		{rowKeyA10Second, "a2@10"},
+		{rowKeyB10First, "b1@10"},
+	}, collect(t, func(onRow func(key string, value []byte) error) error {
+		return kvStore.ScanTabletRows(ctx, "", "", onRow)
+	}))
+
+	err := kvStore.FetchTabletRow(ctx, rowKeyA10First, func(key string, _ []byte) error { return nil })
+	assert.Equal(t, store.ErrNotFound, err)
+
+	exists, err := kvStore.HasTabletRow(ctx, tabletKeyA+":0000000a:0000000000000001")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
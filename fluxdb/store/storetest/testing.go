@@ -0,0 +1,81 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type StoreCleanupFunc func()
+type StoreFactory func() (store.KVStore, StoreCleanupFunc)
+
+// TestAll runs the full conformance suite against the `store.KVStore` implementation
+// returned by `storeFactory`. The factory is called once per test and must return
+// an empty store each time.
+func TestAll(t *testing.T, storeName string, storeFactory StoreFactory) {
+	TestAllABIs(t, storeName, storeFactory)
+	TestAllIndexes(t, storeName, storeFactory)
+	TestAllTabletRows(t, storeName, storeFactory)
+	TestAllLastBlocks(t, storeName, storeFactory)
+	TestAllMarkers(t, storeName, storeFactory)
+	TestAllBatches(t, storeName, storeFactory)
+}
+
+type storeTest struct {
+	name string
+	test func(t *testing.T, storeFactory StoreFactory)
+}
+
+func runAll(t *testing.T, storeName string, storeFactory StoreFactory, tests []storeTest) {
+	for _, rt := range tests {
+		t.Run(storeName+"/"+rt.name, func(t *testing.T) {
+			rt.test(t, storeFactory)
+		})
+	}
+}
+
+type kv struct {
+	key   string
+	value string
+}
+
+func write(t *testing.T, kvStore store.KVStore, mutate func(batch store.Batch)) {
+	t.Helper()
+
+	batch := kvStore.NewBatch(zap.NewNop())
+	mutate(batch)
+
+	err := batch.Flush(context.Background())
+	if err == store.ErrDeletionsNotSupported && !kvStore.SupportsDeletions() {
+		t.Skip("store cannot delete keys")
+	}
+	require.NoError(t, err)
+}
+
+func collect(t *testing.T, scan func(onRow func(key string, value []byte) error) error) (out []kv) {
+	t.Helper()
+
+	require.NoError(t, scan(func(key string, value []byte) error {
+		out = append(out, kv{key, string(value)})
+		return nil
+	}))
+
+	return out
+}
@@ -0,0 +1,163 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluxdb
+
+import (
+	"encoding/hex"
+	"errors"
+	"sync"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"go.uber.org/zap"
+)
+
+var ErrSubscriptionOverflow = errors.New("subscription overflowed, consumer is too slow")
+
+// WriteStep is a block write request as seen by the pipeline, the `Step` being
+// one of `forkable.StepNew`, `forkable.StepUndo` or `forkable.StepIrreversible`.
+type WriteStep struct {
+	Step  forkable.StepType
+	Block bstream.BlockRef
+	Write *WriteRequest
+}
+
+// WriteSubscription receives the write steps processed by the handler. The
+// `Pending` steps are the ones the handler knew about when the subscription
+// was created and that are not in the database yet, everything at or before
+// `LastWrittenBlockNum` being readable from the database.
+type WriteSubscription struct {
+	LastWrittenBlockNum uint32
+	Pending             []*WriteStep
+
+	handler *FluxDBHandler
+	steps   chan *WriteStep
+
+	closeOnce sync.Once
+	err       error
+}
+
+// Steps returns the channel of incoming write steps. It's closed once the
+// subscription is closed, `Err` then tells why it was closed.
+func (s *WriteSubscription) Steps() <-chan *WriteStep {
+	return s.steps
+}
+
+func (s *WriteSubscription) Err() error {
+	return s.err
+}
+
+func (s *WriteSubscription) Close() {
+	s.handler.unsubscribe(s, nil)
+}
+
+func (s *WriteSubscription) shutdown(err error) {
+	s.closeOnce.Do(func() {
+		s.err = err
+		close(s.steps)
+	})
+}
+
+// SubscribeWrites registers a new subscription to the write steps processed by
+// the handler. The subscription is dropped with `ErrSubscriptionOverflow` when
+// more than `bufferSize` steps are waiting to be consumed.
+func (p *FluxDBHandler) SubscribeWrites(bufferSize int) *WriteSubscription {
+	p.subscriptionsLock.Lock()
+	defer p.subscriptionsLock.Unlock()
+
+	sub := &WriteSubscription{
+		handler: p,
+		steps:   make(chan *WriteStep, bufferSize),
+	}
+
+	// Irreversible writes not flushed yet are still pending, so the database is
+	// only complete up to the block preceding the first one of them.
+	unwritten := append(append([]*WriteRequest{}, p.flushingWrites...), p.batchWrites...)
+	if len(unwritten) > 0 {
+		sub.LastWrittenBlockNum = unwritten[0].BlockNum - 1
+	} else if p.serverForkDB != nil {
+		sub.LastWrittenBlockNum = uint32(p.serverForkDB.LIBNum())
+	}
+
+	lastPendingBlockNum := sub.LastWrittenBlockNum
+	addPending := func(step forkable.StepType, write *WriteRequest) {
+		if write.BlockNum <= lastPendingBlockNum {
+			return
+		}
+
+		sub.Pending = append(sub.Pending, newWriteStep(step, write))
+		lastPendingBlockNum = write.BlockNum
+	}
+
+	for _, write := range unwritten {
+		addPending(forkable.StepIrreversible, write)
+	}
+
+	p.speculativeReadsLock.RLock()
+	for _, write := range p.speculativeWrites {
+		// The server fork database lags behind the pipeline LIB when not writing,
+		// speculative writes might thus already be irreversible.
+		step := forkable.StepNew
+		if write.BlockNum <= p.irreversibleBlockNum {
+			step = forkable.StepIrreversible
+		}
+
+		addPending(step, write)
+	}
+	p.speculativeReadsLock.RUnlock()
+
+	p.subscriptions = append(p.subscriptions, sub)
+	zlog.Debug("added write subscription", zap.Int("subscription_count", len(p.subscriptions)), zap.Int("pending_step_count", len(sub.Pending)))
+
+	return sub
+}
+
+func (p *FluxDBHandler) unsubscribe(toRemove *WriteSubscription, err error) {
+	p.subscriptionsLock.Lock()
+	defer p.subscriptionsLock.Unlock()
+
+	p.removeSubscription(toRemove, err)
+}
+
+func (p *FluxDBHandler) removeSubscription(toRemove *WriteSubscription, err error) {
+	for i, sub := range p.subscriptions {
+		if sub == toRemove {
+			p.subscriptions = append(p.subscriptions[:i], p.subscriptions[i+1:]...)
+			break
+		}
+	}
+
+	toRemove.shutdown(err)
+}
+
+// broadcast sends the step to all subscriptions, the caller must hold `subscriptionsLock`.
+func (p *FluxDBHandler) broadcast(step *WriteStep) {
+	for _, sub := range p.subscriptions {
+		select {
+		case sub.steps <- step:
+		default:
+			zlog.Info("write subscription overflowed, dropping it", zap.Stringer("block", step.Block))
+			defer p.removeSubscription(sub, ErrSubscriptionOverflow)
+		}
+	}
+}
+
+func newWriteStep(step forkable.StepType, write *WriteRequest) *WriteStep {
+	return &WriteStep{
+		Step:  step,
+		Block: bstream.NewBlockRef(hex.EncodeToString(write.BlockID), uint64(write.BlockNum)),
+		Write: write,
+	}
+}
@@ -18,6 +18,9 @@ import (
 	"crypto/md5"
 	"encoding/binary"
 	"fmt"
+	"math"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 )
 
 type ReadTableRequest struct {
@@ -26,12 +29,126 @@ type ReadTableRequest struct {
 	BlockNum              uint32
 	Offset, Limit         *uint32
 	SpeculativeWrites     []*WriteRequest
+
+	// IndexPosition follows nodeos `index_position` semantics, `0` and `1` both
+	// refer to the primary index while `2` is the first secondary index, `3` the
+	// second one and so on.
+	IndexPosition uint32
+
+	// LowerBound and UpperBound, both inclusive, restrict the rows read. On
+	// the primary index, they are big endian encoded primary keys, on secondary
+	// indexes, they are secondary keys in their sortable form (see
+	// `SecondaryKeyFromString`).
+	LowerBound, UpperBound []byte
+
+	// Reverse returns the rows in descending key order instead of ascending.
+	Reverse bool
+}
+
+// pagination returns the number of rows to skip and the maximum number of rows
+// to return, a limit of 0 meaning all rows are returned.
+func (r *ReadTableRequest) pagination() (offset, limit int) {
+	if r.Offset != nil {
+		offset = int(*r.Offset)
+	}
+
+	if r.Limit != nil {
+		limit = int(*r.Limit)
+	}
+
+	return
+}
+
+// page returns the `[start, end)` slice indices of the requested page within
+// `count` ordered rows.
+func (r *ReadTableRequest) page(count int) (start, end int) {
+	offset, limit := r.pagination()
+	if offset > count {
+		offset = count
+	}
+
+	end = count
+	if limit > 0 && offset+limit < count {
+		end = offset + limit
+	}
+
+	return offset, end
 }
 
 func (r *ReadTableRequest) tableKey() string {
 	return fmt.Sprintf("td:%016x:%016x:%016x", r.Account, r.Table, r.Scope)
 }
 
+// primaryKeyRange returns the primary key bounds in their row key form, an
+// empty string meaning the range is unbounded on that side.
+func (r *ReadTableRequest) primaryKeyRange() (lower, upper string, err error) {
+	if r.LowerBound != nil {
+		if len(r.LowerBound) != 8 {
+			return "", "", fmt.Errorf("primary key lower bound should have 8 bytes, got %d", len(r.LowerBound))
+		}
+
+		lower = fmt.Sprintf("%016x", big.Uint64(r.LowerBound))
+	}
+
+	if r.UpperBound != nil {
+		if len(r.UpperBound) != 8 {
+			return "", "", fmt.Errorf("primary key upper bound should have 8 bytes, got %d", len(r.UpperBound))
+		}
+
+		upper = fmt.Sprintf("%016x", big.Uint64(r.UpperBound))
+	}
+
+	return
+}
+
+func (r *ReadTableRequest) speculativeDeletionCount() (count int) {
+	for _, blockWrite := range r.SpeculativeWrites {
+		if r.isSecondaryIndexRead() {
+			for _, row := range blockWrite.SecondaryIndexes {
+				if row.Deletion && r.Account == row.Account && r.Scope == row.Scope && r.Table == row.Table && r.secondaryIndexPosition() == row.IndexPosition {
+					count++
+				}
+			}
+
+			continue
+		}
+
+		for _, row := range blockWrite.TableDatas {
+			if row.Deletion && r.Account == row.Account && r.Scope == row.Scope && r.Table == row.Table {
+				count++
+			}
+		}
+	}
+
+	return
+}
+
+func (r *ReadTableRequest) isSecondaryIndexRead() bool {
+	return r.IndexPosition >= 2
+}
+
+func (r *ReadTableRequest) secondaryIndexPosition() uint8 {
+	return uint8(r.IndexPosition - 2)
+}
+
+// secondaryKeyRange returns the secondary key bounds in their entry key form (see
+// `secondaryIndexEntryKey`), an empty string meaning the range is unbounded on that side.
+func (r *ReadTableRequest) secondaryKeyRange() (lower, upper string) {
+	if r.LowerBound != nil {
+		lower = secondaryIndexEntryKey(r.LowerBound, 0)
+	}
+
+	if r.UpperBound != nil {
+		upper = secondaryIndexEntryKey(r.UpperBound, math.MaxUint64)
+	}
+
+	return
+}
+
+func (r *ReadTableRequest) secondaryIndexTableKey() string {
+	return fmt.Sprintf("si:%016x:%016x:%016x:%02x", r.Account, r.Table, r.Scope, r.secondaryIndexPosition())
+}
+
 type ReadTableRowRequest struct {
 	ReadTableRequest
 	PrimaryKey uint64
@@ -41,6 +158,81 @@ func (r *ReadTableRowRequest) primaryKeyString() string {
 	return fmt.Sprintf("%016x", r.PrimaryKey)
 }
 
+// ReadTableRowsRequest asks for several rows of a single table at once, rows
+// deleted or that never existed are absent from the response.
+type ReadTableRowsRequest struct {
+	ReadTableRequest
+	PrimaryKeys []uint64
+}
+
+// ReadTableRowHistoryRequest asks for every mutation of a single table row
+// between `FromBlockNum` and `ToBlockNum`, both inclusive.
+type ReadTableRowHistoryRequest struct {
+	Account, Scope, Table    uint64
+	PrimaryKey               uint64
+	FromBlockNum, ToBlockNum uint32
+	SpeculativeWrites        []*WriteRequest
+}
+
+func (r *ReadTableRowHistoryRequest) tableKey() string {
+	return fmt.Sprintf("td:%016x:%016x:%016x", r.Account, r.Table, r.Scope)
+}
+
+func (r *ReadTableRowHistoryRequest) primaryKeyString() string {
+	return fmt.Sprintf("%016x", r.PrimaryKey)
+}
+
+type ReadTableRowHistoryResponse struct {
+	Mutations []*TableRowMutation
+}
+
+// TableRowMutation is a single change of a table row, `Row` is `nil` when the
+// mutation is a deletion.
+type TableRowMutation struct {
+	BlockNum uint32
+	Deletion bool
+	Row      *TableRow
+}
+
+// DiffTableRequest asks for the rows of a table that changed between the state at
+// `FromBlockNum` and the state at `ToBlockNum`.
+type DiffTableRequest struct {
+	Account, Scope, Table    uint64
+	FromBlockNum, ToBlockNum uint32
+	SpeculativeWrites        []*WriteRequest
+}
+
+func (r *DiffTableRequest) tableKey() string {
+	return fmt.Sprintf("td:%016x:%016x:%016x", r.Account, r.Table, r.Scope)
+}
+
+// DiffTableResponse holds the rows inserted, updated and removed between the
+// two blocks of a `DiffTableRequest`, each list being sorted by primary key.
+// `FromABI` is only set when there are updated or removed rows to decode.
+type DiffTableResponse struct {
+	FromABI, ToABI *ABIRow
+
+	Inserted []*TableRow
+	Updated  []*TableRowUpdate
+	Removed  []*TableRow
+}
+
+type TableRowUpdate struct {
+	Old, New *TableRow
+}
+
+// ScanTableWritesRequest asks for the rows of a table written by each block of
+// the inclusive `[FromBlockNum, ToBlockNum]` range. Only the database is read,
+// speculative writes are left to the caller.
+type ScanTableWritesRequest struct {
+	Account, Scope, Table    uint64
+	FromBlockNum, ToBlockNum uint32
+}
+
+func (r *ScanTableWritesRequest) tableKey() string {
+	return fmt.Sprintf("td:%016x:%016x:%016x", r.Account, r.Table, r.Scope)
+}
+
 type ReadTableResponse struct {
 	ABI  *ABIRow
 	Rows []*TableRow
@@ -64,30 +256,78 @@ type LinkedPermission struct {
 	PermissionName string `json:"permission_name"`
 }
 
+// AccountPermission is the state of one of an account's permissions, `BlockNum`
+// being the block at which it was last changed.
+type AccountPermission struct {
+	BlockNum   uint32
+	Permission *pbcodec.PermissionObject
+}
+
+// AccountResources holds the resource limits in effect for an account as well as
+// its last known resource usage, each being nil when it was never seen.
+type AccountResources struct {
+	Limits         *pbcodec.RlimitAccountLimits
+	LimitsBlockNum uint32
+	Usage          *pbcodec.RlimitAccountUsage
+	UsageBlockNum  uint32
+}
+
 type WriteRequest struct {
 	ABIs []*ABIRow
 
-	AuthLinks   []*AuthLinkRow
-	KeyAccounts []*KeyAccountRow
-	TableDatas  []*TableDataRow
-	TableScopes []*TableScopeRow
+	AccountPermissions    []*AccountPermissionRow
+	AccountResourceLimits []*AccountResourceLimitRow
+	AuthLinks             []*AuthLinkRow
+	KeyAccounts           []*KeyAccountRow
+	TableDatas            []*TableDataRow
+	TableScopes           []*TableScopeRow
+	SecondaryIndexes      []*SecondaryIndexRow
 
 	BlockNum uint32
 	BlockID  []byte
 }
 
+// SpeculativeWritesUpTo returns the speculative writes that happened at or before `blockNum`.
+func SpeculativeWritesUpTo(speculativeWrites []*WriteRequest, blockNum uint32) (out []*WriteRequest) {
+	for _, write := range speculativeWrites {
+		if write.BlockNum <= blockNum {
+			out = append(out, write)
+		}
+	}
+
+	return
+}
+
+// tableKeyShard returns the shard, out of `shardCount`, the rows of a table go to.
+func tableKeyShard(tableKey string, shardCount uint32) uint32 {
+	h := md5.New()
+	_, _ = h.Write([]byte(tableKey))
+	md5Hash := h.Sum(nil)
+
+	bigInt := binary.LittleEndian.Uint32(md5Hash)
+	return bigInt % shardCount
+}
+
 func (req *WriteRequest) purgeShardedRows(shardIdx, shardCount uint32) {
 	include := func(row writableRow) bool {
-		h := md5.New()
-		_, _ = h.Write([]byte(row.tableKey()))
-		md5Hash := h.Sum(nil)
+		return tableKeyShard(row.tableKey(), shardCount) == shardIdx
+	}
 
-		bigInt := binary.LittleEndian.Uint32(md5Hash)
-		elementShard := bigInt % shardCount
-		inCurrentShard := shardIdx == elementShard
+	var newAccountPermissions []*AccountPermissionRow
+	for _, el := range req.AccountPermissions {
+		if include(el) {
+			newAccountPermissions = append(newAccountPermissions, el)
+		}
+	}
+	req.AccountPermissions = newAccountPermissions
 
-		return inCurrentShard
+	var newAccountResourceLimits []*AccountResourceLimitRow
+	for _, el := range req.AccountResourceLimits {
+		if include(el) {
+			newAccountResourceLimits = append(newAccountResourceLimits, el)
+		}
 	}
+	req.AccountResourceLimits = newAccountResourceLimits
 
 	var newAuthLinks []*AuthLinkRow
 	for _, el := range req.AuthLinks {
@@ -121,6 +361,14 @@ func (req *WriteRequest) purgeShardedRows(shardIdx, shardCount uint32) {
 	}
 	req.TableScopes = newTableScopes
 
+	var newSecondaryIndexes []*SecondaryIndexRow
+	for _, el := range req.SecondaryIndexes {
+		if include(el) {
+			newSecondaryIndexes = append(newSecondaryIndexes, el)
+		}
+	}
+	req.SecondaryIndexes = newSecondaryIndexes
+
 	if shardIdx != 0 {
 		req.ABIs = nil
 	}
@@ -128,6 +376,10 @@ func (req *WriteRequest) purgeShardedRows(shardIdx, shardCount uint32) {
 
 func (req *WriteRequest) appendRow(row interface{}) {
 	switch obj := row.(type) {
+	case *AccountPermissionRow:
+		req.AccountPermissions = append(req.AccountPermissions, obj)
+	case *AccountResourceLimitRow:
+		req.AccountResourceLimits = append(req.AccountResourceLimits, obj)
 	case *AuthLinkRow:
 		req.AuthLinks = append(req.AuthLinks, obj)
 	case *KeyAccountRow:
@@ -136,12 +388,46 @@ func (req *WriteRequest) appendRow(row interface{}) {
 		req.TableDatas = append(req.TableDatas, obj)
 	case *TableScopeRow:
 		req.TableScopes = append(req.TableScopes, obj)
+	case *SecondaryIndexRow:
+		req.SecondaryIndexes = append(req.SecondaryIndexes, obj)
 	default:
 		panic(fmt.Sprintf("unsupported writable row: %T", row))
 	}
 }
 
+// ramPayerRows returns the RAM payer mapping changes of the table data rows written,
+// the mapping of the previous payer being deleted when the row is deleted or moved
+// to another payer.
+func (req *WriteRequest) ramPayerRows() (out []*RAMPayerRow) {
+	for _, row := range req.TableDatas {
+		if row.OldPayer != 0 && (row.Deletion || row.OldPayer != row.Payer) {
+			out = append(out, &RAMPayerRow{
+				Payer:    row.OldPayer,
+				Account:  row.Account,
+				Table:    row.Table,
+				Scope:    row.Scope,
+				PrimKey:  row.PrimKey,
+				Deletion: true,
+			})
+		}
+
+		if !row.Deletion {
+			out = append(out, newRAMPayerRow(row))
+		}
+	}
+
+	return
+}
+
 func (req *WriteRequest) AllWritableRows() (out []writableRow) {
+	for _, el := range req.AccountPermissions {
+		out = append(out, el)
+	}
+
+	for _, el := range req.AccountResourceLimits {
+		out = append(out, el)
+	}
+
 	for _, el := range req.AuthLinks {
 		out = append(out, el)
 	}
@@ -158,6 +444,10 @@ func (req *WriteRequest) AllWritableRows() (out []writableRow) {
 		out = append(out, el)
 	}
 
+	for _, el := range req.SecondaryIndexes {
+		out = append(out, el)
+	}
+
 	return
 }
 
@@ -173,6 +463,72 @@ type writableRow interface {
 	buildData() []byte
 }
 
+// AccountPermissionRow holds one of an account's permissions, `Data` being the
+// protobuf encoded `pbcodec.PermissionObject` (nil on deletion).
+type AccountPermissionRow struct {
+	Account    uint64
+	Permission uint64
+	Deletion   bool
+	Data       []byte
+}
+
+func (r *AccountPermissionRow) tableKey() string {
+	return fmt.Sprintf("ap:%016x", r.Account)
+}
+
+func (r *AccountPermissionRow) rowKey(blockNum uint32) string {
+	return fmt.Sprintf("%s:%08x:%s", r.tableKey(), blockNum, r.primKey())
+}
+
+func (r *AccountPermissionRow) primKey() string {
+	return fmt.Sprintf("%016x", r.Permission)
+}
+
+func (r *AccountPermissionRow) isDeletion() bool {
+	return r.Deletion
+}
+
+func (r *AccountPermissionRow) buildData() []byte {
+	return r.Data
+}
+
+type AccountResourceLimitKind byte
+
+const (
+	AccountResourceLimitKindLimits AccountResourceLimitKind = iota
+	AccountResourceLimitKindUsage
+)
+
+// AccountResourceLimitRow holds either the resource limits of an account or its
+// resource usage, depending on `Kind`. `Data` is the protobuf encoded
+// `pbcodec.RlimitAccountLimits` or `pbcodec.RlimitAccountUsage`. Those are never
+// deleted, nodeos keeps them around as long as the account exists.
+type AccountResourceLimitRow struct {
+	Account uint64
+	Kind    AccountResourceLimitKind
+	Data    []byte
+}
+
+func (r *AccountResourceLimitRow) tableKey() string {
+	return fmt.Sprintf("arl:%016x", r.Account)
+}
+
+func (r *AccountResourceLimitRow) rowKey(blockNum uint32) string {
+	return fmt.Sprintf("%s:%08x:%s", r.tableKey(), blockNum, r.primKey())
+}
+
+func (r *AccountResourceLimitRow) primKey() string {
+	return fmt.Sprintf("%02x", byte(r.Kind))
+}
+
+func (r *AccountResourceLimitRow) isDeletion() bool {
+	return false
+}
+
+func (r *AccountResourceLimitRow) buildData() []byte {
+	return r.Data
+}
+
 type AuthLinkRow struct {
 	Deletion bool
 
@@ -235,8 +591,11 @@ func (r *KeyAccountRow) buildData() []byte {
 type TableDataRow struct {
 	Account, Scope, Table, PrimKey uint64
 	Payer                          uint64
-	Deletion                       bool
-	Data                           []byte
+	// OldPayer is the payer of the row before the block, 0 when the row did not
+	// exist, it is only used to keep the RAM payer mapping in sync
+	OldPayer uint64
+	Deletion bool
+	Data     []byte
 }
 
 func (t *TableDataRow) tableKey() string {
@@ -262,6 +621,49 @@ func (t *TableDataRow) buildData() []byte {
 	return value
 }
 
+// RAMPayerRow maps a contract table row to the account paying the RAM it uses,
+// `ByteCount` being the size of the row payload (payer and data). Those are
+// derived from the table data rows when writing a block, see `writeBlock`.
+type RAMPayerRow struct {
+	Payer                          uint64
+	Account, Table, Scope, PrimKey uint64
+	ByteCount                      uint32
+	Deletion                       bool
+}
+
+func newRAMPayerRow(row *TableDataRow) *RAMPayerRow {
+	return &RAMPayerRow{
+		Payer:     row.Payer,
+		Account:   row.Account,
+		Table:     row.Table,
+		Scope:     row.Scope,
+		PrimKey:   row.PrimKey,
+		ByteCount: uint32(len(row.Data) + 8),
+	}
+}
+
+func (r *RAMPayerRow) tableKey() string {
+	return fmt.Sprintf("rp:%016x", r.Payer)
+}
+
+func (r *RAMPayerRow) rowKey(blockNum uint32) string {
+	return fmt.Sprintf("%s:%08x:%s", r.tableKey(), blockNum, r.primKey())
+}
+
+func (r *RAMPayerRow) primKey() string {
+	return fmt.Sprintf("%016x:%016x:%016x:%016x", r.Account, r.Table, r.Scope, r.PrimKey)
+}
+
+func (r *RAMPayerRow) isDeletion() bool {
+	return r.Deletion
+}
+
+func (r *RAMPayerRow) buildData() []byte {
+	value := make([]byte, 4)
+	big.PutUint32(value, r.ByteCount)
+	return value
+}
+
 type TableScopeRow struct {
 	Account, Scope, Table uint64
 	Deletion              bool
@@ -291,6 +693,43 @@ func (t *TableScopeRow) buildData() []byte {
 	return value
 }
 
+// SecondaryIndexRow maps a table row's primary key to its secondary key for
+// one of the table's secondary indexes. The key is kept in its sortable form
+// so bounds can be compared byte-wise. Entries are stored under their secondary
+// key followed by their primary key, changing the secondary key of a row is
+// then the deletion of its previous entry and the insertion of a new one, both
+// rows carrying their `Key`.
+type SecondaryIndexRow struct {
+	Account, Scope, Table, PrimKey uint64
+	IndexPosition                  uint8
+	Kind                           SecondaryIndexKind
+	Deletion                       bool
+	Key                            []byte
+}
+
+func (r *SecondaryIndexRow) tableKey() string {
+	return fmt.Sprintf("si:%016x:%016x:%016x:%02x", r.Account, r.Table, r.Scope, r.IndexPosition)
+}
+
+func (r *SecondaryIndexRow) rowKey(blockNum uint32) string {
+	return fmt.Sprintf("%s:%08x:%s", r.tableKey(), blockNum, r.primKey())
+}
+
+func (r *SecondaryIndexRow) primKey() string {
+	return secondaryIndexEntryKey(r.Key, r.PrimKey)
+}
+
+func (r *SecondaryIndexRow) isDeletion() bool {
+	return r.Deletion
+}
+
+func (r *SecondaryIndexRow) buildData() []byte {
+	value := make([]byte, len(r.Key)+1)
+	value[0] = byte(r.Kind)
+	copy(value[1:], r.Key)
+	return value
+}
+
 type ABIRow struct {
 	Account   uint64
 	BlockNum  uint32 // in Read operation only
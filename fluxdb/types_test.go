@@ -23,6 +23,53 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestAccountPermission_RowKey(t *testing.T) {
+	tests := []struct {
+		row      *AccountPermissionRow
+		blockNum uint32
+		expected string
+	}{
+		{
+			&AccountPermissionRow{N("eosio"), N("active"), false, nil},
+			2,
+			"ap:5530ea0000000000:00000002:3232eda800000000",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			actual := test.row.rowKey(test.blockNum)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestAccountResourceLimit_RowKey(t *testing.T) {
+	tests := []struct {
+		row      *AccountResourceLimitRow
+		blockNum uint32
+		expected string
+	}{
+		{
+			&AccountResourceLimitRow{N("eosio"), AccountResourceLimitKindLimits, nil},
+			2,
+			"arl:5530ea0000000000:00000002:00",
+		},
+		{
+			&AccountResourceLimitRow{N("eosio"), AccountResourceLimitKindUsage, nil},
+			2,
+			"arl:5530ea0000000000:00000002:01",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			actual := test.row.rowKey(test.blockNum)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
 func TestAuthLink_BuildData(t *testing.T) {
 	updatedRow := &AuthLinkRow{
 		PermissionName: N(""),
@@ -82,7 +129,7 @@ func TestTableData_RowKey(t *testing.T) {
 		expected string
 	}{
 		{
-			&TableDataRow{N("eosio"), N("scope"), N("table"), N("key"), N("payer"), false, nil},
+			&TableDataRow{N("eosio"), N("scope"), N("table"), N("key"), N("payer"), 0, false, nil},
 			0,
 			"td:5530ea0000000000:c98f150000000000:c229550000000000:00000000:82bc000000000000",
 		},
@@ -96,6 +143,27 @@ func TestTableData_RowKey(t *testing.T) {
 	}
 }
 
+func TestSecondaryIndex_RowKey(t *testing.T) {
+	tests := []struct {
+		row      *SecondaryIndexRow
+		blockNum uint32
+		expected string
+	}{
+		{
+			&SecondaryIndexRow{N("eosio"), N("scope"), N("table"), N("key"), 2, SecondaryIndexKindUint64, false, []byte{0, 0, 0, 0, 0, 0, 0x01, 0x02}},
+			0,
+			"si:5530ea0000000000:c98f150000000000:c229550000000000:02:00000000:000000000000010200000000000000000000000000000000000000000000000082bc000000000000",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			actual := test.row.rowKey(test.blockNum)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
 func TestTableScope_RowKey(t *testing.T) {
 	tests := []struct {
 		row      *TableScopeRow
@@ -82,6 +82,17 @@ func explodeWritableRowKey(rowKey string) (tableKey string, blockNum uint32, pri
 		blockNum, err = keyChunkToBlockNum(parts[2])
 		primKey = strings.Join(parts[3:5], ":")
 
+	// AccountPermission ap:<account>:<blockNum>:<permission>
+	case parts[0] == "ap":
+		if partCount != 4 {
+			err = fmt.Errorf("account permission row key should have 4 parts, got %d", partCount)
+			return
+		}
+
+		tableKey = strings.Join(parts[0:2], ":")
+		blockNum, err = keyChunkToBlockNum(parts[2])
+		primKey = parts[3]
+
 	// AccountResourceLimit arl:<account>:<blockNum>:<primaryKey>
 	case parts[0] == "arl":
 		if partCount != 4 {
@@ -115,6 +126,17 @@ func explodeWritableRowKey(rowKey string) (tableKey string, blockNum uint32, pri
 		blockNum, err = keyChunkToBlockNum(parts[2])
 		primKey = strings.Join(parts[3:5], ":")
 
+	// RAMPayer rp:<payer>:<blockNum>:<account>:<table>:<scope>:<rowPrimaryKey>
+	case parts[0] == "rp":
+		if partCount != 7 {
+			err = fmt.Errorf("ram payer row key should have 7 parts, got %d", partCount)
+			return
+		}
+
+		tableKey = strings.Join(parts[0:2], ":")
+		blockNum, err = keyChunkToBlockNum(parts[2])
+		primKey = strings.Join(parts[3:7], ":")
+
 	// TableData td:<account>:<table>:<scope>:<blockNum>:<rowPrimaryKey>
 	case parts[0] == "td":
 		if partCount != 6 {
@@ -126,6 +148,17 @@ func explodeWritableRowKey(rowKey string) (tableKey string, blockNum uint32, pri
 		blockNum, err = keyChunkToBlockNum(parts[4])
 		primKey = parts[5]
 
+	// SecondaryIndex si:<account>:<table>:<scope>:<indexPosition>:<blockNum>:<secondaryKey><rowPrimaryKey>
+	case parts[0] == "si":
+		if partCount != 7 {
+			err = fmt.Errorf("secondary index row key should have 7 parts, got %d", partCount)
+			return
+		}
+
+		tableKey = strings.Join(parts[0:5], ":")
+		blockNum, err = keyChunkToBlockNum(parts[5])
+		primKey = parts[6]
+
 	// TableScope ts:<account>:<table>:<blockNum>:<scope>
 	case parts[0] == "ts":
 		if partCount != 5 {
@@ -144,6 +177,33 @@ func explodeWritableRowKey(rowKey string) (tableKey string, blockNum uint32, pri
 	return
 }
 
+// primaryKeyInRange checks that a row key primary key is within the inclusive
+// `[lower, upper]` range, an empty bound meaning no restriction on that side.
+// Primary keys of a given table all have the same fixed width hexadecimal
+// form, so comparing them as strings respects their numerical order.
+func primaryKeyInRange(primaryKey, lower, upper string) bool {
+	if lower != "" && primaryKey < lower {
+		return false
+	}
+
+	if upper != "" && primaryKey > upper {
+		return false
+	}
+
+	return true
+}
+
+// nextPrimaryKey returns the hex encoded primary key directly following
+// `primaryKey`, `ok` being false when it's already the highest one.
+func nextPrimaryKey(primaryKey string) (next string, ok bool) {
+	value, err := strconv.ParseUint(primaryKey, 16, 64)
+	if err != nil || value == math.MaxUint64 {
+		return "", false
+	}
+
+	return fmt.Sprintf("%016x", value+1), true
+}
+
 // chunkKeyRevBlockNum returns the actual block num out of a
 // reverse-encoded block num
 func chunkKeyRevBlockNum(key string, prefixKey string) (blockNum uint32, err error) {
@@ -18,11 +18,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
 	"strconv"
 	"testing"
 
 	"cloud.google.com/go/bigtable/bttest"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb/store"
 	"github.com/dfuse-io/dfuse-eosio/fluxdb/store/bigt"
+	_ "github.com/dfuse-io/kvdb/store/badger"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/api/option"
@@ -63,6 +68,22 @@ func TestExplodeRowKey(t *testing.T) {
 			expected{err: &strconv.NumError{Func: "ParseUint", Num: "0000000G", Err: errors.New("invalid syntax")}},
 		},
 
+		{
+			"account_permission",
+			"ap:0000000000000003:00000004:0000000000000001",
+			expected{"ap:0000000000000003", 4, "0000000000000001", nil},
+		},
+		{
+			"account_permission/wrong_part_count",
+			"ap:0000000000000003",
+			expected{err: errors.New("account permission row key should have 4 parts, got 2")},
+		},
+		{
+			"account_permission/wrong_block_num",
+			"ap:0000000000000003:0000000G:0000000000000001",
+			expected{err: &strconv.NumError{Func: "ParseUint", Num: "0000000G", Err: errors.New("invalid syntax")}},
+		},
+
 		{
 			"account_resource_limit",
 			"arl:eosio:00000004:limits",
@@ -126,6 +147,17 @@ func TestExplodeRowKey(t *testing.T) {
 			expected{err: &strconv.NumError{Func: "ParseUint", Num: "0000000G", Err: errors.New("invalid syntax")}},
 		},
 
+		{
+			"ram_payer",
+			"rp:0000000000000001:00000004:0000000000000002:0000000000000003:0000000000000004:0000000000000005",
+			expected{"rp:0000000000000001", 4, "0000000000000002:0000000000000003:0000000000000004:0000000000000005", nil},
+		},
+		{
+			"ram_payer/wrong_part_count",
+			"rp:0000000000000001:00000004:0000000000000002",
+			expected{err: errors.New("ram payer row key should have 7 parts, got 4")},
+		},
+
 		{
 			"table_data",
 			"td:0000000000000001:0000000000000002:0000000000000003:00000004:0000000000000005",
@@ -147,6 +179,17 @@ func TestExplodeRowKey(t *testing.T) {
 			expected{err: &strconv.NumError{Func: "ParseUint", Num: "0000000G", Err: errors.New("invalid syntax")}},
 		},
 
+		{
+			"secondary_index",
+			"si:0000000000000001:0000000000000002:0000000000000003:01:00000004:0000000000000005",
+			expected{"si:0000000000000001:0000000000000002:0000000000000003:01", 4, "0000000000000005", nil},
+		},
+		{
+			"secondary_index/wrong_part_count",
+			"si:0000000000000001:0000000000000002:0000000000000003:00000004:0000000000000005",
+			expected{err: errors.New("secondary index row key should have 7 parts, got 6")},
+		},
+
 		{
 			"table_scope",
 			"ts:0000000000000001:0000000000000002:00000004:0000000000000005",
@@ -242,3 +285,19 @@ func NewTestDB(t *testing.T) (*FluxDB, func()) {
 
 	return db, closer
 }
+
+// newTestBadgerKVStore returns a badger store, the default storage engine, which cannot delete keys
+func newTestBadgerKVStore(t *testing.T) (store.KVStore, func()) {
+	dir, err := ioutil.TempDir("", "fluxdb-badger")
+	require.NoError(t, err)
+
+	kvStore, err := NewKVStore(fmt.Sprintf("badger://%s", path.Join(dir, "flux.db")))
+	require.NoError(t, err)
+
+	closer := func() {
+		kvStore.Close()
+		os.RemoveAll(dir)
+	}
+
+	return kvStore, closer
+}
@@ -35,7 +35,17 @@ func (fdb *FluxDB) WriteBatch(ctx context.Context, w []*WriteRequest) error {
 		return derr.Wrap(err, "next block check")
 	}
 
+	// The rows are committed in more than one flush, and a flush is not atomic on all
+	// stores. The pending write is recorded first, so a crash leaving only part of the
+	// rows behind can be repaired, see `RepairPartialWrites`.
+	if err := fdb.writePendingWrite(ctx, newPendingWrite(w)); err != nil {
+		return derr.Wrap(err, "write pending write")
+	}
+
 	batch := fdb.store.NewBatch(zlog)
+	if err := fdb.markRAMPayerStart(ctx, batch, w[0].BlockNum); err != nil {
+		return derr.Wrap(err, "mark ram payer start")
+	}
 
 	for _, req := range w {
 
@@ -52,6 +62,14 @@ func (fdb *FluxDB) WriteBatch(ctx context.Context, w []*WriteRequest) error {
 		return derr.Wrap(err, "flush")
 	}
 
+	// Only once all rows are committed is the last written block moved forward, readers
+	// never go past it, so they never see the rows of a block not fully written.
+	lastBlock := w[len(w)-1]
+	batch.SetLast(fdb.lastBlockKey(), []byte(hex.EncodeToString(lastBlock.BlockID)))
+	if err := batch.Flush(ctx); err != nil {
+		return derr.Wrap(err, "flush last written block")
+	}
+
 	if sched := fdb.idxCache.IndexingSchedule(); len(sched) != 0 {
 		err := fdb.IndexTables(ctx)
 		if err != nil {
@@ -106,18 +124,15 @@ func (fdb *FluxDB) VerifyAllShardsWritten() error {
 
 func (fdb *FluxDB) writeBlock(ctx context.Context, batch store.Batch, w *WriteRequest) (err error) {
 	for _, row := range w.AllWritableRows() {
-		var value []byte
-		if !row.isDeletion() {
-			value = row.buildData()
-		}
-
-		batch.SetRow(row.rowKey(w.BlockNum), value)
+		fdb.writeRow(batch, w.BlockNum, row, true)
+	}
 
-		tableKey := row.tableKey()
-		fdb.idxCache.IncCount(tableKey)
-		if fdb.idxCache.shouldTriggerIndexing(tableKey) {
-			fdb.idxCache.ScheduleIndex(tableKey, w.BlockNum)
-		}
+	// Table rows are also mapped by RAM payer, the mapping of the previous payer being
+	// deleted when a row is deleted or moved to another payer. When sharding, a payer's
+	// rows come from tables spread over all shards, an index built by a single shard
+	// would miss the others' rows, so indexing is left to the live process.
+	for _, row := range w.ramPayerRows() {
+		fdb.writeRow(batch, w.BlockNum, row, !fdb.IsSharding())
 	}
 
 	for _, abi := range w.ABIs {
@@ -126,7 +141,40 @@ func (fdb *FluxDB) writeBlock(ctx context.Context, batch store.Batch, w *WriteRe
 		batch.SetABI(key, abi.PackedABI)
 	}
 
-	batch.SetLast(fdb.lastBlockKey(), []byte(hex.EncodeToString(w.BlockID)))
+	return nil
+}
 
+// markRAMPayerStart records, when writing the first block of an empty database, that the
+// RAM payer mappings cover all rows from that block on. A database written before the
+// mappings were introduced has no such marker, its mappings must be backfilled.
+func (fdb *FluxDB) markRAMPayerStart(ctx context.Context, batch store.Batch, firstBlockNum uint32) error {
+	if fdb.ramPayerStartChecked {
+		return nil
+	}
+
+	// Shards have their own last block key, the database is only empty when the main one is missing
+	_, err := fdb.store.FetchLastWrittenBlock(ctx, lastBlockRowKey)
+	if err == store.ErrNotFound {
+		batch.SetMarker(ramPayerStartBlockMarkerKey, []byte(HexBlockNum(firstBlockNum)))
+	} else if err != nil {
+		return derr.Wrap(err, "fetching last written block")
+	}
+
+	fdb.ramPayerStartChecked = true
 	return nil
 }
+
+func (fdb *FluxDB) writeRow(batch store.Batch, blockNum uint32, row writableRow, scheduleIndexing bool) {
+	var value []byte
+	if !row.isDeletion() {
+		value = row.buildData()
+	}
+
+	batch.SetRow(row.rowKey(blockNum), value)
+
+	tableKey := row.tableKey()
+	fdb.idxCache.IncCount(tableKey)
+	if scheduleIndexing && fdb.idxCache.shouldTriggerIndexing(tableKey) {
+		fdb.idxCache.ScheduleIndex(tableKey, blockNum)
+	}
+}
@@ -30,18 +30,20 @@ import (
 )
 
 type Config struct {
-	ChainId                   string // Chain ID
-	ProcessingType            string // The actual processing type to perform, either `live`, `batch` or `patch`
-	BlockStoreURL             string // GS path to read batch files from
-	BlockStreamAddr           string // [LIVE] Address of grpc endpoint
-	KvdbDsn                   string // Storage connection string
-	BatchSize                 uint64 // DB batch size
-	StartBlockNum             uint64 // [BATCH] Block number where we start processing
-	StopBlockNum              uint64 // [BATCH] Block number where we stop processing
-	NumBlocksBeforeStart      uint64 // [BATCH] Number of blocks to fetch before start block
-	ParallelFileDownloadCount int    // Number of threads of parallel file download
-	AllowLiveOnEmptyTable     bool   // [LIVE] force pipeline creation if live request and table is empty
-	HTTPListenAddr            string //  http listen address for /healthz endpoint
+	ChainId                   string        // Chain ID
+	ProcessingType            string        // The actual processing type to perform, either `live`, `batch` or `patch`
+	BlockStoreURL             string        // GS path to read batch files from
+	BlockStreamAddr           string        // [LIVE] Address of grpc endpoint
+	KvdbDsn                   string        // Storage connection string
+	BatchSize                 uint64        // DB batch size
+	StartBlockNum             uint64        // [BATCH] Block number where we start processing
+	StopBlockNum              uint64        // [BATCH] Block number where we stop processing
+	NumBlocksBeforeStart      uint64        // [BATCH] Number of blocks to fetch before start block
+	ParallelFileDownloadCount int           // Number of threads of parallel file download
+	AllowLiveOnEmptyTable     bool          // [LIVE] force pipeline creation if live request and table is empty
+	HTTPListenAddr            string        //  http listen address for /healthz endpoint
+	ForkPruningInterval       time.Duration // [LIVE] Interval at which forked out blocks below LIB are deleted, 0 disables it
+	ForkPruningLookback       uint32        // [LIVE] Number of blocks below the LIB at startup from which fork pruning starts, older forks are never pruned
 }
 
 type App struct {
@@ -126,6 +128,17 @@ func (a *App) Run() error {
 	a.OnTerminating(loader.Shutdown)
 	loader.OnTerminated(a.Shutdown)
 
+	if a.Config.ProcessingType == "live" && a.Config.ForkPruningInterval > 0 {
+		if pruner, ok := db.(eosdb.ForkPruner); ok {
+			zlog.Info("enabling fork pruning", zap.Duration("interval", a.Config.ForkPruningInterval), zap.Uint32("lookback", a.Config.ForkPruningLookback))
+			forkPruner := kvdbloader.NewForkPruner(db, pruner, a.Config.ForkPruningLookback)
+			a.OnTerminating(forkPruner.Shutdown)
+			go forkPruner.PruneEvery(a.Config.ForkPruningInterval)
+		} else {
+			zlog.Warn("fork pruning is not supported by the database driver, not enabling it", zap.String("dsn", a.Config.KvdbDsn))
+		}
+	}
+
 	go loader.Launch()
 	return nil
 }
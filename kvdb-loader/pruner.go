@@ -0,0 +1,119 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvdb_loader
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dfuse-io/dfuse-eosio/eosdb"
+	"github.com/dfuse-io/kvdb"
+	"github.com/dfuse-io/shutter"
+	"go.uber.org/zap"
+)
+
+// maxForkPruningBlockCount is the maximum number of block numbers pruned by a single call to the driver
+const maxForkPruningBlockCount = 1000
+
+// ForkPruner deletes, in the background, the blocks forked out below the last irreversible
+// block along with their transactions and traces.
+type ForkPruner struct {
+	*shutter.Shutter
+
+	db     eosdb.DBWriter
+	pruner eosdb.ForkPruner
+
+	lookbackBlockCount uint32
+	lastPrunedBlockNum uint32
+	initialized        bool
+}
+
+// NewForkPruner creates a pruner starting `lookbackBlockCount` blocks below the last
+// irreversible block found on its first run, forks older than that being left as is.
+func NewForkPruner(db eosdb.DBWriter, pruner eosdb.ForkPruner, lookbackBlockCount uint32) *ForkPruner {
+	return &ForkPruner{
+		Shutter:            shutter.New(),
+		db:                 db,
+		pruner:             pruner,
+		lookbackBlockCount: lookbackBlockCount,
+	}
+}
+
+func (p *ForkPruner) PruneEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.Terminating():
+			zlog.Info("terminating fork pruner via shutter")
+			return
+
+		case <-ticker.C:
+			prunedCount, err := p.PruneOnce(context.Background())
+			if err != nil {
+				zlog.Error("unable to prune forked blocks, will retry", zap.Error(err))
+			}
+
+			if prunedCount > 0 {
+				zlog.Info("pruned forked blocks", zap.Int("pruned_count", prunedCount), zap.Uint32("last_pruned_block_num", p.lastPrunedBlockNum))
+			}
+		}
+	}
+}
+
+// PruneOnce prunes the forked blocks from the last pruned one up to the one right before
+// the last irreversible block, returning how many were deleted.
+func (p *ForkPruner) PruneOnce(ctx context.Context) (prunedCount int, err error) {
+	lib, err := p.db.GetLastWrittenIrreversibleBlockRef(ctx)
+	if err == kvdb.ErrNotFound {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("get last written irreversible block: %w", err)
+	}
+
+	if lib.Num() <= 1 {
+		return 0, nil
+	}
+
+	highBlockNum := uint32(lib.Num()) - 1
+	if !p.initialized {
+		p.initialized = true
+		if highBlockNum > p.lookbackBlockCount {
+			p.lastPrunedBlockNum = highBlockNum - p.lookbackBlockCount
+		}
+	}
+
+	for p.lastPrunedBlockNum < highBlockNum {
+		lowBlockNum := p.lastPrunedBlockNum + 1
+		toBlockNum := highBlockNum
+		if toBlockNum-lowBlockNum >= maxForkPruningBlockCount {
+			toBlockNum = lowBlockNum + maxForkPruningBlockCount - 1
+		}
+
+		count, err := p.pruner.PruneForkedBlocks(ctx, lowBlockNum, toBlockNum)
+		prunedCount += count
+		if err != nil {
+			return prunedCount, fmt.Errorf("prune forked blocks between #%d and #%d: %w", lowBlockNum, toBlockNum, err)
+		}
+
+		p.lastPrunedBlockNum = toBlockNum
+	}
+
+	return prunedCount, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2019 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvdb_loader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/dfuse-eosio/eosdb"
+	"github.com/dfuse-io/kvdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pruneRange struct {
+	low, high uint32
+}
+
+type testForkPrunerDB struct {
+	eosdb.DBWriter
+
+	lib    bstream.BlockRef
+	ranges []pruneRange
+}
+
+func (db *testForkPrunerDB) GetLastWrittenIrreversibleBlockRef(ctx context.Context) (bstream.BlockRef, error) {
+	if db.lib == nil {
+		return nil, kvdb.ErrNotFound
+	}
+
+	return db.lib, nil
+}
+
+func (db *testForkPrunerDB) PruneForkedBlocks(ctx context.Context, lowBlockNum, highBlockNum uint32) (int, error) {
+	db.ranges = append(db.ranges, pruneRange{lowBlockNum, highBlockNum})
+	return 1, nil
+}
+
+func TestForkPruner_PruneOnce(t *testing.T) {
+	ctx := context.Background()
+	db := &testForkPrunerDB{}
+	pruner := NewForkPruner(db, db, 1500)
+
+	prunedCount, err := pruner.PruneOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, prunedCount, "nothing irreversible yet")
+
+	// First run starts at the lookback below the last irreversible block, by chunks
+	db.lib = bstream.NewBlockRef("00000fa1aa", 4001)
+	prunedCount, err = pruner.PruneOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, prunedCount)
+	assert.Equal(t, []pruneRange{{2501, 3500}, {3501, 4000}}, db.ranges)
+
+	// Nothing new irreversible
+	db.ranges = nil
+	prunedCount, err = pruner.PruneOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, prunedCount)
+	assert.Nil(t, db.ranges)
+
+	// Continues right after the last pruned block
+	db.lib = bstream.NewBlockRef("00000fabaa", 4011)
+	_, err = pruner.PruneOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []pruneRange{{4001, 4010}}, db.ranges)
+}
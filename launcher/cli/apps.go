@@ -403,6 +403,20 @@ to find how to install it.`)
 			cmd.Flags().Bool("fluxdb-enable-dev-mode", false, "Enable dev mode, enables fluxdb without a live pipeline (**do not** use this in prod)")
 			cmd.Flags().Int("fluxdb-max-threads", 2, "Number of threads of parallel processing")
 			cmd.Flags().String("fluxdb-http-listen-addr", FluxDBServingAddr, "Address to listen for incoming http requests")
+			cmd.Flags().String("fluxdb-grpc-listen-addr", FluxDBGRPCServingAddr, "Address to listen for incoming gRPC requests")
+			cmd.Flags().Int("fluxdb-abi-decoder-cache-size", 1000, "Number of decoded ABIs (along with their table decoders) kept in memory to decode rows to JSON, 0 disables the cache")
+			cmd.Flags().Int("fluxdb-decoded-row-cache-bytes", 100*1024*1024, "Bytes of rows decoded to JSON (binary and JSON forms) kept in memory to serve them again without decoding, 0 disables the cache")
+			cmd.Flags().Bool("fluxdb-enable-secondary-index-reads", false, "Serves /v0/state/table reads through secondary indexes (index_position above 1). Secondary indexes are recorded from SEC_IDX_OP deep-mind lines, which stock deep-mind nodeos does not emit, only enable it when blocks come from a nodeos instrumented to emit them")
+			cmd.Flags().String("fluxdb-snapshots-store", "", "Store URL where state snapshots are written to and imported from, snapshots are disabled when empty")
+			cmd.Flags().Uint32("fluxdb-snapshot-interval-blocks", 0, "Export a state snapshot each time this many blocks have been written, 0 disables the export")
+			cmd.Flags().Bool("fluxdb-enable-snapshot-import", false, "Imports the latest snapshot of the snapshots store when the database is empty, instead of processing from the first block")
+			cmd.Flags().Uint32("fluxdb-prune-before-block", 0, "When non-zero, prunes the history of all rows before this block (only the latest version of each row is kept) then exits, reads below this block are then rejected. Requires a store able to delete keys, the kvdb ones (badger, tikv, bigkv) cannot")
+			cmd.Flags().Bool("fluxdb-backfill-ram-payer-rows", false, "Backfills the RAM payer mappings of a database written before they were introduced (or imported from a snapshot) then exits, /v0/state/ram_payer_rows rejects reads until it's done. Nothing must write to the database while it runs")
+			cmd.Flags().String("fluxdb-catch-up-shards-store", "", "Store URL where the catch-up writes its shards, when set, a database that is behind is caught up from the merged blocks files by parallel workers before the live pipeline takes over")
+			cmd.Flags().Int("fluxdb-catch-up-shard-count", 4, "Number of shards used by the catch-up, each shard being injected by its own worker, must not change while resuming an interrupted catch-up")
+			cmd.Flags().Uint32("fluxdb-catch-up-range-blocks", 25000, "Number of blocks in each range sharded by a single catch-up worker, a range is kept in memory until it's fully sharded")
+			cmd.Flags().Int("fluxdb-catch-up-parallel-ranges", 4, "Number of ranges sharded in parallel by the catch-up")
+			cmd.Flags().Uint32("fluxdb-catch-up-stop-block", 0, "Block up to which the catch-up runs, 0 means up to the last irreversible merged blocks (catch-up is then skipped when less than a range behind)")
 			return nil
 		},
 		InitFunc: func(config *launcher.BoxConfig, modules *launcher.RuntimeModules) error {
@@ -413,16 +427,41 @@ to find how to install it.`)
 			if err != nil {
 				return nil, err
 			}
+
+			snapshotsStoreURL := viper.GetString("fluxdb-snapshots-store")
+			if snapshotsStoreURL != "" {
+				snapshotsStoreURL = buildStoreURL(viper.GetString("global-data-dir"), snapshotsStoreURL)
+			}
+
+			catchUpShardsStoreURL := viper.GetString("fluxdb-catch-up-shards-store")
+			if catchUpShardsStoreURL != "" {
+				catchUpShardsStoreURL = buildStoreURL(viper.GetString("global-data-dir"), catchUpShardsStoreURL)
+			}
+
 			return fluxdbApp.New(&fluxdbApp.Config{
-				EnableServerMode:   viper.GetBool("fluxdb-enable-server-mode"),
-				EnableInjectMode:   viper.GetBool("fluxdb-enable-inject-mode"),
-				StoreDSN:           fmt.Sprintf(viper.GetString("fluxdb-kvdb-store-dsn"), absDataDir),
-				EnableLivePipeline: viper.GetBool("fluxdb-live"),
-				BlockStreamAddr:    viper.GetString("fluxdb-block-stream-addr"),
-				BlockStoreURL:      buildStoreURL(viper.GetString("global-data-dir"), viper.GetString("fluxdb-blocks-store")),
-				EnableDevMode:      viper.GetBool("fluxdb-enable-dev-mode"),
-				ThreadsNum:         viper.GetInt("fluxdb-max-threads"),
-				HTTPListenAddr:     viper.GetString("fluxdb-http-listen-addr"),
+				EnableServerMode:          viper.GetBool("fluxdb-enable-server-mode"),
+				EnableInjectMode:          viper.GetBool("fluxdb-enable-inject-mode"),
+				StoreDSN:                  fmt.Sprintf(viper.GetString("fluxdb-kvdb-store-dsn"), absDataDir),
+				EnableLivePipeline:        viper.GetBool("fluxdb-live"),
+				BlockStreamAddr:           viper.GetString("fluxdb-block-stream-addr"),
+				BlockStoreURL:             buildStoreURL(viper.GetString("global-data-dir"), viper.GetString("fluxdb-blocks-store")),
+				EnableDevMode:             viper.GetBool("fluxdb-enable-dev-mode"),
+				ThreadsNum:                viper.GetInt("fluxdb-max-threads"),
+				HTTPListenAddr:            viper.GetString("fluxdb-http-listen-addr"),
+				GRPCListenAddr:            viper.GetString("fluxdb-grpc-listen-addr"),
+				ABIDecoderCacheSize:       viper.GetInt("fluxdb-abi-decoder-cache-size"),
+				DecodedRowCacheBytes:      viper.GetInt("fluxdb-decoded-row-cache-bytes"),
+				EnableSecondaryIndexReads: viper.GetBool("fluxdb-enable-secondary-index-reads"),
+				SnapshotsStoreURL:         snapshotsStoreURL,
+				SnapshotIntervalBlocks:    viper.GetUint32("fluxdb-snapshot-interval-blocks"),
+				EnableSnapshotImport:      viper.GetBool("fluxdb-enable-snapshot-import"),
+				PruneBeforeBlockNum:       viper.GetUint32("fluxdb-prune-before-block"),
+				BackfillRAMPayerRows:      viper.GetBool("fluxdb-backfill-ram-payer-rows"),
+				CatchUpShardsStoreURL:     catchUpShardsStoreURL,
+				CatchUpShardCount:         viper.GetInt("fluxdb-catch-up-shard-count"),
+				CatchUpRangeBlockCount:    viper.GetUint32("fluxdb-catch-up-range-blocks"),
+				CatchUpParallelRangeCount: viper.GetInt("fluxdb-catch-up-parallel-ranges"),
+				CatchUpStopBlockNum:       viper.GetUint32("fluxdb-catch-up-stop-block"),
 			}), nil
 		},
 	})
@@ -446,6 +485,8 @@ to find how to install it.`)
 			cmd.Flags().String("kvdb-loader-http-listen-addr", KvdbHTTPServingAddr, "Listen address for /healthz endpoint")
 			cmd.Flags().Int("kvdb-parallel-file-download-count", 2, "Maximum number of files to download in parallel")
 			cmd.Flags().Bool("kvdb-loader-allow-live-on-empty-table", true, "[LIVE] force pipeline creation if live request and table is empty")
+			cmd.Flags().Duration("kvdb-loader-fork-pruning-interval", 0, "[LIVE] Interval at which blocks forked out below the last irreversible block are deleted with their transactions, 0 disables fork pruning. Only supported by the sql and memory drivers")
+			cmd.Flags().Uint32("kvdb-loader-fork-pruning-lookback", 3600, "[LIVE] Number of blocks below the last irreversible block at startup from which fork pruning starts, forks older than that are never pruned")
 			return nil
 		},
 		InitFunc: func(config *launcher.BoxConfig, modules *launcher.RuntimeModules) error {
@@ -469,6 +510,8 @@ to find how to install it.`)
 				AllowLiveOnEmptyTable:     viper.GetBool("kvdb-loader-allow-live-on-empty-table"),
 				HTTPListenAddr:            viper.GetString("kvdb-loader-http-listen-addr"),
 				ParallelFileDownloadCount: viper.GetInt("kvdb-parallel-file-download-count"),
+				ForkPruningInterval:       viper.GetDuration("kvdb-loader-fork-pruning-interval"),
+				ForkPruningLookback:       viper.GetUint32("kvdb-loader-fork-pruning-lookback"),
 			}), nil
 		},
 	})
@@ -54,7 +54,7 @@ func Main() {
 	startCmd.SetHelpTemplate(fmt.Sprintf(startCmdHelpTemplate, strings.Join(availableCmds, "\n  ")))
 	startCmd.Example = startCmdExample
 
-	RootCmd.AddCommand(startCmd, purgeCmd, initCmd)
+	RootCmd.AddCommand(startCmd, purgeCmd, initCmd, toolsCmd)
 
 	RootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
 		setup()
@@ -26,6 +26,7 @@ import (
 	"github.com/dfuse-io/derr"
 	_ "github.com/dfuse-io/dfuse-eosio/codec"
 	_ "github.com/dfuse-io/dfuse-eosio/eosdb/kv"
+	_ "github.com/dfuse-io/dfuse-eosio/eosdb/memory"
 	"github.com/dfuse-io/dfuse-eosio/launcher"
 	core "github.com/dfuse-io/dfuse-eosio/launcher"
 	"github.com/dfuse-io/dfuse-eosio/metrics"
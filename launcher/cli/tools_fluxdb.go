@@ -0,0 +1,157 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	"github.com/dfuse-io/dstore"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var fluxdbShardInspectCmd = &cobra.Command{
+	Use:   "fluxdb-shard-inspect <shard-file-url>",
+	Short: "Prints the header and the write requests of a FluxDB shard file",
+	Example: `dfuseeos tools fluxdb-shard-inspect ./dfuse-data/fluxdb/shards/000/0000000001-0000025000
+dfuseeos tools fluxdb-shard-inspect gs://bucket/shards/002/0000025001-0000050000 --rows`,
+	Args: cobra.ExactArgs(1),
+	RunE: fluxdbShardInspectE,
+}
+
+var fluxdbCheckConsistencyCmd = &cobra.Command{
+	Use:   "fluxdb-check-consistency <dump-file>",
+	Short: "Compares FluxDB against a JSON dump of nodeos state (ABIs, table rows, table scopes and permission links) and reports differences",
+	Args:  cobra.ExactArgs(1),
+	RunE:  fluxdbCheckConsistencyE,
+}
+
+func init() {
+	fluxdbShardInspectCmd.Flags().Bool("rows", false, "Also print every row of each write request, in JSON")
+	fluxdbCheckConsistencyCmd.Flags().String("dsn", FluxDSN, "FluxDB kvdb store connection string to check")
+
+	toolsCmd.AddCommand(fluxdbShardInspectCmd, fluxdbCheckConsistencyCmd)
+}
+
+func fluxdbShardInspectE(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	baseURL, filename := splitShardFileURL(args[0])
+	store, err := dstore.NewSimpleStore(baseURL)
+	if err != nil {
+		return fmt.Errorf("unable to create store for %q: %w", baseURL, err)
+	}
+
+	reader, err := store.OpenObject(filename)
+	if err != nil {
+		return fmt.Errorf("unable to open shard file %q: %w", args[0], err)
+	}
+	defer reader.Close()
+
+	header, requests, err := fluxdb.ReadShard(reader)
+	if err != nil {
+		return fmt.Errorf("unable to read shard file %q: %w", args[0], err)
+	}
+
+	if header == nil {
+		fmt.Println("Legacy gob shard (no header)")
+	} else {
+		fmt.Printf("Shard %d of %d, blocks [%d, %d]\n", header.ShardIndex, header.ShardCount, header.StartBlock, header.StopBlock)
+	}
+
+	rowCount := 0
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	for _, request := range requests {
+		requestRowCount := len(request.AccountPermissions) + len(request.AccountResourceLimits) + len(request.AuthLinks) + len(request.KeyAccounts) +
+			len(request.TableDatas) + len(request.TableScopes) + len(request.SecondaryIndexes)
+		rowCount += requestRowCount
+
+		fmt.Printf("Block #%d (%s): %d ABI(s), %d row(s)\n", request.BlockNum, hex.EncodeToString(request.BlockId), len(request.Abis), requestRowCount)
+		if viper.GetBool("rows") {
+			if err := marshaler.Marshal(os.Stdout, request); err != nil {
+				return fmt.Errorf("unable to print write request of block #%d: %w", request.BlockNum, err)
+			}
+			fmt.Println()
+		}
+	}
+
+	fmt.Printf("Total: %d write request(s), %d row(s)\n", len(requests), rowCount)
+	return nil
+}
+
+func fluxdbCheckConsistencyE(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to open dump file: %w", err)
+	}
+	defer file.Close()
+
+	dump, err := fluxdb.ReadConsistencyDump(file)
+	if err != nil {
+		return err
+	}
+
+	absDataDir, err := filepath.Abs(viper.GetString("global-data-dir"))
+	if err != nil {
+		return err
+	}
+
+	kvStore, err := fluxdb.NewKVStore(fmt.Sprintf(viper.GetString("dsn"), absDataDir))
+	if err != nil {
+		return fmt.Errorf("unable to create kvdb store: %w", err)
+	}
+
+	db := fluxdb.New(kvStore)
+	defer db.Close()
+
+	report, err := db.CheckConsistency(context.Background(), dump)
+	if err != nil {
+		return fmt.Errorf("unable to check consistency: %w", err)
+	}
+
+	for _, difference := range report.Differences {
+		fmt.Println(difference)
+	}
+
+	fmt.Printf("Checked at block #%d: %d ABI(s), %d table(s) (%d row(s)), scopes of %d table(s), permission links of %d account(s)\n",
+		report.BlockNum, report.CheckedABIs, report.CheckedTables, report.CheckedTableRows, report.CheckedTableScopeTables, report.CheckedPermissionLinkAccounts)
+
+	if len(report.Differences) > 0 {
+		return fmt.Errorf("found %d difference(s)", len(report.Differences))
+	}
+
+	fmt.Println("No differences found")
+	return nil
+}
+
+// splitShardFileURL splits a shard file URL into the URL of the store holding it and
+// the file name within that store.
+func splitShardFileURL(fileURL string) (baseURL, filename string) {
+	index := strings.LastIndex(fileURL, "/")
+	if index == -1 {
+		return ".", fileURL
+	}
+
+	return fileURL[:index], fileURL[index+1:]
+}
@@ -245,7 +245,7 @@ func (x RAMOp_Operation) String() string {
 }
 
 func (RAMOp_Operation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{33, 0}
+	return fileDescriptor_3286b8d338e80dff, []int{34, 0}
 }
 
 type RAMOp_Namespace int32
@@ -294,7 +294,7 @@ func (x RAMOp_Namespace) String() string {
 }
 
 func (RAMOp_Namespace) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{33, 1}
+	return fileDescriptor_3286b8d338e80dff, []int{34, 1}
 }
 
 type RAMOp_Action int32
@@ -334,7 +334,7 @@ func (x RAMOp_Action) String() string {
 }
 
 func (RAMOp_Action) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{33, 2}
+	return fileDescriptor_3286b8d338e80dff, []int{34, 2}
 }
 
 type TableOp_Operation int32
@@ -362,7 +362,7 @@ func (x TableOp_Operation) String() string {
 }
 
 func (TableOp_Operation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{35, 0}
+	return fileDescriptor_3286b8d338e80dff, []int{36, 0}
 }
 
 type DTrxOp_Operation int32
@@ -402,7 +402,7 @@ func (x DTrxOp_Operation) String() string {
 }
 
 func (DTrxOp_Operation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{36, 0}
+	return fileDescriptor_3286b8d338e80dff, []int{37, 0}
 }
 
 type FeatureOp_Kind int32
@@ -430,7 +430,7 @@ func (x FeatureOp_Kind) String() string {
 }
 
 func (FeatureOp_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{38, 0}
+	return fileDescriptor_3286b8d338e80dff, []int{39, 0}
 }
 
 type PermOp_Operation int32
@@ -461,7 +461,7 @@ func (x PermOp_Operation) String() string {
 }
 
 func (PermOp_Operation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{40, 0}
+	return fileDescriptor_3286b8d338e80dff, []int{41, 0}
 }
 
 type RlimitOp_Operation int32
@@ -489,7 +489,7 @@ func (x RlimitOp_Operation) String() string {
 }
 
 func (RlimitOp_Operation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{48, 0}
+	return fileDescriptor_3286b8d338e80dff, []int{49, 0}
 }
 
 type Block struct {
@@ -2505,10 +2505,13 @@ type TransactionTrace struct {
 	// List of table creations/deletions
 	TableOps []*TableOp `protobuf:"bytes,24,rep,name=table_ops,json=tableOps,proto3" json:"table_ops,omitempty"`
 	// Tree of creation, rather than execution
-	CreationTree         []*CreationFlatNode `protobuf:"bytes,25,rep,name=creation_tree,json=creationTree,proto3" json:"creation_tree,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	CreationTree []*CreationFlatNode `protobuf:"bytes,25,rep,name=creation_tree,json=creationTree,proto3" json:"creation_tree,omitempty"`
+	// List of secondary index operations (idx64, idx128, idx256, idx_double
+	// and idx_long_double) this transaction entailed
+	DbSecondaryIndexOps  []*DBSecondaryIndexOp `protobuf:"bytes,27,rep,name=db_secondary_index_ops,json=dbSecondaryIndexOps,proto3" json:"db_secondary_index_ops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *TransactionTrace) Reset()         { *m = TransactionTrace{} }
@@ -2690,6 +2693,13 @@ func (m *TransactionTrace) GetCreationTree() []*CreationFlatNode {
 	return nil
 }
 
+func (m *TransactionTrace) GetDbSecondaryIndexOps() []*DBSecondaryIndexOp {
+	if m != nil {
+		return m.DbSecondaryIndexOps
+	}
+	return nil
+}
+
 type TransactionReceiptHeader struct {
 	Status               TransactionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=dfuse.eosio.codec.v1.TransactionStatus" json:"status,omitempty"`
 	CpuUsageMicroSeconds uint32            `protobuf:"varint,2,opt,name=cpu_usage_micro_seconds,json=cpuUsageMicroSeconds,proto3" json:"cpu_usage_micro_seconds,omitempty"`
@@ -3387,6 +3397,138 @@ func (m *DBOp) GetNewData() []byte {
 	return nil
 }
 
+type DBSecondaryIndexOp struct {
+	Operation   DBOp_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=dfuse.eosio.codec.v1.DBOp_Operation" json:"operation,omitempty"`
+	ActionIndex uint32         `protobuf:"varint,2,opt,name=action_index,json=actionIndex,proto3" json:"action_index,omitempty"`
+	Code        string         `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Scope       string         `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	// Name of the table the secondary index belongs to, the index number being
+	// stripped from the low 4 bits of the index table name and stored in `index_position`
+	TableName string `protobuf:"bytes,5,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// Zero-based position of the secondary index within the table's secondary indexes
+	IndexPosition uint32 `protobuf:"varint,6,opt,name=index_position,json=indexPosition,proto3" json:"index_position,omitempty"`
+	// One of `idx64`, `idx128`, `idx256`, `idx_double` or `idx_long_double`
+	IndexKind  string `protobuf:"bytes,7,opt,name=index_kind,json=indexKind,proto3" json:"index_kind,omitempty"`
+	PrimaryKey string `protobuf:"bytes,8,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	OldPayer   string `protobuf:"bytes,9,opt,name=old_payer,json=oldPayer,proto3" json:"old_payer,omitempty"`
+	NewPayer   string `protobuf:"bytes,10,opt,name=new_payer,json=newPayer,proto3" json:"new_payer,omitempty"`
+	// Secondary key bytes, as laid out in memory by nodeos (little endian words)
+	OldSecondaryKey      []byte   `protobuf:"bytes,11,opt,name=old_secondary_key,json=oldSecondaryKey,proto3" json:"old_secondary_key,omitempty"`
+	NewSecondaryKey      []byte   `protobuf:"bytes,12,opt,name=new_secondary_key,json=newSecondaryKey,proto3" json:"new_secondary_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBSecondaryIndexOp) Reset()         { *m = DBSecondaryIndexOp{} }
+func (m *DBSecondaryIndexOp) String() string { return proto.CompactTextString(m) }
+func (*DBSecondaryIndexOp) ProtoMessage()    {}
+func (*DBSecondaryIndexOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3286b8d338e80dff, []int{33}
+}
+
+func (m *DBSecondaryIndexOp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DBSecondaryIndexOp.Unmarshal(m, b)
+}
+func (m *DBSecondaryIndexOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DBSecondaryIndexOp.Marshal(b, m, deterministic)
+}
+func (m *DBSecondaryIndexOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBSecondaryIndexOp.Merge(m, src)
+}
+func (m *DBSecondaryIndexOp) XXX_Size() int {
+	return xxx_messageInfo_DBSecondaryIndexOp.Size(m)
+}
+func (m *DBSecondaryIndexOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBSecondaryIndexOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBSecondaryIndexOp proto.InternalMessageInfo
+
+func (m *DBSecondaryIndexOp) GetOperation() DBOp_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return DBOp_OPERATION_UNKNOWN
+}
+
+func (m *DBSecondaryIndexOp) GetActionIndex() uint32 {
+	if m != nil {
+		return m.ActionIndex
+	}
+	return 0
+}
+
+func (m *DBSecondaryIndexOp) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *DBSecondaryIndexOp) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+func (m *DBSecondaryIndexOp) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *DBSecondaryIndexOp) GetIndexPosition() uint32 {
+	if m != nil {
+		return m.IndexPosition
+	}
+	return 0
+}
+
+func (m *DBSecondaryIndexOp) GetIndexKind() string {
+	if m != nil {
+		return m.IndexKind
+	}
+	return ""
+}
+
+func (m *DBSecondaryIndexOp) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *DBSecondaryIndexOp) GetOldPayer() string {
+	if m != nil {
+		return m.OldPayer
+	}
+	return ""
+}
+
+func (m *DBSecondaryIndexOp) GetNewPayer() string {
+	if m != nil {
+		return m.NewPayer
+	}
+	return ""
+}
+
+func (m *DBSecondaryIndexOp) GetOldSecondaryKey() []byte {
+	if m != nil {
+		return m.OldSecondaryKey
+	}
+	return nil
+}
+
+func (m *DBSecondaryIndexOp) GetNewSecondaryKey() []byte {
+	if m != nil {
+		return m.NewSecondaryKey
+	}
+	return nil
+}
+
 type RAMOp struct {
 	Operation   RAMOp_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=dfuse.eosio.codec.v1.RAMOp_Operation" json:"operation,omitempty"`
 	ActionIndex uint32          `protobuf:"varint,2,opt,name=action_index,json=actionIndex,proto3" json:"action_index,omitempty"`
@@ -3406,7 +3548,7 @@ func (m *RAMOp) Reset()         { *m = RAMOp{} }
 func (m *RAMOp) String() string { return proto.CompactTextString(m) }
 func (*RAMOp) ProtoMessage()    {}
 func (*RAMOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{33}
+	return fileDescriptor_3286b8d338e80dff, []int{34}
 }
 
 func (m *RAMOp) XXX_Unmarshal(b []byte) error {
@@ -3497,7 +3639,7 @@ func (m *RAMCorrectionOp) Reset()         { *m = RAMCorrectionOp{} }
 func (m *RAMCorrectionOp) String() string { return proto.CompactTextString(m) }
 func (*RAMCorrectionOp) ProtoMessage()    {}
 func (*RAMCorrectionOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{34}
+	return fileDescriptor_3286b8d338e80dff, []int{35}
 }
 
 func (m *RAMCorrectionOp) XXX_Unmarshal(b []byte) error {
@@ -3562,7 +3704,7 @@ func (m *TableOp) Reset()         { *m = TableOp{} }
 func (m *TableOp) String() string { return proto.CompactTextString(m) }
 func (*TableOp) ProtoMessage()    {}
 func (*TableOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{35}
+	return fileDescriptor_3286b8d338e80dff, []int{36}
 }
 
 func (m *TableOp) XXX_Unmarshal(b []byte) error {
@@ -3645,7 +3787,7 @@ func (m *DTrxOp) Reset()         { *m = DTrxOp{} }
 func (m *DTrxOp) String() string { return proto.CompactTextString(m) }
 func (*DTrxOp) ProtoMessage()    {}
 func (*DTrxOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{36}
+	return fileDescriptor_3286b8d338e80dff, []int{37}
 }
 
 func (m *DTrxOp) XXX_Unmarshal(b []byte) error {
@@ -3751,7 +3893,7 @@ func (m *ExtDTrxOp) Reset()         { *m = ExtDTrxOp{} }
 func (m *ExtDTrxOp) String() string { return proto.CompactTextString(m) }
 func (*ExtDTrxOp) ProtoMessage()    {}
 func (*ExtDTrxOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{37}
+	return fileDescriptor_3286b8d338e80dff, []int{38}
 }
 
 func (m *ExtDTrxOp) XXX_Unmarshal(b []byte) error {
@@ -3821,7 +3963,7 @@ func (m *FeatureOp) Reset()         { *m = FeatureOp{} }
 func (m *FeatureOp) String() string { return proto.CompactTextString(m) }
 func (*FeatureOp) ProtoMessage()    {}
 func (*FeatureOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{38}
+	return fileDescriptor_3286b8d338e80dff, []int{39}
 }
 
 func (m *FeatureOp) XXX_Unmarshal(b []byte) error {
@@ -3882,7 +4024,7 @@ func (m *CreationFlatNode) Reset()         { *m = CreationFlatNode{} }
 func (m *CreationFlatNode) String() string { return proto.CompactTextString(m) }
 func (*CreationFlatNode) ProtoMessage()    {}
 func (*CreationFlatNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{39}
+	return fileDescriptor_3286b8d338e80dff, []int{40}
 }
 
 func (m *CreationFlatNode) XXX_Unmarshal(b []byte) error {
@@ -3931,7 +4073,7 @@ func (m *PermOp) Reset()         { *m = PermOp{} }
 func (m *PermOp) String() string { return proto.CompactTextString(m) }
 func (*PermOp) ProtoMessage()    {}
 func (*PermOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{40}
+	return fileDescriptor_3286b8d338e80dff, []int{41}
 }
 
 func (m *PermOp) XXX_Unmarshal(b []byte) error {
@@ -3994,7 +4136,7 @@ func (m *PermissionObject) Reset()         { *m = PermissionObject{} }
 func (m *PermissionObject) String() string { return proto.CompactTextString(m) }
 func (*PermissionObject) ProtoMessage()    {}
 func (*PermissionObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{41}
+	return fileDescriptor_3286b8d338e80dff, []int{42}
 }
 
 func (m *PermissionObject) XXX_Unmarshal(b []byte) error {
@@ -4056,7 +4198,7 @@ func (m *Permission) Reset()         { *m = Permission{} }
 func (m *Permission) String() string { return proto.CompactTextString(m) }
 func (*Permission) ProtoMessage()    {}
 func (*Permission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{42}
+	return fileDescriptor_3286b8d338e80dff, []int{43}
 }
 
 func (m *Permission) XXX_Unmarshal(b []byte) error {
@@ -4112,7 +4254,7 @@ func (m *Authority) Reset()         { *m = Authority{} }
 func (m *Authority) String() string { return proto.CompactTextString(m) }
 func (*Authority) ProtoMessage()    {}
 func (*Authority) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{43}
+	return fileDescriptor_3286b8d338e80dff, []int{44}
 }
 
 func (m *Authority) XXX_Unmarshal(b []byte) error {
@@ -4173,7 +4315,7 @@ func (m *KeyWeight) Reset()         { *m = KeyWeight{} }
 func (m *KeyWeight) String() string { return proto.CompactTextString(m) }
 func (*KeyWeight) ProtoMessage()    {}
 func (*KeyWeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{44}
+	return fileDescriptor_3286b8d338e80dff, []int{45}
 }
 
 func (m *KeyWeight) XXX_Unmarshal(b []byte) error {
@@ -4220,7 +4362,7 @@ func (m *PermissionLevel) Reset()         { *m = PermissionLevel{} }
 func (m *PermissionLevel) String() string { return proto.CompactTextString(m) }
 func (*PermissionLevel) ProtoMessage()    {}
 func (*PermissionLevel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{45}
+	return fileDescriptor_3286b8d338e80dff, []int{46}
 }
 
 func (m *PermissionLevel) XXX_Unmarshal(b []byte) error {
@@ -4267,7 +4409,7 @@ func (m *PermissionLevelWeight) Reset()         { *m = PermissionLevelWeight{} }
 func (m *PermissionLevelWeight) String() string { return proto.CompactTextString(m) }
 func (*PermissionLevelWeight) ProtoMessage()    {}
 func (*PermissionLevelWeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{46}
+	return fileDescriptor_3286b8d338e80dff, []int{47}
 }
 
 func (m *PermissionLevelWeight) XXX_Unmarshal(b []byte) error {
@@ -4314,7 +4456,7 @@ func (m *WaitWeight) Reset()         { *m = WaitWeight{} }
 func (m *WaitWeight) String() string { return proto.CompactTextString(m) }
 func (*WaitWeight) ProtoMessage()    {}
 func (*WaitWeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{47}
+	return fileDescriptor_3286b8d338e80dff, []int{48}
 }
 
 func (m *WaitWeight) XXX_Unmarshal(b []byte) error {
@@ -4366,7 +4508,7 @@ func (m *RlimitOp) Reset()         { *m = RlimitOp{} }
 func (m *RlimitOp) String() string { return proto.CompactTextString(m) }
 func (*RlimitOp) ProtoMessage()    {}
 func (*RlimitOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{48}
+	return fileDescriptor_3286b8d338e80dff, []int{49}
 }
 
 func (m *RlimitOp) XXX_Unmarshal(b []byte) error {
@@ -4486,7 +4628,7 @@ func (m *RlimitState) Reset()         { *m = RlimitState{} }
 func (m *RlimitState) String() string { return proto.CompactTextString(m) }
 func (*RlimitState) ProtoMessage()    {}
 func (*RlimitState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{49}
+	return fileDescriptor_3286b8d338e80dff, []int{50}
 }
 
 func (m *RlimitState) XXX_Unmarshal(b []byte) error {
@@ -4584,7 +4726,7 @@ func (m *RlimitConfig) Reset()         { *m = RlimitConfig{} }
 func (m *RlimitConfig) String() string { return proto.CompactTextString(m) }
 func (*RlimitConfig) ProtoMessage()    {}
 func (*RlimitConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{50}
+	return fileDescriptor_3286b8d338e80dff, []int{51}
 }
 
 func (m *RlimitConfig) XXX_Unmarshal(b []byte) error {
@@ -4648,7 +4790,7 @@ func (m *RlimitAccountLimits) Reset()         { *m = RlimitAccountLimits{} }
 func (m *RlimitAccountLimits) String() string { return proto.CompactTextString(m) }
 func (*RlimitAccountLimits) ProtoMessage()    {}
 func (*RlimitAccountLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{51}
+	return fileDescriptor_3286b8d338e80dff, []int{52}
 }
 
 func (m *RlimitAccountLimits) XXX_Unmarshal(b []byte) error {
@@ -4718,7 +4860,7 @@ func (m *RlimitAccountUsage) Reset()         { *m = RlimitAccountUsage{} }
 func (m *RlimitAccountUsage) String() string { return proto.CompactTextString(m) }
 func (*RlimitAccountUsage) ProtoMessage()    {}
 func (*RlimitAccountUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{52}
+	return fileDescriptor_3286b8d338e80dff, []int{53}
 }
 
 func (m *RlimitAccountUsage) XXX_Unmarshal(b []byte) error {
@@ -4780,7 +4922,7 @@ func (m *UsageAccumulator) Reset()         { *m = UsageAccumulator{} }
 func (m *UsageAccumulator) String() string { return proto.CompactTextString(m) }
 func (*UsageAccumulator) ProtoMessage()    {}
 func (*UsageAccumulator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{53}
+	return fileDescriptor_3286b8d338e80dff, []int{54}
 }
 
 func (m *UsageAccumulator) XXX_Unmarshal(b []byte) error {
@@ -4838,7 +4980,7 @@ func (m *ElasticLimitParameters) Reset()         { *m = ElasticLimitParameters{}
 func (m *ElasticLimitParameters) String() string { return proto.CompactTextString(m) }
 func (*ElasticLimitParameters) ProtoMessage()    {}
 func (*ElasticLimitParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{54}
+	return fileDescriptor_3286b8d338e80dff, []int{55}
 }
 
 func (m *ElasticLimitParameters) XXX_Unmarshal(b []byte) error {
@@ -4913,7 +5055,7 @@ func (m *Ratio) Reset()         { *m = Ratio{} }
 func (m *Ratio) String() string { return proto.CompactTextString(m) }
 func (*Ratio) ProtoMessage()    {}
 func (*Ratio) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{55}
+	return fileDescriptor_3286b8d338e80dff, []int{56}
 }
 
 func (m *Ratio) XXX_Unmarshal(b []byte) error {
@@ -4962,7 +5104,7 @@ func (m *Exception) Reset()         { *m = Exception{} }
 func (m *Exception) String() string { return proto.CompactTextString(m) }
 func (*Exception) ProtoMessage()    {}
 func (*Exception) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{56}
+	return fileDescriptor_3286b8d338e80dff, []int{57}
 }
 
 func (m *Exception) XXX_Unmarshal(b []byte) error {
@@ -5029,7 +5171,7 @@ func (m *Exception_LogMessage) Reset()         { *m = Exception_LogMessage{} }
 func (m *Exception_LogMessage) String() string { return proto.CompactTextString(m) }
 func (*Exception_LogMessage) ProtoMessage()    {}
 func (*Exception_LogMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{56, 0}
+	return fileDescriptor_3286b8d338e80dff, []int{57, 0}
 }
 
 func (m *Exception_LogMessage) XXX_Unmarshal(b []byte) error {
@@ -5089,7 +5231,7 @@ func (m *Exception_LogContext) Reset()         { *m = Exception_LogContext{} }
 func (m *Exception_LogContext) String() string { return proto.CompactTextString(m) }
 func (*Exception_LogContext) ProtoMessage()    {}
 func (*Exception_LogContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{56, 1}
+	return fileDescriptor_3286b8d338e80dff, []int{57, 1}
 }
 
 func (m *Exception_LogContext) XXX_Unmarshal(b []byte) error {
@@ -5182,7 +5324,7 @@ func (m *Feature) Reset()         { *m = Feature{} }
 func (m *Feature) String() string { return proto.CompactTextString(m) }
 func (*Feature) ProtoMessage()    {}
 func (*Feature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{57}
+	return fileDescriptor_3286b8d338e80dff, []int{58}
 }
 
 func (m *Feature) XXX_Unmarshal(b []byte) error {
@@ -5258,7 +5400,7 @@ func (m *SubjectiveRestrictions) Reset()         { *m = SubjectiveRestrictions{}
 func (m *SubjectiveRestrictions) String() string { return proto.CompactTextString(m) }
 func (*SubjectiveRestrictions) ProtoMessage()    {}
 func (*SubjectiveRestrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{58}
+	return fileDescriptor_3286b8d338e80dff, []int{59}
 }
 
 func (m *SubjectiveRestrictions) XXX_Unmarshal(b []byte) error {
@@ -5312,7 +5454,7 @@ func (m *Specification) Reset()         { *m = Specification{} }
 func (m *Specification) String() string { return proto.CompactTextString(m) }
 func (*Specification) ProtoMessage()    {}
 func (*Specification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{59}
+	return fileDescriptor_3286b8d338e80dff, []int{60}
 }
 
 func (m *Specification) XXX_Unmarshal(b []byte) error {
@@ -5368,7 +5510,7 @@ func (m *AccountCreationRef) Reset()         { *m = AccountCreationRef{} }
 func (m *AccountCreationRef) String() string { return proto.CompactTextString(m) }
 func (*AccountCreationRef) ProtoMessage()    {}
 func (*AccountCreationRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3286b8d338e80dff, []int{60}
+	return fileDescriptor_3286b8d338e80dff, []int{61}
 }
 
 func (m *AccountCreationRef) XXX_Unmarshal(b []byte) error {
@@ -5482,6 +5624,7 @@ func init() {
 	proto.RegisterType((*Extension)(nil), "dfuse.eosio.codec.v1.Extension")
 	proto.RegisterType((*TrxOp)(nil), "dfuse.eosio.codec.v1.TrxOp")
 	proto.RegisterType((*DBOp)(nil), "dfuse.eosio.codec.v1.DBOp")
+	proto.RegisterType((*DBSecondaryIndexOp)(nil), "dfuse.eosio.codec.v1.DBSecondaryIndexOp")
 	proto.RegisterType((*RAMOp)(nil), "dfuse.eosio.codec.v1.RAMOp")
 	proto.RegisterType((*RAMCorrectionOp)(nil), "dfuse.eosio.codec.v1.RAMCorrectionOp")
 	proto.RegisterType((*TableOp)(nil), "dfuse.eosio.codec.v1.TableOp")
@@ -5517,373 +5660,379 @@ func init() {
 func init() { proto.RegisterFile("dfuse/eosio/codec/v1/codec.proto", fileDescriptor_3286b8d338e80dff) }
 
 var fileDescriptor_3286b8d338e80dff = []byte{
-	// 5882 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7c, 0xc9, 0x6f, 0x23, 0xd9,
-	0x79, 0x78, 0x93, 0xe2, 0xfa, 0x91, 0x94, 0x4a, 0xaf, 0xb5, 0x50, 0xea, 0x4d, 0x5d, 0xe3, 0xe9,
-	0xe9, 0x19, 0x7b, 0xd4, 0xd3, 0x3d, 0x8b, 0x3d, 0xb6, 0xe7, 0xd7, 0x43, 0x91, 0xec, 0x91, 0xa6,
-	0x25, 0x52, 0x78, 0xa4, 0xba, 0xa7, 0xfd, 0xb3, 0x53, 0x28, 0x55, 0x3d, 0x49, 0xe5, 0x26, 0xab,
-	0xca, 0x55, 0x45, 0x2d, 0x46, 0x60, 0x20, 0xf0, 0x25, 0x01, 0x6c, 0x04, 0xc8, 0x25, 0x40, 0x82,
-	0x20, 0x41, 0xe0, 0x6b, 0x0e, 0x31, 0x10, 0x20, 0x71, 0x90, 0x63, 0x80, 0x5c, 0x83, 0x9c, 0x72,
-	0x48, 0x02, 0xe4, 0x10, 0xc4, 0xff, 0x40, 0x72, 0x0d, 0xde, 0x56, 0x0b, 0x59, 0x64, 0x4b, 0xed,
-	0x46, 0x90, 0x93, 0xf8, 0xbe, 0xf7, 0x7d, 0xdf, 0xdb, 0xbe, 0xfd, 0xbd, 0x12, 0x6c, 0x98, 0x47,
-	0x23, 0x9f, 0x3c, 0x20, 0x8e, 0x6f, 0x39, 0x0f, 0x0c, 0xc7, 0x24, 0xc6, 0x83, 0xd3, 0x87, 0xfc,
-	0xc7, 0xa6, 0xeb, 0x39, 0x81, 0x83, 0x96, 0x18, 0xc6, 0x26, 0xc3, 0xd8, 0xe4, 0x1d, 0xa7, 0x0f,
-	0xd7, 0xef, 0x1c, 0x3b, 0xce, 0xf1, 0x80, 0x3c, 0x60, 0x38, 0x87, 0xa3, 0xa3, 0x07, 0x81, 0x35,
-	0x24, 0x7e, 0xa0, 0x0f, 0x5d, 0x4e, 0xa6, 0xfe, 0xc9, 0x3c, 0xe4, 0xb7, 0x06, 0x8e, 0xf1, 0x12,
-	0xcd, 0x43, 0xd6, 0x32, 0xeb, 0x99, 0x8d, 0xcc, 0xfd, 0x32, 0xce, 0x5a, 0x26, 0x5a, 0x81, 0x82,
-	0x3d, 0x1a, 0x1e, 0x12, 0xaf, 0x9e, 0xdd, 0xc8, 0xdc, 0xaf, 0x61, 0xd1, 0x42, 0x9f, 0x42, 0xe1,
-	0x84, 0xe8, 0x26, 0xf1, 0xea, 0xb9, 0x8d, 0xcc, 0xfd, 0xca, 0xa3, 0xbb, 0x9b, 0x69, 0x23, 0x6f,
-	0x32, 0xa6, 0xdb, 0x0c, 0x11, 0x0b, 0x02, 0xf4, 0x3e, 0x20, 0xd7, 0x73, 0xcc, 0x91, 0x41, 0x3c,
-	0xcd, 0xb7, 0x8e, 0x6d, 0x3d, 0x18, 0x79, 0xa4, 0x9e, 0x67, 0x43, 0x2e, 0xca, 0x9e, 0x9e, 0xec,
-	0x40, 0xbb, 0x50, 0x0d, 0x3c, 0xdd, 0xf6, 0x75, 0x23, 0xb0, 0x1c, 0xdb, 0xaf, 0x17, 0x36, 0xe6,
-	0xee, 0x57, 0x1e, 0xdd, 0x4f, 0x1f, 0xaf, 0x1f, 0x61, 0x62, 0x62, 0x10, 0xcb, 0x0d, 0x70, 0x82,
-	0x1a, 0x7d, 0x1d, 0x16, 0x63, 0x6d, 0xcd, 0x70, 0x46, 0x76, 0x50, 0x5f, 0x61, 0x4b, 0x53, 0x62,
-	0x1d, 0x4d, 0x0a, 0x47, 0x5f, 0x82, 0x72, 0x48, 0x17, 0xa0, 0x91, 0xf3, 0x80, 0xd8, 0x3e, 0x1b,
-	0xbe, 0xc8, 0x86, 0xbf, 0x93, 0x3e, 0x7c, 0x5b, 0xe2, 0xe1, 0x05, 0x46, 0x18, 0xb6, 0x7d, 0xb4,
-	0x07, 0x6f, 0x99, 0xae, 0xe3, 0x6b, 0xae, 0xe7, 0xb8, 0x8e, 0x4f, 0x4c, 0xcd, 0xf2, 0x3c, 0x72,
-	0x4a, 0x3c, 0xdf, 0x3a, 0x1c, 0x10, 0x8d, 0x61, 0xdb, 0xa3, 0x61, 0xbd, 0xc4, 0xa6, 0xb2, 0x41,
-	0x51, 0xf7, 0x05, 0xe6, 0x4e, 0x0c, 0x71, 0x4b, 0xe0, 0xa1, 0xef, 0xc2, 0x3a, 0x63, 0x97, 0xce,
-	0xa5, 0xcc, 0xb8, 0xd4, 0x29, 0x46, 0x2a, 0xf5, 0xbe, 0x58, 0x98, 0xe7, 0x38, 0x81, 0x36, 0x24,
-	0xde, 0xcb, 0x01, 0xa9, 0x57, 0xd8, 0x39, 0xbe, 0x3d, 0xe3, 0x1c, 0xb1, 0xe3, 0x04, 0x7b, 0x0c,
-	0x59, 0x2c, 0xcf, 0x0b, 0x01, 0xe8, 0x18, 0xd6, 0xc2, 0x43, 0x0d, 0x1c, 0x6d, 0xa0, 0xfb, 0x81,
-	0x26, 0x00, 0x66, 0xbd, 0xca, 0xf6, 0xec, 0x1b, 0xe9, 0xac, 0xf7, 0x05, 0x59, 0xdf, 0xd9, 0xd5,
-	0xfd, 0x40, 0xb4, 0x4c, 0xbc, 0xe2, 0xa6, 0xc2, 0x91, 0x0d, 0x37, 0x27, 0x06, 0xb2, 0x86, 0xee,
-	0xc0, 0x62, 0x5b, 0x7a, 0x58, 0xaf, 0xb1, 0xb1, 0x36, 0x2f, 0x33, 0xd6, 0x0e, 0x27, 0xdb, 0xc1,
-	0x5b, 0xb8, 0xee, 0xa6, 0xf6, 0x78, 0x87, 0xe8, 0x2d, 0xa8, 0x19, 0x8e, 0x7d, 0x64, 0x79, 0x43,
-	0x21, 0x2c, 0x0b, 0x1b, 0x73, 0xf7, 0x6b, 0xb8, 0x2a, 0x80, 0x5c, 0x50, 0xbe, 0x02, 0xc5, 0x25,
-	0xb6, 0x69, 0xd9, 0xc7, 0x9a, 0x6f, 0x9c, 0x10, 0x73, 0x34, 0x20, 0x75, 0x85, 0xed, 0xe7, 0xfb,
-	0x53, 0x26, 0xc2, 0xb1, 0xe5, 0x7c, 0x7a, 0x82, 0x08, 0x2f, 0x08, 0x36, 0x12, 0x80, 0x1c, 0xb8,
-	0x41, 0x25, 0xf2, 0x54, 0x0f, 0x88, 0xa9, 0x31, 0x65, 0x35, 0x9c, 0x81, 0x76, 0x44, 0x98, 0x6e,
-	0xf8, 0xf5, 0x45, 0x36, 0xc8, 0x83, 0xf4, 0x41, 0x1a, 0x92, 0x70, 0x5f, 0xd0, 0x3d, 0x11, 0x64,
-	0x78, 0x4d, 0x9f, 0xd6, 0x85, 0x6e, 0x42, 0xf9, 0x54, 0x1f, 0x58, 0x26, 0xed, 0xac, 0xa3, 0x8d,
-	0xcc, 0xfd, 0x12, 0x8e, 0x00, 0xe8, 0x33, 0x00, 0x6f, 0x60, 0x0d, 0xad, 0x40, 0x73, 0x5c, 0xbf,
-	0x7e, 0x9d, 0xed, 0xf5, 0xed, 0xf4, 0xd1, 0x31, 0xc3, 0xeb, 0xba, 0xb8, 0xec, 0x89, 0x5f, 0x3e,
-	0x3a, 0x80, 0x3a, 0x3b, 0x2b, 0xc3, 0x0a, 0xb4, 0xb8, 0x1a, 0x52, 0x66, 0x4b, 0x8c, 0xd9, 0x8d,
-	0x69, 0x7a, 0x7d, 0xde, 0x75, 0xf1, 0x8a, 0x24, 0x8e, 0xa9, 0x39, 0x67, 0x8b, 0xe2, 0xdc, 0x02,
-	0x4f, 0x37, 0x88, 0x5f, 0x5f, 0x66, 0x0c, 0xef, 0xbd, 0xd2, 0x50, 0xf4, 0x29, 0x3a, 0x8e, 0x9b,
-	0x05, 0x06, 0xf1, 0xd1, 0x27, 0xb0, 0x3a, 0xc1, 0x56, 0x08, 0xc1, 0x2a, 0x53, 0xb0, 0xe5, 0x71,
-	0x1a, 0x2e, 0x0d, 0xdf, 0x81, 0x75, 0x72, 0x4e, 0x8c, 0x51, 0x40, 0x34, 0xcb, 0x76, 0x47, 0x81,
-	0x96, 0x30, 0x36, 0x75, 0x46, 0xba, 0x2a, 0x30, 0x76, 0x28, 0x42, 0x23, 0x66, 0x73, 0x3e, 0x83,
-	0x1b, 0xa2, 0xcb, 0xd4, 0x02, 0x27, 0xd0, 0x07, 0x49, 0xea, 0x35, 0xae, 0xd9, 0x12, 0xa5, 0x4f,
-	0x31, 0xe2, 0xe4, 0xef, 0xc1, 0x22, 0x37, 0x59, 0xd4, 0xb2, 0x52, 0x79, 0x7c, 0x49, 0x2e, 0xea,
-	0xf3, 0xcc, 0xb6, 0x72, 0x9d, 0xed, 0x71, 0xf8, 0x53, 0x72, 0x81, 0xfa, 0x80, 0x98, 0x1c, 0x90,
-	0x50, 0x68, 0xb5, 0xd3, 0x87, 0x75, 0x60, 0x22, 0x75, 0x6f, 0xb6, 0x02, 0x85, 0x02, 0xab, 0x70,
-	0x0e, 0xb2, 0xfd, 0xec, 0x21, 0xf2, 0x61, 0x83, 0xc9, 0x8b, 0x96, 0x9c, 0x87, 0x3e, 0x0a, 0x4e,
-	0x1c, 0xcf, 0x0a, 0x2e, 0xb4, 0xd3, 0x47, 0xf5, 0xdb, 0x6c, 0x8c, 0xaf, 0xcf, 0xb0, 0x35, 0x62,
-	0x9a, 0x0d, 0x49, 0x85, 0x6f, 0x32, 0xa6, 0xa9, 0x7d, 0xcf, 0x1e, 0xa1, 0x1f, 0xa4, 0x2c, 0xe5,
-	0x51, 0xfd, 0xce, 0x2c, 0xed, 0x90, 0x4b, 0x09, 0xd9, 0x4c, 0x5d, 0xd3, 0x23, 0xf5, 0x77, 0xe7,
-	0xa0, 0xc6, 0x86, 0x7e, 0x6e, 0x05, 0x27, 0x98, 0x1c, 0xf9, 0x13, 0x7e, 0xf2, 0x21, 0xe4, 0xd9,
-	0x7a, 0x99, 0x9b, 0x9c, 0x2a, 0xc6, 0xdc, 0x8c, 0x72, 0x4c, 0xa4, 0xc3, 0x5a, 0xaa, 0x32, 0x78,
-	0xe4, 0xc8, 0xaf, 0xcf, 0xcd, 0xb2, 0xc6, 0x09, 0x2f, 0x77, 0xe4, 0xe3, 0xd5, 0x14, 0xbd, 0x60,
-	0xb3, 0xdc, 0x07, 0x65, 0x82, 0x73, 0xee, 0x2a, 0x9c, 0x17, 0x82, 0x31, 0x8e, 0xff, 0x1f, 0x56,
-	0x26, 0x75, 0x82, 0xf1, 0xcd, 0x5f, 0x85, 0xef, 0xd2, 0xb8, 0xe6, 0x30, 0xe6, 0x2a, 0x54, 0xe3,
-	0xfe, 0xac, 0x5e, 0x60, 0xe6, 0x27, 0x01, 0x53, 0xdf, 0x85, 0x85, 0xf1, 0x55, 0xae, 0x40, 0xe1,
-	0x44, 0xf7, 0x4f, 0x88, 0x5f, 0xcf, 0x6c, 0xcc, 0xdd, 0xaf, 0x62, 0xd1, 0x52, 0xb7, 0x61, 0x6d,
-	0xaa, 0x09, 0xa4, 0x81, 0xc0, 0xa4, 0x39, 0xe5, 0xf4, 0x8a, 0x3b, 0x86, 0xac, 0xfe, 0x34, 0x0b,
-	0xab, 0x53, 0x4c, 0x36, 0xba, 0x0f, 0x4a, 0x28, 0x73, 0x03, 0xeb, 0x50, 0xa3, 0xfe, 0x37, 0xc3,
-	0xb4, 0x74, 0x5e, 0xc2, 0x77, 0xad, 0xc3, 0xce, 0x68, 0x48, 0x5d, 0x49, 0x88, 0x49, 0xa7, 0xc8,
-	0x64, 0xa5, 0x8a, 0xab, 0x12, 0xb8, 0xad, 0xfb, 0x27, 0xe8, 0x0b, 0xa8, 0xc4, 0xb5, 0x71, 0xee,
-	0x4a, 0xda, 0x08, 0x7e, 0xa4, 0x87, 0xfb, 0x71, 0x46, 0x8f, 0xc4, 0xb1, 0x5f, 0x59, 0x17, 0x22,
-	0x8e, 0x8f, 0xd4, 0x21, 0x28, 0x13, 0xab, 0xaf, 0x43, 0x91, 0x1d, 0x8d, 0x63, 0x8b, 0x45, 0xcb,
-	0x26, 0x7a, 0x0c, 0x65, 0xe9, 0x54, 0xfd, 0x7a, 0x96, 0xd9, 0xe2, 0xbb, 0xb3, 0x47, 0x7f, 0x4a,
-	0x2e, 0x70, 0x44, 0xa3, 0x7e, 0x1f, 0x2a, 0xb1, 0x1e, 0x74, 0x17, 0xaa, 0xba, 0xc1, 0x8c, 0xa0,
-	0x66, 0xeb, 0x43, 0x22, 0x74, 0xaf, 0x22, 0x60, 0x1d, 0x7d, 0x48, 0xd2, 0x8d, 0x5f, 0x36, 0xd5,
-	0xf8, 0xa9, 0xbf, 0x0d, 0x6b, 0x53, 0x57, 0x3d, 0x63, 0x55, 0xed, 0xc9, 0x55, 0xbd, 0x73, 0xc9,
-	0x3d, 0x8d, 0xaf, 0xed, 0x8f, 0x33, 0xb0, 0x38, 0x81, 0x70, 0x99, 0x25, 0x1a, 0xb0, 0x3a, 0xc5,
-	0xae, 0x0a, 0xcb, 0x73, 0x25, 0xa3, 0xba, 0x7c, 0x98, 0x06, 0x56, 0x0d, 0x58, 0x4e, 0xc5, 0x47,
-	0x8f, 0x21, 0x7b, 0xfa, 0x01, 0x9b, 0xd6, 0xd4, 0xc8, 0x26, 0xdd, 0x42, 0x7f, 0xb0, 0x7d, 0x0d,
-	0x67, 0x4f, 0x3f, 0xd8, 0x2a, 0x43, 0xf1, 0x54, 0xf7, 0x2c, 0xdd, 0x0e, 0xd4, 0x01, 0xac, 0x4e,
-	0xc1, 0xa5, 0x31, 0x48, 0x70, 0xe2, 0x11, 0xff, 0xc4, 0x19, 0x98, 0xe2, 0x00, 0x22, 0x00, 0xfa,
-	0x10, 0x72, 0x2f, 0xc9, 0x85, 0xdc, 0xfd, 0x29, 0x91, 0xf8, 0x53, 0x72, 0xf1, 0x9c, 0x58, 0xc7,
-	0x27, 0x01, 0x66, 0xc8, 0x6a, 0x0f, 0x16, 0xc6, 0x62, 0x58, 0x74, 0x0b, 0xc0, 0x76, 0x4c, 0xe9,
-	0xd1, 0xc5, 0x30, 0x14, 0xc2, 0x3d, 0x29, 0x3b, 0x0c, 0xe6, 0x52, 0x28, 0x8c, 0x0f, 0x57, 0xa5,
-	0x87, 0x41, 0x61, 0x1d, 0x0a, 0x52, 0x0d, 0x58, 0x49, 0x8f, 0x5e, 0x11, 0x82, 0x5c, 0xec, 0x04,
-	0xd9, 0x6f, 0xf4, 0x31, 0xac, 0xb2, 0x68, 0x95, 0x9f, 0x9f, 0x3d, 0x1a, 0x46, 0x01, 0x32, 0xcf,
-	0xad, 0x96, 0x68, 0x37, 0x9b, 0x65, 0x67, 0x34, 0x94, 0xac, 0x54, 0x02, 0xf5, 0x69, 0x61, 0xeb,
-	0x9b, 0x1c, 0xe6, 0x97, 0x59, 0x40, 0x93, 0xd9, 0x93, 0xf0, 0x73, 0xb9, 0xd0, 0xcf, 0x2d, 0x41,
-	0xde, 0xb2, 0x4d, 0x72, 0xce, 0x6c, 0x73, 0x0e, 0xf3, 0x06, 0x7a, 0x0c, 0x05, 0x3f, 0xd0, 0x83,
-	0x91, 0xcf, 0x66, 0x32, 0x3f, 0x4d, 0x25, 0x62, 0xfc, 0x7b, 0x0c, 0x1d, 0x0b, 0x32, 0x3a, 0x69,
-	0xc3, 0x1d, 0x69, 0x23, 0x5f, 0x3f, 0x26, 0xda, 0xd0, 0x32, 0x3c, 0x47, 0xf3, 0x89, 0xe1, 0xd8,
-	0xa6, 0x2f, 0x27, 0x6d, 0xb8, 0xa3, 0x03, 0xda, 0xbb, 0x47, 0x3b, 0x7b, 0xbc, 0x0f, 0xdd, 0x83,
-	0x05, 0x9b, 0x04, 0x82, 0xec, 0xcc, 0xf1, 0x4c, 0xee, 0x38, 0x6b, 0xb8, 0x66, 0x93, 0x80, 0xa1,
-	0x3f, 0xa7, 0x40, 0xf4, 0x0c, 0x90, 0xab, 0x1b, 0x2f, 0x69, 0x48, 0x15, 0x4d, 0x41, 0x78, 0xac,
-	0x69, 0xea, 0xcb, 0xf0, 0xe3, 0x3b, 0xb2, 0xe8, 0x8e, 0x83, 0xd4, 0xbf, 0xa3, 0x6a, 0x3c, 0x0e,
-	0x45, 0xb7, 0x01, 0xc2, 0xbc, 0x96, 0xfb, 0x94, 0x32, 0x8e, 0x41, 0xd0, 0x06, 0x54, 0x0c, 0x67,
-	0xe8, 0x7a, 0xc4, 0x67, 0x16, 0x86, 0x2f, 0x30, 0x0e, 0x42, 0xdf, 0x84, 0xba, 0x98, 0xaf, 0xe1,
-	0xd8, 0x01, 0x39, 0x0f, 0xb4, 0x23, 0x8f, 0x10, 0xcd, 0xd4, 0x03, 0x9d, 0x2d, 0xb0, 0x8a, 0x97,
-	0x79, 0x7f, 0x93, 0x77, 0x3f, 0xf1, 0x08, 0x69, 0xe9, 0x81, 0xce, 0x72, 0xeb, 0xc9, 0x85, 0xe6,
-	0x18, 0x49, 0xca, 0xfc, 0xff, 0x7a, 0x0e, 0x2a, 0xb1, 0x14, 0x1d, 0x7d, 0x0b, 0xca, 0x61, 0x69,
-	0x40, 0xb8, 0x9e, 0xf5, 0x4d, 0x5e, 0x3c, 0xd8, 0x94, 0xc5, 0x83, 0xcd, 0xbe, 0xc4, 0xc0, 0x11,
-	0x32, 0x5a, 0x87, 0x92, 0xb4, 0x6e, 0x42, 0x5a, 0xc2, 0x36, 0x55, 0x67, 0x91, 0x2d, 0x11, 0x93,
-	0x6d, 0x7a, 0x0d, 0x47, 0x00, 0x4e, 0x49, 0x4e, 0x2d, 0x67, 0xe4, 0x33, 0xa1, 0x62, 0x94, 0xbc,
-	0x3d, 0x9e, 0xad, 0x0f, 0x69, 0xc2, 0x59, 0x2f, 0xb2, 0xd5, 0xc4, 0x03, 0x9b, 0x3d, 0x0a, 0x97,
-	0x0a, 0x1b, 0xe2, 0x95, 0x18, 0x5e, 0x25, 0x8e, 0xf2, 0x6e, 0xcc, 0x57, 0x4b, 0x03, 0xcf, 0x73,
-	0xe5, 0x85, 0xd0, 0xcf, 0x09, 0x43, 0xbf, 0x0b, 0x8b, 0xbc, 0x5e, 0x11, 0x4f, 0xfe, 0x2b, 0x97,
-	0x4b, 0xfe, 0x15, 0x4e, 0x19, 0xcb, 0xfe, 0xf7, 0x41, 0xb1, 0xc9, 0x99, 0x16, 0x3a, 0x80, 0xab,
-	0x07, 0xda, 0xf3, 0x36, 0x39, 0x93, 0x40, 0xff, 0xd9, 0x43, 0xf5, 0xf7, 0x00, 0x94, 0xd8, 0x51,
-	0xb6, 0x4f, 0x89, 0x1d, 0x4c, 0x44, 0xa5, 0x6b, 0x50, 0xe2, 0x66, 0xc0, 0x32, 0x85, 0x1f, 0x2c,
-	0xb2, 0xf6, 0x8e, 0x89, 0x6e, 0x40, 0x39, 0xb4, 0x10, 0x42, 0x69, 0x38, 0x2e, 0x8d, 0x54, 0xc6,
-	0x03, 0xb1, 0xdc, 0x64, 0x20, 0x86, 0x08, 0x2c, 0x5a, 0x76, 0x40, 0x3c, 0x9b, 0xa6, 0x28, 0xa6,
-	0x69, 0xc5, 0x54, 0xea, 0x93, 0x57, 0xaa, 0x3f, 0x9b, 0xee, 0x66, 0xc3, 0x34, 0x89, 0xb9, 0x23,
-	0x98, 0x0c, 0x2e, 0xb6, 0xaf, 0x61, 0x45, 0xb2, 0x6c, 0x08, 0x8e, 0xe8, 0x4b, 0x28, 0x85, 0xdc,
-	0x0b, 0x8c, 0xfb, 0x37, 0xae, 0xc2, 0x7d, 0xfb, 0x1a, 0x0e, 0xe9, 0x51, 0x17, 0xca, 0x3c, 0x71,
-	0xa2, 0xcc, 0x8a, 0xb3, 0x02, 0xa2, 0x09, 0x66, 0x6d, 0x91, 0x70, 0x6d, 0x5f, 0xc3, 0x11, 0x0f,
-	0xa4, 0xc1, 0x82, 0x19, 0x78, 0xe7, 0x32, 0xe9, 0xb0, 0xec, 0x63, 0x26, 0x75, 0x95, 0x47, 0x1f,
-	0x5d, 0x92, 0x6d, 0x2b, 0xf0, 0xce, 0xe5, 0x11, 0x53, 0xde, 0xf3, 0x66, 0x04, 0xb0, 0xec, 0x63,
-	0x74, 0x08, 0x8b, 0x6c, 0x00, 0x43, 0xb7, 0x0d, 0x32, 0x18, 0xe8, 0x81, 0x94, 0xd8, 0xca, 0xa3,
-	0x0f, 0xaf, 0x30, 0x44, 0x93, 0x91, 0xb3, 0x11, 0x14, 0x33, 0x6c, 0x73, 0x76, 0xeb, 0xdf, 0x87,
-	0x85, 0xb1, 0x83, 0x40, 0x3b, 0x50, 0x89, 0xdb, 0x8f, 0xcc, 0x2c, 0x43, 0x49, 0xfd, 0x77, 0xd2,
-	0x50, 0xc6, 0x69, 0xd7, 0xff, 0x39, 0x03, 0x79, 0xc6, 0x1e, 0x6d, 0x41, 0xd1, 0xe3, 0x5e, 0x45,
-	0x30, 0xbc, 0x7c, 0x0d, 0x4f, 0x12, 0x8e, 0x4f, 0x2c, 0xfb, 0xfa, 0x13, 0x43, 0x0d, 0xa8, 0xb8,
-	0xa3, 0xc3, 0x81, 0x65, 0x68, 0x2c, 0x9a, 0xe0, 0xd6, 0x6e, 0x63, 0x8a, 0x36, 0x32, 0xc4, 0xa7,
-	0xe4, 0xc2, 0xc7, 0xe0, 0x86, 0xbf, 0xd7, 0x7f, 0x9e, 0x81, 0x92, 0x14, 0x0c, 0xf4, 0x5d, 0xc8,
-	0xb3, 0x6c, 0x48, 0x2c, 0xee, 0xb2, 0x75, 0x07, 0x4e, 0x84, 0x9a, 0x50, 0x39, 0x8c, 0x0c, 0xb1,
-	0x58, 0xd8, 0x25, 0x8a, 0xaa, 0x71, 0xaa, 0xf5, 0x3f, 0xca, 0x40, 0x2d, 0x21, 0x51, 0xe8, 0xff,
-	0x01, 0x18, 0x1e, 0x61, 0xc5, 0xa3, 0xc3, 0x0b, 0x31, 0xb3, 0xe9, 0xe6, 0xab, 0xc5, 0xcb, 0x2c,
-	0x65, 0x41, 0xb2, 0x75, 0xf1, 0x06, 0xf7, 0x7b, 0x7d, 0x1f, 0xaa, 0x71, 0x51, 0x44, 0x9f, 0x43,
-	0xc5, 0x10, 0xbf, 0xaf, 0x30, 0x37, 0x90, 0x34, 0x5b, 0x17, 0x5b, 0x45, 0xc8, 0x13, 0x2a, 0xe2,
-	0xea, 0xfb, 0x00, 0xd1, 0x09, 0xa1, 0x3b, 0xc9, 0x83, 0x15, 0xfe, 0x37, 0x3a, 0x36, 0xf5, 0x17,
-	0x05, 0x58, 0x8a, 0x4d, 0x73, 0xd7, 0x3a, 0x22, 0xc6, 0x85, 0x31, 0x20, 0x13, 0xe6, 0xf3, 0x59,
-	0xb2, 0xae, 0x24, 0x42, 0x9c, 0xec, 0xd5, 0x42, 0x9c, 0xb8, 0x07, 0xe3, 0x20, 0xf4, 0x02, 0xae,
-	0x27, 0xd3, 0x72, 0xae, 0x15, 0x5f, 0xbb, 0xa2, 0x56, 0xa0, 0x60, 0x32, 0x5e, 0x1b, 0x3b, 0x30,
-	0xf8, 0x0d, 0x14, 0x64, 0x6c, 0x1f, 0xaf, 0x8f, 0xef, 0x23, 0xea, 0xc2, 0x42, 0x68, 0x0a, 0x79,
-	0x25, 0x40, 0x14, 0x91, 0x2f, 0x2b, 0xfb, 0xf3, 0x21, 0x39, 0x6b, 0xa3, 0xe7, 0xb0, 0x12, 0x31,
-	0xe4, 0xde, 0x49, 0x5c, 0x32, 0x54, 0x2f, 0xab, 0x0f, 0x4b, 0x21, 0x83, 0x78, 0x5c, 0x93, 0x54,
-	0x83, 0xa5, 0x2b, 0xab, 0xc1, 0x98, 0xac, 0x2e, 0x5f, 0x59, 0x56, 0xd1, 0x87, 0xb0, 0xcc, 0xd8,
-	0xd1, 0x95, 0x25, 0x5c, 0xeb, 0x5d, 0xe6, 0x5a, 0x97, 0x64, 0x67, 0xbc, 0x5c, 0x8f, 0x3e, 0x8e,
-	0xef, 0x47, 0x82, 0x4a, 0x65, 0x54, 0xcb, 0x61, 0x6f, 0x82, 0xec, 0x53, 0xa8, 0xf3, 0x91, 0x53,
-	0x86, 0x7b, 0x8b, 0x11, 0xae, 0xc6, 0xfa, 0xe3, 0xa4, 0x5f, 0xe6, 0x4a, 0x35, 0xe5, 0xfa, 0x97,
-	0xb9, 0xd2, 0x8a, 0x72, 0x57, 0xfd, 0x45, 0x06, 0x16, 0x27, 0x44, 0x84, 0x1a, 0xaa, 0x49, 0xd7,
-	0x70, 0xf7, 0xd5, 0x32, 0x9b, 0x10, 0xad, 0x64, 0x84, 0x9c, 0x9d, 0x88, 0x90, 0xdf, 0x83, 0xc5,
-	0xb4, 0xc0, 0x97, 0x26, 0x60, 0x0b, 0x46, 0x32, 0xe4, 0x55, 0xff, 0x30, 0x0b, 0x95, 0xf8, 0x04,
-	0x1f, 0x87, 0x37, 0x53, 0x33, 0xdd, 0x56, 0x8c, 0x64, 0xec, 0x7e, 0xaa, 0x03, 0x4b, 0x89, 0xc1,
-	0xe5, 0xc5, 0x13, 0xcf, 0x37, 0x6f, 0x4e, 0xaf, 0xb5, 0x3b, 0x36, 0x46, 0xb1, 0xd9, 0x35, 0xc4,
-	0x95, 0xd3, 0x27, 0x50, 0x94, 0x2c, 0xe6, 0x2e, 0xc1, 0x42, 0x22, 0xa3, 0xc7, 0x00, 0xb1, 0xd0,
-	0x33, 0x77, 0xb9, 0xd0, 0x33, 0x46, 0xa2, 0xfe, 0x41, 0x16, 0x16, 0x27, 0x96, 0x89, 0xbe, 0x4d,
-	0xd9, 0xba, 0x96, 0xa7, 0xc7, 0xce, 0x6f, 0x56, 0x90, 0x1f, 0xc3, 0x46, 0x2a, 0xd4, 0x3c, 0x72,
-	0x14, 0xa5, 0x96, 0x32, 0x77, 0xf1, 0xc8, 0x91, 0x4c, 0x28, 0xd1, 0x7d, 0x50, 0x22, 0x1c, 0xd7,
-	0x23, 0x47, 0xd6, 0xb9, 0x88, 0x2f, 0xe7, 0x25, 0xda, 0x3e, 0x83, 0xa2, 0xf7, 0xe1, 0xfa, 0x50,
-	0x3f, 0xd7, 0xc6, 0x33, 0xb8, 0x1c, 0xbf, 0x8d, 0x1b, 0xea, 0xe7, 0x9d, 0x44, 0x12, 0xf7, 0x0e,
-	0x50, 0x98, 0x16, 0xcb, 0x13, 0x7d, 0x91, 0x4d, 0xd4, 0x86, 0xfa, 0x79, 0x53, 0xe6, 0x87, 0x3e,
-	0x0d, 0x6d, 0x4d, 0x32, 0xd0, 0x2f, 0x68, 0x0a, 0xc9, 0x62, 0xc6, 0x1a, 0x2e, 0x31, 0x40, 0x8f,
-	0x18, 0xea, 0xef, 0x97, 0x13, 0x71, 0x33, 0x37, 0x3c, 0xe3, 0x86, 0x3f, 0x11, 0x1c, 0x67, 0x59,
-	0xa6, 0x1b, 0x05, 0xc7, 0x61, 0x0a, 0xbc, 0x1e, 0x4f, 0x81, 0x3f, 0x05, 0xe0, 0x24, 0x34, 0x27,
-	0xba, 0x4c, 0xee, 0xc4, 0xb0, 0x69, 0x9b, 0x4a, 0x7b, 0x78, 0xa5, 0x15, 0x86, 0xeb, 0x3c, 0x89,
-	0x5a, 0x90, 0x1d, 0x5b, 0x22, 0x6c, 0xdf, 0x8e, 0x82, 0x28, 0x1e, 0x6b, 0x6f, 0x5e, 0xd6, 0x5d,
-	0x08, 0x29, 0x0f, 0x43, 0xa9, 0x3a, 0x14, 0xc9, 0x40, 0x77, 0x7d, 0x62, 0xb2, 0x3d, 0x9a, 0xc3,
-	0xb2, 0x49, 0x57, 0x1f, 0x9e, 0x09, 0x0b, 0x93, 0x73, 0xb8, 0x24, 0xf3, 0x69, 0x9a, 0xcc, 0xc9,
-	0x54, 0xc9, 0x64, 0xc1, 0x6e, 0x09, 0x47, 0x00, 0xf4, 0x04, 0x6a, 0xc9, 0x4b, 0x98, 0xf2, 0xac,
-	0xc2, 0x5f, 0x23, 0xe6, 0x0b, 0xaa, 0x89, 0xab, 0x17, 0x0c, 0x8b, 0x47, 0xba, 0x45, 0xcd, 0x2d,
-	0x0b, 0x7f, 0xb9, 0x73, 0x81, 0x2b, 0x39, 0x97, 0x05, 0xce, 0x80, 0xc6, 0x1c, 0xfc, 0x90, 0x3f,
-	0xa3, 0xd1, 0xbf, 0x41, 0x5c, 0x26, 0xf7, 0x0b, 0xb3, 0x4d, 0xb8, 0x40, 0xc3, 0x11, 0x05, 0xba,
-	0x05, 0x40, 0x3c, 0xcf, 0xf1, 0x34, 0x8a, 0xc6, 0x6e, 0xf7, 0x72, 0xb8, 0xcc, 0x20, 0x4d, 0xc7,
-	0x24, 0xe8, 0x21, 0x14, 0xcc, 0x43, 0x76, 0x91, 0xb5, 0xc8, 0x96, 0xbc, 0x9e, 0xce, 0xba, 0xb5,
-	0xd5, 0x75, 0x71, 0xde, 0x3c, 0xec, 0xba, 0x3e, 0xfa, 0x26, 0x94, 0xd8, 0xea, 0x28, 0x11, 0x9a,
-	0x65, 0x19, 0x84, 0x3f, 0x29, 0x52, 0x6c, 0x4a, 0xf8, 0x39, 0x54, 0x44, 0xc9, 0x3a, 0x76, 0x0d,
-	0x37, 0x65, 0x2d, 0xa2, 0x86, 0x4d, 0xdd, 0xd1, 0x91, 0xfc, 0xc9, 0x86, 0x76, 0x89, 0x37, 0x8c,
-	0x5d, 0xbc, 0xdd, 0x9c, 0x76, 0x51, 0xe9, 0x0d, 0xe9, 0xd0, 0x2e, 0xfb, 0xeb, 0xa3, 0x8f, 0xa0,
-	0xe8, 0xe9, 0x9c, 0x6e, 0x79, 0xd6, 0x85, 0x1d, 0x6e, 0xec, 0x75, 0x5d, 0x5c, 0xf0, 0x74, 0x46,
-	0xd5, 0x03, 0x44, 0xa9, 0x0c, 0xc7, 0xf3, 0x48, 0x74, 0xe3, 0xb7, 0xc2, 0x18, 0xbc, 0x3d, 0x95,
-	0x41, 0x33, 0x44, 0xef, 0xba, 0x58, 0xf1, 0xf4, 0x61, 0x1c, 0xe0, 0x8f, 0xdd, 0x45, 0xae, 0x5e,
-	0xf5, 0x2e, 0xf2, 0xdb, 0x50, 0x0e, 0xf4, 0xc3, 0x01, 0xdf, 0xc2, 0x3a, 0xa3, 0xbe, 0x35, 0x45,
-	0xb4, 0x28, 0x5a, 0xd7, 0xc5, 0xa5, 0x80, 0xff, 0xf0, 0xd1, 0x53, 0xa8, 0x85, 0xde, 0x3c, 0xf0,
-	0x08, 0xa9, 0xaf, 0xcd, 0xba, 0x6b, 0x6c, 0x0a, 0xd4, 0x27, 0x03, 0x3d, 0xe8, 0x38, 0x26, 0xc1,
-	0x55, 0x49, 0xdc, 0xf7, 0x08, 0x51, 0x7f, 0x95, 0x81, 0xfa, 0x34, 0x75, 0xfd, 0xbf, 0x5e, 0x59,
-	0x53, 0xff, 0x36, 0x03, 0x05, 0xae, 0xc6, 0xd4, 0xa0, 0x88, 0x4a, 0xb5, 0xb0, 0xa4, 0xb2, 0x19,
-	0x96, 0x29, 0xb3, 0xb1, 0x32, 0xe5, 0x53, 0xa8, 0x89, 0xd2, 0xf5, 0x8f, 0xb9, 0x27, 0x9a, 0x9b,
-	0x25, 0x0d, 0x54, 0x0c, 0x2d, 0x56, 0x1b, 0xdb, 0x25, 0xa7, 0x64, 0x80, 0x93, 0xb4, 0xd4, 0x62,
-	0xfd, 0xd0, 0x77, 0x6c, 0x1e, 0x27, 0x88, 0xf2, 0x13, 0x05, 0xb0, 0x9a, 0xd8, 0x1a, 0x94, 0x3c,
-	0xfd, 0x8c, 0xf7, 0xe5, 0x59, 0x4d, 0xa8, 0xe8, 0xe9, 0x67, 0x2c, 0x76, 0xf8, 0xab, 0x02, 0x54,
-	0x62, 0x46, 0x08, 0xad, 0x43, 0x89, 0x99, 0xc7, 0x53, 0xe2, 0xb1, 0x50, 0xb6, 0x8c, 0xc3, 0x36,
-	0xfa, 0x6c, 0x3c, 0x7d, 0x7d, 0x6b, 0xa6, 0x1b, 0x1f, 0xcf, 0x5c, 0x3f, 0x82, 0x42, 0x22, 0x89,
-	0x9a, 0x1d, 0x04, 0x08, 0x5c, 0x74, 0x17, 0xaa, 0xf1, 0x58, 0x84, 0x9d, 0x41, 0x09, 0x57, 0x62,
-	0x51, 0x46, 0xdc, 0x8e, 0xe7, 0x92, 0x76, 0xbc, 0x0e, 0x45, 0xc3, 0xb1, 0x7d, 0x67, 0x20, 0x5f,
-	0xd7, 0xc8, 0x26, 0x7a, 0x1b, 0xe6, 0xe3, 0x09, 0x88, 0x65, 0x8a, 0xca, 0x5b, 0x2d, 0x06, 0x1d,
-	0xaf, 0x11, 0x15, 0xc7, 0xdc, 0x60, 0xaa, 0xd7, 0x2a, 0xa5, 0x7b, 0xad, 0xa4, 0x73, 0x2c, 0x5f,
-	0xc5, 0x39, 0xf6, 0x00, 0xc9, 0x3b, 0x11, 0x6a, 0x42, 0x4c, 0x32, 0x08, 0x74, 0xbf, 0x0e, 0xb3,
-	0x84, 0xa5, 0xc1, 0xf1, 0x71, 0x63, 0xaf, 0x45, 0xb1, 0xb1, 0x22, 0x18, 0x60, 0x7d, 0xc8, 0x00,
-	0xfe, 0x9b, 0x75, 0x05, 0x4b, 0xe3, 0xae, 0xe0, 0x6d, 0x98, 0x97, 0xef, 0x1a, 0x3c, 0xd3, 0xb2,
-	0xf5, 0x01, 0xf3, 0x16, 0x35, 0x2c, 0x5c, 0x63, 0x97, 0x03, 0xd1, 0x47, 0xb0, 0xc2, 0xec, 0x80,
-	0xe3, 0x69, 0x63, 0xe8, 0x8b, 0x42, 0x31, 0x79, 0x6f, 0x23, 0x41, 0xf5, 0x3d, 0x78, 0xcf, 0x18,
-	0x38, 0x3e, 0xf1, 0x03, 0x6d, 0x64, 0xdb, 0x4e, 0x60, 0x1d, 0x59, 0xc4, 0xd4, 0x68, 0x38, 0xef,
-	0xa7, 0x70, 0x42, 0x8c, 0xd3, 0x3d, 0x41, 0x71, 0x10, 0x12, 0x34, 0x04, 0x7e, 0x92, 0xf7, 0x3b,
-	0xf1, 0x84, 0x8e, 0xc7, 0x38, 0xd7, 0x79, 0xe4, 0x16, 0x25, 0x1a, 0x14, 0xaa, 0xfe, 0x79, 0x16,
-	0x6a, 0x09, 0x39, 0x4f, 0x68, 0x4e, 0x66, 0x4c, 0x73, 0x56, 0xa0, 0x60, 0x5a, 0xc7, 0xc4, 0x0f,
-	0x84, 0x01, 0x10, 0x2d, 0x3a, 0xdc, 0xf1, 0xc0, 0x39, 0xd4, 0x07, 0x9a, 0x4f, 0x7e, 0x34, 0x22,
-	0xb6, 0xc1, 0xe5, 0x3b, 0x87, 0xe7, 0x39, 0xb8, 0x27, 0xa0, 0xe8, 0x0b, 0x6e, 0x2b, 0x22, 0x34,
-	0x1e, 0x0c, 0xab, 0x53, 0x8e, 0x7f, 0x14, 0x9c, 0x48, 0x52, 0x5c, 0xd5, 0x63, 0x2d, 0xf4, 0x16,
-	0x8d, 0x5f, 0x8d, 0xd3, 0x88, 0x51, 0x9e, 0x8d, 0x57, 0xa5, 0xc0, 0x38, 0x12, 0xe5, 0x15, 0x21,
-	0xf1, 0xab, 0x8e, 0x2a, 0x05, 0x86, 0x48, 0x77, 0xa1, 0xaa, 0x1f, 0x5a, 0x11, 0x0e, 0xd7, 0x8e,
-	0x8a, 0x7e, 0x68, 0x49, 0x14, 0x75, 0x0f, 0xaa, 0xf1, 0xa9, 0x5c, 0xe6, 0x76, 0x6f, 0x1d, 0x4a,
-	0x21, 0x47, 0x11, 0x76, 0xca, 0xb6, 0xda, 0x80, 0x85, 0x31, 0xc1, 0x9e, 0x61, 0x71, 0x97, 0x20,
-	0xcf, 0x34, 0x85, 0x71, 0x99, 0xc3, 0xbc, 0xa1, 0x7e, 0x08, 0xe5, 0x30, 0x53, 0xa0, 0x46, 0x39,
-	0xb8, 0x70, 0x89, 0xb8, 0xf8, 0x62, 0xbf, 0x29, 0x8c, 0x99, 0x49, 0x7e, 0x31, 0xcd, 0x7e, 0xab,
-	0x3f, 0xcb, 0x42, 0x9e, 0xc5, 0x1f, 0xa8, 0x09, 0x65, 0xc7, 0x25, 0xb1, 0xc4, 0x61, 0x7e, 0xfa,
-	0x75, 0xff, 0x79, 0xd7, 0xdd, 0xec, 0x4a, 0x64, 0x1c, 0xd1, 0xa5, 0xfa, 0x82, 0x49, 0x73, 0x34,
-	0x97, 0x66, 0x8e, 0xc6, 0x6a, 0x1b, 0xb9, 0xd7, 0xaf, 0x6d, 0xa8, 0xdf, 0x82, 0x72, 0x38, 0x3b,
-	0xb4, 0x0c, 0x8b, 0xdd, 0xfd, 0x36, 0x6e, 0xf4, 0x77, 0xba, 0x1d, 0xed, 0xa0, 0xf3, 0xb4, 0xd3,
-	0x7d, 0xde, 0x51, 0xae, 0xa1, 0x25, 0x50, 0x22, 0x70, 0x13, 0xb7, 0x1b, 0xfd, 0xb6, 0x92, 0x51,
-	0xff, 0x62, 0x0e, 0x72, 0x34, 0x88, 0x43, 0x5b, 0x93, 0xbb, 0xf1, 0xb5, 0xe9, 0x31, 0x5f, 0xfa,
-	0x66, 0x44, 0x57, 0x16, 0x5c, 0xdb, 0x44, 0x3a, 0x25, 0x56, 0xcc, 0xf2, 0x0a, 0x04, 0x39, 0x66,
-	0x65, 0xf8, 0x8e, 0xb0, 0xdf, 0xf4, 0x74, 0x7d, 0xc3, 0x71, 0x89, 0x70, 0x75, 0xbc, 0x41, 0xad,
-	0x12, 0x0f, 0x68, 0xd8, 0xfe, 0x72, 0x8b, 0xcf, 0x43, 0x1c, 0x26, 0x5b, 0x77, 0xa0, 0xe2, 0x7a,
-	0xd6, 0x50, 0xf7, 0x2e, 0xd8, 0xb5, 0x38, 0x37, 0xf8, 0x20, 0x40, 0x4f, 0xc9, 0x05, 0xb5, 0xf6,
-	0xce, 0xc0, 0xd4, 0x5c, 0xfd, 0x82, 0x78, 0x4c, 0x9e, 0xcb, 0xb8, 0xe4, 0x0c, 0xcc, 0x7d, 0xda,
-	0xe6, 0x39, 0xc1, 0x99, 0xe8, 0xe4, 0x56, 0xbe, 0x64, 0x93, 0x33, 0xde, 0xb9, 0x06, 0x14, 0x91,
-	0x7b, 0xd8, 0x32, 0xf7, 0xb0, 0xce, 0xc0, 0x94, 0xce, 0x97, 0xd2, 0xb1, 0x2e, 0xe0, 0x5d, 0x36,
-	0xe1, 0xce, 0xd7, 0xbc, 0xea, 0x19, 0xec, 0x74, 0x7a, 0x6d, 0xdc, 0x57, 0x32, 0x49, 0xe8, 0xc1,
-	0x7e, 0x8b, 0x9e, 0x4c, 0x36, 0x09, 0xc5, 0xed, 0xbd, 0xee, 0xb3, 0xb6, 0x32, 0xa7, 0xfe, 0x7d,
-	0x15, 0xf2, 0x2c, 0x18, 0xbd, 0x82, 0xf8, 0x32, 0xfc, 0xd7, 0x3e, 0xb1, 0x25, 0xc8, 0xf3, 0x6d,
-	0xe2, 0x47, 0xc6, 0x1b, 0x91, 0x46, 0xe6, 0x62, 0x1a, 0x49, 0xa1, 0x3c, 0xcd, 0xe2, 0x86, 0x88,
-	0x37, 0xe8, 0x4c, 0xe9, 0x19, 0xfa, 0xae, 0x2e, 0xac, 0xcf, 0x2b, 0x66, 0xda, 0x91, 0xc8, 0x38,
-	0xa2, 0xa3, 0xe2, 0x30, 0xb2, 0xad, 0x1f, 0x8d, 0x08, 0x3b, 0x6e, 0x7e, 0x64, 0x65, 0x0e, 0xa1,
-	0xa7, 0xfd, 0xed, 0x30, 0x1e, 0x29, 0xb2, 0x01, 0xd4, 0x59, 0x03, 0x24, 0xa3, 0x12, 0xf5, 0x3f,
-	0x0b, 0x97, 0x38, 0xba, 0x75, 0x58, 0x19, 0x57, 0x1f, 0xad, 0xdf, 0xd8, 0xda, 0x6d, 0x2b, 0x19,
-	0x74, 0x1b, 0xd6, 0xa3, 0xbe, 0x56, 0xfb, 0x49, 0x1b, 0xe3, 0x76, 0x4b, 0xeb, 0xe3, 0xaf, 0xb4,
-	0x46, 0xab, 0xa5, 0x64, 0xd1, 0x5d, 0xb8, 0x35, 0xa5, 0xbf, 0xd9, 0xe8, 0x34, 0xdb, 0xbb, 0xca,
-	0xdc, 0x0c, 0x94, 0xfd, 0x83, 0xde, 0x76, 0xbb, 0xa5, 0xe4, 0xd0, 0xbb, 0xf0, 0xf6, 0x14, 0x14,
-	0xdc, 0xd8, 0xd3, 0x9a, 0x5d, 0x8c, 0xdb, 0x4d, 0xda, 0xa7, 0xe4, 0x91, 0x0a, 0xb7, 0xa7, 0xa1,
-	0x32, 0x41, 0x6a, 0x29, 0x05, 0x54, 0x87, 0xa5, 0x38, 0xce, 0x6e, 0xbb, 0xdf, 0x6e, 0x1c, 0xf4,
-	0xb7, 0x95, 0x22, 0x5a, 0x01, 0x14, 0xf5, 0xec, 0xee, 0x74, 0x9e, 0x32, 0x78, 0x29, 0x49, 0xd1,
-	0x69, 0x3f, 0x6f, 0x34, 0x9b, 0xdd, 0x83, 0x4e, 0x5f, 0x29, 0xa3, 0x3b, 0x70, 0x23, 0xea, 0xd9,
-	0xc7, 0x3b, 0x7b, 0x0d, 0xfc, 0x42, 0xdb, 0xe9, 0xb4, 0xda, 0x7c, 0x07, 0x20, 0x39, 0xa1, 0x24,
-	0x82, 0x10, 0xed, 0xca, 0x2c, 0x1c, 0xa1, 0x14, 0x55, 0xf4, 0x01, 0x7c, 0x63, 0x36, 0x0e, 0x1d,
-	0x8f, 0xce, 0x4d, 0xdb, 0x6f, 0xbc, 0x68, 0x63, 0xa5, 0x86, 0x3e, 0x84, 0x07, 0xaf, 0xa0, 0xe0,
-	0x13, 0xd0, 0xba, 0xbb, 0x2d, 0x41, 0x34, 0x9f, 0x3c, 0x6c, 0xd1, 0xcf, 0x0f, 0x7b, 0x21, 0x79,
-	0x52, 0xbd, 0x76, 0xb3, 0xdb, 0x69, 0x25, 0x57, 0xab, 0xa0, 0xaf, 0xc1, 0xc6, 0x74, 0x14, 0xb1,
-	0xde, 0x45, 0xf4, 0x08, 0x36, 0xa7, 0x63, 0xa5, 0xae, 0x06, 0xa1, 0x8f, 0xe1, 0xe1, 0x2b, 0x69,
-	0x26, 0xd6, 0x73, 0x3d, 0x69, 0x4b, 0x7a, 0xed, 0x7e, 0x63, 0x6b, 0x47, 0x59, 0x4a, 0x4a, 0x7a,
-	0xaf, 0xdd, 0x6f, 0x76, 0x5b, 0x6d, 0x65, 0x39, 0x79, 0xcc, 0x07, 0x9d, 0x50, 0x00, 0x56, 0x92,
-	0xc7, 0xcc, 0x47, 0xa3, 0x3d, 0xd2, 0x9b, 0xac, 0x4e, 0x45, 0x10, 0xe7, 0x57, 0x57, 0xff, 0x2b,
-	0x03, 0xe5, 0x50, 0xbd, 0xe9, 0x04, 0x3a, 0x8d, 0xbd, 0x76, 0x6f, 0xbf, 0xd1, 0x6c, 0xc7, 0x54,
-	0x6d, 0x11, 0x6a, 0x11, 0x98, 0x4e, 0x35, 0x93, 0xc4, 0x94, 0x72, 0x97, 0x45, 0x08, 0xe6, 0x63,
-	0x60, 0x3a, 0xc9, 0x39, 0xb4, 0x0a, 0xd7, 0x93, 0x30, 0x26, 0xc2, 0x4a, 0x2e, 0x89, 0xcc, 0xd6,
-	0x9a, 0xa7, 0x07, 0x1d, 0xc1, 0xe2, 0x8a, 0xa2, 0x14, 0xd0, 0x2d, 0x58, 0x8b, 0xfa, 0xc6, 0xf6,
-	0x5a, 0x29, 0xa2, 0xeb, 0xb0, 0x10, 0x75, 0x73, 0xe1, 0x28, 0x25, 0x07, 0x67, 0x40, 0x0d, 0x77,
-	0x9f, 0x2b, 0x65, 0xf5, 0xe7, 0x51, 0x62, 0x89, 0x60, 0xbe, 0xd1, 0x1c, 0xb3, 0x2e, 0xf3, 0x00,
-	0x02, 0x46, 0x25, 0x28, 0x43, 0xb7, 0x40, 0xb4, 0x85, 0x85, 0xc8, 0xd2, 0x2d, 0x90, 0xa0, 0x48,
-	0xd5, 0xe7, 0xd0, 0x02, 0x54, 0x04, 0x98, 0x1a, 0x0a, 0x25, 0x17, 0x23, 0x15, 0x92, 0x96, 0x8f,
-	0x81, 0xc4, 0x41, 0x14, 0xd4, 0xdf, 0xc9, 0xc0, 0xc2, 0x58, 0x4d, 0x82, 0x47, 0x8a, 0x61, 0x49,
-	0x23, 0xac, 0x20, 0x56, 0x23, 0xe0, 0x8e, 0x39, 0x66, 0x87, 0xb3, 0xe3, 0x76, 0xf8, 0x0a, 0xde,
-	0x82, 0x86, 0xdd, 0x45, 0x51, 0x8c, 0x40, 0xed, 0x49, 0x6f, 0xf6, 0xce, 0xcc, 0xf2, 0xc5, 0x1b,
-	0xf6, 0x67, 0x32, 0x2e, 0xc9, 0xa5, 0xc5, 0x25, 0xf9, 0xe9, 0x71, 0x49, 0x61, 0x2c, 0x2e, 0x51,
-	0x3b, 0x6f, 0x26, 0x0c, 0x10, 0x67, 0x97, 0x55, 0xff, 0x29, 0x07, 0x05, 0x5e, 0x30, 0x43, 0xad,
-	0xc9, 0x3d, 0xba, 0x37, 0xab, 0xc2, 0xf6, 0xda, 0x5b, 0xb4, 0x02, 0x05, 0x9f, 0xd8, 0x66, 0xb8,
-	0x47, 0xa2, 0x45, 0xa3, 0x26, 0xfe, 0x2b, 0xaa, 0xe8, 0x96, 0x38, 0x60, 0xc7, 0x8c, 0xf6, 0x35,
-	0x1f, 0xdf, 0xd7, 0xbb, 0x50, 0x65, 0x57, 0x6c, 0xfe, 0x09, 0x4d, 0xeb, 0x02, 0xb1, 0x5f, 0x95,
-	0x10, 0xd6, 0x08, 0x68, 0x24, 0xc7, 0xeb, 0xdb, 0x23, 0x3b, 0xb0, 0x06, 0x22, 0x54, 0x03, 0x06,
-	0x3a, 0xa0, 0x10, 0x2a, 0x97, 0x51, 0xd1, 0x9e, 0x32, 0xe1, 0xde, 0xbf, 0x1a, 0x01, 0x1b, 0x41,
-	0x4a, 0xd0, 0x5d, 0xbe, 0x44, 0xd0, 0xfd, 0x1b, 0x5c, 0x28, 0xaa, 0x7f, 0x93, 0x79, 0xdd, 0xa8,
-	0x1b, 0xad, 0xc1, 0x72, 0xcc, 0x29, 0x1d, 0xf4, 0x42, 0x13, 0x3a, 0x16, 0xf6, 0x3d, 0x69, 0xec,
-	0xec, 0xb6, 0x5b, 0xca, 0xdc, 0x18, 0x1b, 0x6e, 0x12, 0x72, 0xe8, 0x06, 0xac, 0x46, 0xd0, 0xbd,
-	0x6e, 0x6b, 0xe7, 0xc9, 0x0b, 0xd9, 0x99, 0x4f, 0xef, 0xe4, 0xa3, 0x14, 0xd4, 0x5f, 0x67, 0x58,
-	0xee, 0x24, 0x04, 0xeb, 0x11, 0x2c, 0xfb, 0xce, 0xc8, 0x33, 0x88, 0x36, 0xb6, 0x85, 0xdc, 0x00,
-	0x5c, 0xe7, 0x9d, 0xfd, 0xe9, 0xc5, 0x94, 0xf1, 0x3b, 0x85, 0xf8, 0x43, 0x9d, 0xb9, 0xe4, 0x43,
-	0x9d, 0x64, 0xed, 0x24, 0x77, 0x95, 0xda, 0xc9, 0xc7, 0x50, 0x14, 0x05, 0x66, 0x71, 0x59, 0x30,
-	0xbb, 0xbe, 0x5c, 0xe0, 0xf5, 0x65, 0xf5, 0x3f, 0x32, 0x50, 0x0e, 0xcb, 0xc6, 0x54, 0xd1, 0x5f,
-	0x5a, 0xb6, 0x5c, 0x1a, 0xfb, 0x7d, 0x19, 0x95, 0x78, 0x1b, 0xe6, 0x65, 0x8d, 0x5a, 0x24, 0xff,
-	0x22, 0xa7, 0x13, 0xd0, 0x16, 0xaf, 0x01, 0x7c, 0x13, 0x8a, 0x02, 0x20, 0x96, 0x76, 0x6b, 0x66,
-	0x19, 0x1b, 0x4b, 0x6c, 0x75, 0x0b, 0x72, 0x4f, 0xe9, 0x54, 0x14, 0xa8, 0x3e, 0xdd, 0xe9, 0xb4,
-	0x62, 0x12, 0xb4, 0x0c, 0x8b, 0x0c, 0xb2, 0x8f, 0xa9, 0xe7, 0xeb, 0xef, 0x3c, 0xe3, 0x22, 0xb4,
-	0x08, 0x35, 0x06, 0x0e, 0x41, 0x59, 0xf5, 0xc7, 0xa0, 0x8c, 0xd7, 0x66, 0xd1, 0x07, 0xb0, 0x34,
-	0x56, 0x95, 0xe1, 0x4b, 0xa4, 0xcb, 0xcf, 0x63, 0x94, 0xa8, 0xc9, 0xf0, 0x95, 0x7e, 0x14, 0xbf,
-	0xa5, 0x4d, 0xd9, 0x96, 0xe8, 0x4a, 0x3a, 0x46, 0xa5, 0xfe, 0x4b, 0x16, 0x0a, 0xbc, 0xb8, 0x7e,
-	0x05, 0x33, 0xc5, 0x09, 0x5e, 0xdb, 0x4c, 0x35, 0x78, 0x9e, 0xe6, 0x12, 0x6f, 0x28, 0xde, 0x29,
-	0xdd, 0x7b, 0x55, 0xb9, 0xb5, 0x7b, 0xf8, 0x43, 0x62, 0x04, 0x2c, 0x9f, 0xa3, 0x40, 0xca, 0x82,
-	0xe5, 0x81, 0x94, 0x45, 0xf9, 0x6a, 0x2c, 0x68, 0xba, 0x48, 0xbc, 0xe1, 0xff, 0x52, 0xde, 0xf7,
-	0xab, 0x0c, 0x28, 0xe3, 0x73, 0xa0, 0x26, 0xd7, 0x39, 0xb3, 0xc3, 0x12, 0x15, 0x6f, 0xa4, 0x96,
-	0x24, 0x3e, 0x83, 0x2a, 0x7b, 0x45, 0x3b, 0x72, 0xf9, 0x97, 0x50, 0xaf, 0xbe, 0xd0, 0xab, 0x50,
-	0xfc, 0x03, 0x57, 0x7e, 0x27, 0x55, 0x8e, 0x1e, 0x66, 0xe7, 0x66, 0x15, 0x18, 0x63, 0xcf, 0xc3,
-	0x43, 0x0a, 0xf5, 0x27, 0x00, 0xd1, 0xdc, 0x53, 0x5f, 0xf9, 0xae, 0x40, 0xc1, 0xd5, 0x3d, 0x62,
-	0x87, 0x35, 0x35, 0xde, 0x42, 0x2d, 0xa8, 0x79, 0xe4, 0x47, 0x23, 0xcb, 0xa3, 0xde, 0x63, 0x14,
-	0x9c, 0x88, 0x89, 0xbf, 0x72, 0xf0, 0xaa, 0xa4, 0xa2, 0x20, 0xf5, 0xdf, 0x32, 0x50, 0x8e, 0x5e,
-	0x7d, 0xbf, 0xf9, 0xe7, 0xd8, 0xe8, 0x0b, 0x28, 0x89, 0x52, 0x95, 0xbc, 0x14, 0xff, 0xfa, 0xa5,
-	0x0a, 0xff, 0x82, 0x49, 0x48, 0x8c, 0x3e, 0x81, 0xfc, 0x99, 0x6e, 0x05, 0xf2, 0x7e, 0x7c, 0xca,
-	0xfb, 0xad, 0xe7, 0xba, 0x15, 0x08, 0x52, 0x8e, 0xae, 0x6e, 0x41, 0x39, 0x9c, 0x13, 0x8d, 0x50,
-	0xa2, 0x97, 0x2e, 0x62, 0x9b, 0xcb, 0xe1, 0x43, 0x17, 0xba, 0xd7, 0x67, 0x0c, 0x51, 0x7e, 0x03,
-	0xcb, 0x5b, 0xea, 0x17, 0xb0, 0x30, 0x36, 0x3d, 0x2a, 0x60, 0xba, 0x11, 0x38, 0xa1, 0x80, 0xb1,
-	0x06, 0xba, 0x0d, 0xe0, 0x86, 0x88, 0xe2, 0xc0, 0x62, 0x10, 0xf5, 0x14, 0x96, 0x53, 0xd7, 0x89,
-	0xda, 0x09, 0xc2, 0xcc, 0xac, 0x2f, 0x6c, 0xc6, 0x6f, 0x48, 0x62, 0x84, 0x53, 0x17, 0xf0, 0x18,
-	0x20, 0xda, 0x19, 0xea, 0x83, 0xe8, 0xde, 0xb0, 0x5b, 0x73, 0xf1, 0xd5, 0x03, 0x6d, 0xf7, 0x88,
-	0x31, 0x95, 0xc1, 0x3f, 0xcc, 0x41, 0x49, 0xde, 0xad, 0xa1, 0x27, 0x93, 0x66, 0xec, 0xfe, 0xec,
-	0xeb, 0xb8, 0x74, 0x43, 0xf6, 0x29, 0xe4, 0xfd, 0x40, 0x0f, 0xc8, 0xec, 0x47, 0x70, 0x9c, 0x47,
-	0x8f, 0x22, 0x6e, 0x5f, 0xc3, 0x9c, 0x02, 0x7d, 0x17, 0x0a, 0xec, 0x61, 0xf1, 0xb1, 0x10, 0x7b,
-	0x75, 0x16, 0x6d, 0x93, 0x61, 0x6e, 0x5f, 0xc3, 0x82, 0x06, 0x61, 0x98, 0x97, 0x05, 0x5a, 0x86,
-	0x20, 0xbf, 0x95, 0x7a, 0x77, 0x16, 0x17, 0x51, 0x93, 0xdd, 0x65, 0x04, 0xdb, 0xd7, 0x70, 0x4d,
-	0x8f, 0x03, 0x50, 0x17, 0x24, 0x40, 0x8b, 0x0a, 0x3d, 0x95, 0xd9, 0x1b, 0x23, 0x58, 0xb2, 0x5b,
-	0xb6, 0xed, 0x6b, 0x58, 0x56, 0x8d, 0x59, 0xfb, 0x4d, 0x85, 0xcb, 0xd2, 0x7a, 0x6e, 0x15, 0xb8,
-	0x7b, 0x57, 0xff, 0x7b, 0x0e, 0x2a, 0xb1, 0x3d, 0x45, 0x3f, 0x80, 0x55, 0xfd, 0x94, 0x78, 0xfa,
-	0x31, 0x91, 0xcf, 0x3d, 0xc2, 0x27, 0x01, 0x33, 0x1f, 0x38, 0xb2, 0x59, 0x36, 0x0c, 0x63, 0x34,
-	0x1c, 0x0d, 0xa8, 0xa7, 0xc4, 0x4b, 0x82, 0x0d, 0x7f, 0x20, 0x22, 0x9f, 0x11, 0x4c, 0xb0, 0x0f,
-	0x2f, 0x29, 0xc5, 0xb1, 0xbf, 0x16, 0x7b, 0xf9, 0x08, 0x84, 0x5d, 0x4e, 0x89, 0x0f, 0x72, 0xa3,
-	0x79, 0xf3, 0xcb, 0x05, 0xf9, 0x89, 0x6d, 0x38, 0x95, 0x18, 0x6e, 0x34, 0x89, 0x5c, 0x02, 0x37,
-	0xe4, 0x7b, 0x1f, 0x14, 0xfe, 0x51, 0x26, 0xe5, 0x2a, 0x54, 0x82, 0x97, 0xee, 0xe6, 0x19, 0xbc,
-	0x43, 0xa4, 0x36, 0x85, 0x98, 0x94, 0xa7, 0xc0, 0x2c, 0xc4, 0x30, 0x9b, 0xee, 0x48, 0x60, 0xde,
-	0x83, 0x05, 0x8e, 0xe9, 0xe9, 0x43, 0xed, 0xf0, 0x22, 0x20, 0xbe, 0xb8, 0x4d, 0xa8, 0x31, 0x30,
-	0xd6, 0x87, 0x5b, 0x14, 0x48, 0xe7, 0x79, 0x6a, 0x79, 0xc1, 0x48, 0x8c, 0xce, 0xce, 0x8a, 0xb9,
-	0xf1, 0x1c, 0x5e, 0x10, 0x1d, 0x1d, 0xc2, 0xe5, 0x2e, 0x8e, 0x4b, 0xc7, 0xe7, 0xb8, 0xe5, 0x04,
-	0x6e, 0xd3, 0x1d, 0x31, 0x5c, 0xf5, 0x5f, 0xb3, 0x50, 0x8d, 0x6b, 0x04, 0xfa, 0x2d, 0x58, 0x0a,
-	0x89, 0x34, 0x57, 0xf7, 0xf4, 0x21, 0x09, 0x88, 0xe7, 0x8b, 0x73, 0x9f, 0xf2, 0xfc, 0xba, 0x4d,
-	0xdd, 0x9f, 0x65, 0x30, 0x96, 0xfb, 0x21, 0x0d, 0x46, 0x86, 0x18, 0x26, 0x82, 0x51, 0xfe, 0xe1,
-	0x02, 0xe2, 0xfc, 0xb3, 0xaf, 0xc3, 0xdf, 0x16, 0x4b, 0x8e, 0xf1, 0x7f, 0x02, 0x1b, 0x52, 0xe7,
-	0xa2, 0xab, 0x6f, 0x29, 0x6d, 0x67, 0x96, 0x6d, 0x3a, 0x67, 0xe2, 0x32, 0xfb, 0xa6, 0xc0, 0x93,
-	0xe7, 0xdb, 0xe0, 0x48, 0xcf, 0x19, 0x4e, 0x9c, 0x4f, 0x74, 0x17, 0x3e, 0xc6, 0x27, 0x97, 0xe0,
-	0x23, 0x65, 0x2a, 0xc1, 0x47, 0xfd, 0xb3, 0x0c, 0x5c, 0x4f, 0x31, 0x16, 0x53, 0xa2, 0x91, 0x3a,
-	0x14, 0x85, 0xd4, 0xb1, 0x0d, 0x29, 0x61, 0xd9, 0x64, 0x1f, 0x2c, 0x45, 0x62, 0x37, 0xc7, 0x2a,
-	0x03, 0x65, 0x3b, 0x94, 0xb8, 0x5b, 0x00, 0x31, 0x59, 0xe3, 0x85, 0x83, 0xb2, 0x11, 0x8a, 0xd9,
-	0x0d, 0x28, 0x47, 0x02, 0x96, 0x67, 0xbd, 0x25, 0x4f, 0xc8, 0x96, 0xfa, 0x8f, 0x19, 0x40, 0x93,
-	0xc6, 0x67, 0xca, 0x0c, 0x9b, 0xf1, 0xf7, 0x41, 0x57, 0xd3, 0xd6, 0xe8, 0x1d, 0x51, 0x13, 0xca,
-	0x91, 0xb6, 0xcd, 0x5d, 0x8d, 0x89, 0x7c, 0xb1, 0x20, 0xd7, 0x14, 0x57, 0x59, 0xba, 0x26, 0x6e,
-	0x29, 0x07, 0xa0, 0x8c, 0x93, 0xd2, 0x20, 0x99, 0x85, 0x75, 0xf2, 0x7e, 0x94, 0xfb, 0x39, 0x16,
-	0xba, 0xc9, 0x4b, 0xd0, 0x35, 0x28, 0x9d, 0xea, 0x83, 0x11, 0xd1, 0x44, 0x0c, 0x9d, 0xc3, 0x45,
-	0xd6, 0x6e, 0x9f, 0xa3, 0x75, 0x28, 0x19, 0x8e, 0xed, 0x8f, 0x86, 0x22, 0x20, 0xcc, 0xe1, 0xb0,
-	0xad, 0xfe, 0x34, 0x0b, 0x2b, 0xe9, 0x32, 0x4a, 0xbd, 0x67, 0xa0, 0x7b, 0xc7, 0x84, 0xdf, 0xd2,
-	0xe5, 0xb0, 0x68, 0x21, 0x05, 0xe6, 0x86, 0xba, 0x1c, 0x84, 0xfe, 0xe4, 0x67, 0xef, 0x59, 0x4e,
-	0xf8, 0xda, 0x42, 0x36, 0x69, 0x3a, 0x35, 0xd4, 0xcf, 0xb5, 0xe1, 0x68, 0x10, 0x58, 0xee, 0xc0,
-	0x12, 0x5f, 0xd9, 0xf0, 0xa7, 0x6f, 0x7b, 0x21, 0x10, 0x7d, 0xce, 0xfe, 0x5b, 0x41, 0xe0, 0xe9,
-	0x46, 0xa0, 0x79, 0xd4, 0x87, 0xe6, 0x67, 0x7d, 0x8e, 0x8c, 0xa9, 0x17, 0x61, 0xff, 0xca, 0x80,
-	0x51, 0x60, 0xee, 0x42, 0x2b, 0xe4, 0xdc, 0xd5, 0x6d, 0x93, 0xd3, 0x17, 0x5e, 0x4d, 0x0f, 0x1c,
-	0x9f, 0x52, 0xab, 0x5f, 0x40, 0x9e, 0x01, 0x69, 0xcc, 0x68, 0x8f, 0x86, 0xd4, 0x4f, 0x89, 0x60,
-	0x28, 0x87, 0x23, 0x00, 0xda, 0x80, 0x8a, 0x49, 0x6c, 0x67, 0x68, 0xd9, 0xac, 0x9f, 0xef, 0x40,
-	0x1c, 0xa4, 0xfe, 0x65, 0x8e, 0xe6, 0xdb, 0xf2, 0xc2, 0x5d, 0x16, 0x9b, 0x78, 0x12, 0xc6, 0x8b,
-	0x4d, 0x69, 0x51, 0x7b, 0x1d, 0x8a, 0x43, 0xe2, 0x87, 0x22, 0x55, 0xc6, 0xb2, 0x89, 0x3e, 0x67,
-	0x41, 0x85, 0xf1, 0x52, 0xc4, 0x89, 0xef, 0xbd, 0xe2, 0xb6, 0x7f, 0x73, 0xd7, 0x39, 0xde, 0xe3,
-	0xa4, 0x98, 0x13, 0xae, 0xff, 0x04, 0x20, 0x02, 0xa2, 0x16, 0x7b, 0x5b, 0x11, 0x90, 0x73, 0xf9,
-	0x1a, 0xe4, 0x32, 0x1c, 0xc5, 0xd7, 0x5a, 0x58, 0x92, 0x52, 0xc9, 0x38, 0x72, 0xbc, 0xa1, 0x1e,
-	0x46, 0xf1, 0xbc, 0x15, 0xde, 0xc3, 0xe6, 0xa2, 0x7b, 0xd8, 0xf5, 0x3f, 0xcd, 0xb2, 0x09, 0x08,
-	0x1e, 0x54, 0x35, 0x07, 0x34, 0xd0, 0x93, 0xaa, 0xc9, 0x1a, 0x94, 0xf0, 0xc8, 0x1a, 0x84, 0x9b,
-	0x42, 0x7f, 0x53, 0xd8, 0xc0, 0xb2, 0xf9, 0x8e, 0xe4, 0x31, 0xfb, 0x4d, 0x07, 0x1e, 0x92, 0xe0,
-	0xc4, 0x91, 0x55, 0x29, 0xd1, 0xa2, 0x12, 0x7e, 0xe2, 0xf8, 0x41, 0xec, 0x06, 0x31, 0x6c, 0xa3,
-	0x3b, 0x50, 0xa1, 0x51, 0xbf, 0x6e, 0xc6, 0x0b, 0x79, 0xc0, 0x41, 0xec, 0x86, 0x31, 0xf1, 0xf5,
-	0x58, 0xf1, 0x2a, 0x5f, 0x8f, 0xc5, 0x76, 0xb3, 0xf4, 0xda, 0xbb, 0xa9, 0xfe, 0x3a, 0x0b, 0x45,
-	0x51, 0x27, 0x48, 0x29, 0x3f, 0x64, 0xd2, 0xca, 0x0f, 0x04, 0x56, 0xfd, 0x11, 0xcb, 0x0d, 0xad,
-	0x53, 0xa2, 0x79, 0xc4, 0x0f, 0x3c, 0x2b, 0x7c, 0xee, 0x3b, 0xc3, 0x1b, 0xf5, 0x42, 0x22, 0x1c,
-	0xa3, 0xc1, 0x2b, 0x7e, 0x2a, 0x1c, 0xbd, 0x0f, 0xc8, 0x24, 0xbe, 0xe1, 0x59, 0x6c, 0xf2, 0xc9,
-	0x82, 0xc8, 0x62, 0xac, 0x47, 0xcc, 0x4a, 0x85, 0xaa, 0x49, 0xa8, 0xd5, 0x27, 0xb6, 0x61, 0x11,
-	0x9e, 0xdb, 0x94, 0x71, 0x02, 0x86, 0x1e, 0xc1, 0xf2, 0xf8, 0xf7, 0xeb, 0x1a, 0xbb, 0xcf, 0xe7,
-	0xc7, 0x76, 0x7d, 0xec, 0x1b, 0xf6, 0xfe, 0x85, 0x4b, 0xd0, 0x0e, 0xd4, 0x7c, 0x97, 0x18, 0xd6,
-	0x91, 0x65, 0xe8, 0xe2, 0x83, 0xaa, 0xb9, 0xe9, 0x0f, 0x99, 0x7a, 0x71, 0x54, 0x9c, 0xa4, 0x54,
-	0x7f, 0x99, 0x81, 0x95, 0xf4, 0x4d, 0x60, 0x0f, 0x92, 0x6c, 0xfd, 0x70, 0x40, 0x78, 0xb2, 0x58,
-	0xc2, 0xb2, 0x89, 0x3e, 0x86, 0x15, 0xd7, 0x23, 0xe2, 0x7f, 0x8f, 0xf0, 0x2f, 0x1f, 0x78, 0xd2,
-	0x29, 0x3c, 0xdd, 0x72, 0xa2, 0x17, 0x8b, 0x4e, 0xf4, 0x05, 0x6c, 0x10, 0xdd, 0x1b, 0x58, 0xc4,
-	0x0f, 0x34, 0x7d, 0x30, 0x70, 0xce, 0x68, 0x6e, 0x1b, 0x31, 0x09, 0x1f, 0xdc, 0x96, 0xf1, 0x2d,
-	0x89, 0xd7, 0xe0, 0x68, 0x8d, 0x10, 0x8b, 0x8a, 0x9d, 0xfa, 0x29, 0xd4, 0x12, 0x8b, 0x4a, 0xcd,
-	0xac, 0x97, 0x20, 0xcf, 0xec, 0xbd, 0xd0, 0x21, 0xde, 0x50, 0xff, 0x3d, 0x03, 0x48, 0xb8, 0x46,
-	0x59, 0x32, 0xc2, 0xe4, 0x68, 0xc6, 0x0b, 0x8c, 0x3a, 0x14, 0x45, 0xad, 0x48, 0x7e, 0x79, 0x27,
-	0x9a, 0x93, 0x5f, 0xde, 0x4d, 0x2b, 0x04, 0xe6, 0x66, 0x15, 0x02, 0xf3, 0x57, 0x29, 0x04, 0x5e,
-	0xee, 0xbd, 0xd7, 0x7b, 0xbf, 0xca, 0x00, 0xe2, 0x5f, 0x49, 0x8b, 0x2f, 0x02, 0xac, 0x01, 0xcd,
-	0xff, 0x6f, 0xc0, 0xea, 0xd6, 0x6e, 0xb7, 0xf9, 0x14, 0xb7, 0x9f, 0xb5, 0x71, 0x6f, 0x67, 0x6b,
-	0x67, 0x77, 0xa7, 0xff, 0x42, 0xeb, 0x74, 0x3b, 0x6d, 0xe5, 0x1a, 0xba, 0x0b, 0xb7, 0x52, 0x3a,
-	0x65, 0x8b, 0xdd, 0xf6, 0xbe, 0x05, 0x77, 0x52, 0x50, 0x76, 0x70, 0x0c, 0x29, 0x8b, 0x6e, 0x42,
-	0x3d, 0x05, 0xa9, 0xd7, 0x6f, 0xec, 0xb6, 0xf9, 0x6d, 0x6f, 0x4a, 0xef, 0x5e, 0xe3, 0xc5, 0x56,
-	0x9b, 0xa3, 0xe4, 0xde, 0xfb, 0x59, 0xf2, 0xb5, 0xbb, 0xf8, 0xd4, 0x66, 0x1d, 0x56, 0xfa, 0xb8,
-	0xd1, 0xe9, 0xf1, 0xeb, 0x9c, 0x5e, 0xbf, 0xd1, 0x3f, 0xe8, 0xc9, 0xa9, 0xdf, 0x86, 0xf5, 0xc9,
-	0xbe, 0xf6, 0x57, 0xed, 0xe6, 0x41, 0xbf, 0xdd, 0xe2, 0xb7, 0xd4, 0x93, 0xfd, 0xbd, 0xee, 0x93,
-	0xfe, 0x93, 0xc6, 0xce, 0xae, 0x92, 0x4d, 0xef, 0xdf, 0x6e, 0xe0, 0x16, 0xeb, 0x9f, 0x43, 0xb7,
-	0x60, 0x6d, 0xb2, 0xbf, 0xd5, 0xde, 0x6d, 0xbc, 0x60, 0xd7, 0xd3, 0xa9, 0xdd, 0xed, 0xaf, 0xf6,
-	0x77, 0x70, 0xbb, 0xa5, 0xe4, 0xd3, 0xbb, 0x65, 0x8e, 0x57, 0x48, 0x1f, 0x9c, 0xd7, 0xb2, 0xdb,
-	0x2d, 0xa5, 0xb8, 0xd5, 0xf8, 0xde, 0xe3, 0x63, 0x2b, 0x38, 0x19, 0x1d, 0x6e, 0x1a, 0xce, 0xf0,
-	0x01, 0x53, 0xf0, 0xf7, 0x2d, 0x47, 0xfc, 0xe0, 0xff, 0x41, 0xcc, 0x3d, 0x7c, 0x90, 0xf6, 0x0f,
-	0xc5, 0xbe, 0xe3, 0x1e, 0xb2, 0x9f, 0x87, 0x05, 0x26, 0x54, 0x1f, 0xfe, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x17, 0xe3, 0x1f, 0x3c, 0x77, 0x4c, 0x00, 0x00,
+	// 5980 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0x4b, 0x6c, 0x1c, 0xc9,
+	0x79, 0xb0, 0xe6, 0x3d, 0xf3, 0xcd, 0x0c, 0x39, 0x2c, 0xf1, 0xd1, 0xa2, 0xb4, 0xbb, 0x54, 0xaf,
+	0x77, 0x57, 0x2b, 0x7b, 0xa9, 0x95, 0x76, 0xd7, 0xf6, 0xda, 0xde, 0x5f, 0x3b, 0x9c, 0x19, 0x2d,
+	0xb9, 0x22, 0x67, 0x88, 0xe2, 0x48, 0x5a, 0xf9, 0xb7, 0xd3, 0x68, 0x76, 0x17, 0xc9, 0xb6, 0x66,
+	0xba, 0xdb, 0xdd, 0x3d, 0x7c, 0x18, 0x81, 0x81, 0x20, 0x97, 0x04, 0xb0, 0x2f, 0xb9, 0x04, 0x48,
+	0x10, 0x24, 0x08, 0x7c, 0xcd, 0x21, 0x06, 0x02, 0x24, 0x0e, 0x7c, 0x0c, 0x90, 0x6b, 0x90, 0x53,
+	0x0e, 0x49, 0x80, 0x1c, 0x82, 0xf8, 0x92, 0x63, 0x72, 0x0d, 0xea, 0xd5, 0xaf, 0xe9, 0x19, 0x91,
+	0xf2, 0x26, 0x48, 0x4e, 0x9c, 0xfa, 0xea, 0xfb, 0xbe, 0x7a, 0x7d, 0xf5, 0x3d, 0xab, 0x09, 0x1b,
+	0xe6, 0xd1, 0xc4, 0x27, 0xf7, 0x88, 0xe3, 0x5b, 0xce, 0x3d, 0xc3, 0x31, 0x89, 0x71, 0xef, 0xf4,
+	0x3e, 0xff, 0xb1, 0xe9, 0x7a, 0x4e, 0xe0, 0xa0, 0x65, 0x86, 0xb1, 0xc9, 0x30, 0x36, 0x79, 0xc7,
+	0xe9, 0xfd, 0xf5, 0x37, 0x8e, 0x1d, 0xe7, 0x78, 0x44, 0xee, 0x31, 0x9c, 0xc3, 0xc9, 0xd1, 0xbd,
+	0xc0, 0x1a, 0x13, 0x3f, 0xd0, 0xc7, 0x2e, 0x27, 0x53, 0xff, 0x68, 0x01, 0x4a, 0x5b, 0x23, 0xc7,
+	0x78, 0x81, 0x16, 0x20, 0x6f, 0x99, 0x4a, 0x6e, 0x23, 0x77, 0xa7, 0x86, 0xf3, 0x96, 0x89, 0x56,
+	0xa1, 0x6c, 0x4f, 0xc6, 0x87, 0xc4, 0x53, 0xf2, 0x1b, 0xb9, 0x3b, 0x4d, 0x2c, 0x5a, 0xe8, 0x63,
+	0x28, 0x9f, 0x10, 0xdd, 0x24, 0x9e, 0x52, 0xdc, 0xc8, 0xdd, 0xa9, 0x3f, 0xb8, 0xbd, 0x99, 0x35,
+	0xf2, 0x26, 0x63, 0xba, 0xcd, 0x10, 0xb1, 0x20, 0x40, 0xef, 0x01, 0x72, 0x3d, 0xc7, 0x9c, 0x18,
+	0xc4, 0xd3, 0x7c, 0xeb, 0xd8, 0xd6, 0x83, 0x89, 0x47, 0x94, 0x12, 0x1b, 0x72, 0x49, 0xf6, 0x1c,
+	0xc8, 0x0e, 0xb4, 0x0b, 0x8d, 0xc0, 0xd3, 0x6d, 0x5f, 0x37, 0x02, 0xcb, 0xb1, 0x7d, 0xa5, 0xbc,
+	0x51, 0xb8, 0x53, 0x7f, 0x70, 0x27, 0x7b, 0xbc, 0x61, 0x84, 0x89, 0x89, 0x41, 0x2c, 0x37, 0xc0,
+	0x09, 0x6a, 0xf4, 0x55, 0x58, 0x8a, 0xb5, 0x35, 0xc3, 0x99, 0xd8, 0x81, 0xb2, 0xca, 0x96, 0xd6,
+	0x8a, 0x75, 0x74, 0x28, 0x1c, 0x7d, 0x0e, 0xad, 0x43, 0xba, 0x00, 0x8d, 0x9c, 0x07, 0xc4, 0xf6,
+	0xd9, 0xf0, 0x15, 0x36, 0xfc, 0x1b, 0xd9, 0xc3, 0xf7, 0x24, 0x1e, 0x5e, 0x64, 0x84, 0x61, 0xdb,
+	0x47, 0x7b, 0xf0, 0xa6, 0xe9, 0x3a, 0xbe, 0xe6, 0x7a, 0x8e, 0xeb, 0xf8, 0xc4, 0xd4, 0x2c, 0xcf,
+	0x23, 0xa7, 0xc4, 0xf3, 0xad, 0xc3, 0x11, 0xd1, 0x18, 0xb6, 0x3d, 0x19, 0x2b, 0x55, 0x36, 0x95,
+	0x0d, 0x8a, 0xba, 0x2f, 0x30, 0x77, 0x62, 0x88, 0x5b, 0x02, 0x0f, 0x7d, 0x07, 0xd6, 0x19, 0xbb,
+	0x6c, 0x2e, 0x35, 0xc6, 0x45, 0xa1, 0x18, 0x99, 0xd4, 0xfb, 0x62, 0x61, 0x9e, 0xe3, 0x04, 0xda,
+	0x98, 0x78, 0x2f, 0x46, 0x44, 0xa9, 0xb3, 0x73, 0x7c, 0x6b, 0xce, 0x39, 0x62, 0xc7, 0x09, 0xf6,
+	0x18, 0x32, 0x5e, 0x0c, 0xc9, 0x39, 0x00, 0x1d, 0xc3, 0x8d, 0xf0, 0x50, 0x03, 0x47, 0x1b, 0xe9,
+	0x7e, 0xa0, 0x09, 0x80, 0xa9, 0x34, 0xd8, 0x9e, 0x7d, 0x2d, 0x9b, 0xf5, 0xbe, 0x20, 0x1b, 0x3a,
+	0xbb, 0xba, 0x1f, 0x88, 0x96, 0x89, 0x57, 0xdd, 0x4c, 0x38, 0xb2, 0xe1, 0xd6, 0xd4, 0x40, 0xd6,
+	0xd8, 0x1d, 0x59, 0x6c, 0x4b, 0x0f, 0x95, 0x26, 0x1b, 0x6b, 0xf3, 0x32, 0x63, 0xed, 0x70, 0xb2,
+	0x1d, 0xbc, 0x85, 0x15, 0x37, 0xb3, 0xc7, 0x3b, 0x44, 0x6f, 0x42, 0xd3, 0x70, 0xec, 0x23, 0xcb,
+	0x1b, 0x0b, 0x61, 0x59, 0xdc, 0x28, 0xdc, 0x69, 0xe2, 0x86, 0x00, 0x72, 0x41, 0xf9, 0x02, 0x5a,
+	0x2e, 0xb1, 0x4d, 0xcb, 0x3e, 0xd6, 0x7c, 0xe3, 0x84, 0x98, 0x93, 0x11, 0x51, 0x5a, 0x6c, 0x3f,
+	0xdf, 0x9b, 0x31, 0x11, 0x8e, 0x2d, 0xe7, 0x73, 0x20, 0x88, 0xf0, 0xa2, 0x60, 0x23, 0x01, 0xc8,
+	0x81, 0x9b, 0x54, 0x22, 0x4f, 0xf5, 0x80, 0x98, 0x1a, 0xbb, 0xac, 0x86, 0x33, 0xd2, 0x8e, 0x08,
+	0xbb, 0x1b, 0xbe, 0xb2, 0xc4, 0x06, 0xb9, 0x97, 0x3d, 0x48, 0x5b, 0x12, 0xee, 0x0b, 0xba, 0x47,
+	0x82, 0x0c, 0xdf, 0xd0, 0x67, 0x75, 0xa1, 0x5b, 0x50, 0x3b, 0xd5, 0x47, 0x96, 0x49, 0x3b, 0x15,
+	0xb4, 0x91, 0xbb, 0x53, 0xc5, 0x11, 0x00, 0x7d, 0x02, 0xe0, 0x8d, 0xac, 0xb1, 0x15, 0x68, 0x8e,
+	0xeb, 0x2b, 0xd7, 0xd9, 0x5e, 0xbf, 0x9e, 0x3d, 0x3a, 0x66, 0x78, 0x03, 0x17, 0xd7, 0x3c, 0xf1,
+	0xcb, 0x47, 0x4f, 0x40, 0x61, 0x67, 0x65, 0x58, 0x81, 0x16, 0xbf, 0x86, 0x94, 0xd9, 0x32, 0x63,
+	0x76, 0x73, 0xd6, 0xbd, 0x3e, 0x1f, 0xb8, 0x78, 0x55, 0x12, 0xc7, 0xae, 0x39, 0x67, 0x8b, 0xe2,
+	0xdc, 0x02, 0x4f, 0x37, 0x88, 0xaf, 0xac, 0x30, 0x86, 0x6f, 0xbf, 0x54, 0x51, 0x0c, 0x29, 0x3a,
+	0x5e, 0x0a, 0x52, 0x10, 0x1f, 0x7d, 0x1d, 0xd6, 0xa6, 0xd8, 0x0a, 0x21, 0x58, 0x63, 0x17, 0x6c,
+	0x25, 0x4d, 0xc3, 0xa5, 0xe1, 0xdb, 0xb0, 0x4e, 0xce, 0x89, 0x31, 0x09, 0x88, 0x66, 0xd9, 0xee,
+	0x24, 0xd0, 0x12, 0xca, 0x46, 0x61, 0xa4, 0x6b, 0x02, 0x63, 0x87, 0x22, 0xb4, 0x63, 0x3a, 0xe7,
+	0x13, 0xb8, 0x29, 0xba, 0x4c, 0x2d, 0x70, 0x02, 0x7d, 0x94, 0xa4, 0xbe, 0xc1, 0x6f, 0xb6, 0x44,
+	0x19, 0x52, 0x8c, 0x38, 0xf9, 0x5d, 0x58, 0xe2, 0x2a, 0x8b, 0x6a, 0x56, 0x2a, 0x8f, 0x2f, 0xc8,
+	0x85, 0xb2, 0xc0, 0x74, 0x2b, 0xbf, 0xb3, 0x07, 0x1c, 0xfe, 0x98, 0x5c, 0xa0, 0x21, 0x20, 0x26,
+	0x07, 0x24, 0x14, 0x5a, 0xed, 0xf4, 0xbe, 0x02, 0x1b, 0xb9, 0xd9, 0xdb, 0x36, 0x25, 0xb0, 0x2d,
+	0xce, 0x41, 0xb6, 0x9f, 0xde, 0x47, 0x3e, 0x6c, 0x30, 0x79, 0xd1, 0x92, 0xf3, 0xd0, 0x27, 0xc1,
+	0x89, 0xe3, 0x59, 0xc1, 0x85, 0x76, 0xfa, 0x40, 0x79, 0x9d, 0x8d, 0xf1, 0xd5, 0x39, 0xba, 0x46,
+	0x4c, 0xb3, 0x2d, 0xa9, 0xf0, 0x2d, 0xc6, 0x34, 0xb3, 0xef, 0xe9, 0x03, 0xf4, 0xfd, 0x8c, 0xa5,
+	0x3c, 0x50, 0xde, 0x98, 0x77, 0x3b, 0xe4, 0x52, 0x42, 0x36, 0x33, 0xd7, 0xf4, 0x40, 0xfd, 0x9d,
+	0x02, 0x34, 0xd9, 0xd0, 0xcf, 0xac, 0xe0, 0x04, 0x93, 0x23, 0x7f, 0xca, 0x4e, 0xde, 0x87, 0x12,
+	0x5b, 0x2f, 0x33, 0x93, 0x33, 0xc5, 0x98, 0xab, 0x51, 0x8e, 0x89, 0x74, 0xb8, 0x91, 0x79, 0x19,
+	0x3c, 0x72, 0xe4, 0x2b, 0x85, 0x79, 0xda, 0x38, 0x61, 0xe5, 0x8e, 0x7c, 0xbc, 0x96, 0x71, 0x2f,
+	0xd8, 0x2c, 0xf7, 0xa1, 0x35, 0xc5, 0xb9, 0x78, 0x15, 0xce, 0x8b, 0x41, 0x8a, 0xe3, 0xff, 0x87,
+	0xd5, 0xe9, 0x3b, 0xc1, 0xf8, 0x96, 0xae, 0xc2, 0x77, 0x39, 0x7d, 0x73, 0x18, 0x73, 0x15, 0x1a,
+	0x71, 0x7b, 0xa6, 0x94, 0x99, 0xfa, 0x49, 0xc0, 0xd4, 0x77, 0x61, 0x31, 0xbd, 0xca, 0x55, 0x28,
+	0x9f, 0xe8, 0xfe, 0x09, 0xf1, 0x95, 0xdc, 0x46, 0xe1, 0x4e, 0x03, 0x8b, 0x96, 0xba, 0x0d, 0x37,
+	0x66, 0xaa, 0x40, 0xea, 0x08, 0x4c, 0xab, 0x53, 0x4e, 0xdf, 0x72, 0x53, 0xc8, 0xea, 0x6f, 0xe7,
+	0x61, 0x6d, 0x86, 0xca, 0x46, 0x77, 0xa0, 0x15, 0xca, 0xdc, 0xc8, 0x3a, 0xd4, 0xa8, 0xfd, 0xcd,
+	0xb1, 0x5b, 0xba, 0x20, 0xe1, 0xbb, 0xd6, 0x61, 0x7f, 0x32, 0xa6, 0xa6, 0x24, 0xc4, 0xa4, 0x53,
+	0x64, 0xb2, 0xd2, 0xc0, 0x0d, 0x09, 0xdc, 0xd6, 0xfd, 0x13, 0xf4, 0x19, 0xd4, 0xe3, 0xb7, 0xb1,
+	0x70, 0xa5, 0xdb, 0x08, 0x7e, 0x74, 0x0f, 0xf7, 0xe3, 0x8c, 0x1e, 0x28, 0xc5, 0x57, 0xbb, 0x0b,
+	0x11, 0xc7, 0x07, 0xea, 0x18, 0x5a, 0x53, 0xab, 0x57, 0xa0, 0xc2, 0x8e, 0xc6, 0xb1, 0xc5, 0xa2,
+	0x65, 0x13, 0x3d, 0x84, 0x9a, 0x34, 0xaa, 0xbe, 0x92, 0xdf, 0x28, 0xcc, 0x76, 0x12, 0x25, 0xd3,
+	0xc7, 0xe4, 0x02, 0x47, 0x34, 0xea, 0xf7, 0xa0, 0x1e, 0xeb, 0x41, 0xb7, 0xa1, 0xa1, 0x1b, 0x4c,
+	0x09, 0x6a, 0xb6, 0x3e, 0x26, 0xe2, 0xee, 0xd5, 0x05, 0xac, 0xaf, 0x8f, 0x49, 0xb6, 0xf2, 0xcb,
+	0x67, 0x2a, 0x3f, 0xf5, 0x37, 0xe1, 0xc6, 0xcc, 0x55, 0xcf, 0x59, 0x55, 0x6f, 0x7a, 0x55, 0xef,
+	0x5c, 0x72, 0x4f, 0xe3, 0x6b, 0xfb, 0xc3, 0x1c, 0x2c, 0x4d, 0x21, 0x5c, 0x66, 0x89, 0x06, 0xac,
+	0xcd, 0xd0, 0xab, 0x4a, 0xfe, 0xea, 0x4a, 0x75, 0xe5, 0x30, 0x0b, 0xac, 0x1a, 0xb0, 0x92, 0x89,
+	0x8f, 0x1e, 0x42, 0xfe, 0xf4, 0x7d, 0x25, 0x37, 0xcf, 0xb3, 0xc9, 0xd6, 0xd0, 0xef, 0x6f, 0x5f,
+	0xc3, 0xf9, 0xd3, 0xf7, 0xb7, 0x6a, 0x50, 0x39, 0xd5, 0x3d, 0x4b, 0xb7, 0x03, 0x75, 0x04, 0x6b,
+	0x33, 0x70, 0xa9, 0x0f, 0x12, 0x9c, 0x78, 0xc4, 0x3f, 0x71, 0x46, 0xa6, 0x38, 0x80, 0x08, 0x80,
+	0x3e, 0x80, 0xe2, 0x0b, 0x72, 0x21, 0x77, 0x7f, 0x86, 0x27, 0xfe, 0x98, 0x5c, 0x3c, 0x23, 0xd6,
+	0xf1, 0x49, 0x80, 0x19, 0xb2, 0x7a, 0x00, 0x8b, 0x29, 0x1f, 0x16, 0xbd, 0x06, 0x60, 0x3b, 0xa6,
+	0xb4, 0xe8, 0x62, 0x18, 0x0a, 0xe1, 0x96, 0x94, 0x1d, 0x06, 0x33, 0x29, 0x14, 0xc6, 0x87, 0x6b,
+	0xe0, 0x3a, 0x87, 0xf5, 0x29, 0x48, 0x35, 0x60, 0x35, 0xdb, 0x7b, 0x45, 0x08, 0x8a, 0xb1, 0x13,
+	0x64, 0xbf, 0xd1, 0x47, 0xb0, 0xc6, 0xbc, 0x55, 0x7e, 0x7e, 0xf6, 0x64, 0x1c, 0x39, 0xc8, 0x3c,
+	0xb6, 0x5a, 0xa6, 0xdd, 0x6c, 0x96, 0xfd, 0xc9, 0x58, 0xb2, 0x52, 0x09, 0x28, 0xb3, 0xdc, 0xd6,
+	0x2f, 0x73, 0x98, 0x9f, 0xe7, 0x01, 0x4d, 0x47, 0x4f, 0xc2, 0xce, 0x15, 0x43, 0x3b, 0xb7, 0x0c,
+	0x25, 0xcb, 0x36, 0xc9, 0x39, 0xd3, 0xcd, 0x45, 0xcc, 0x1b, 0xe8, 0x21, 0x94, 0xfd, 0x40, 0x0f,
+	0x26, 0x3e, 0x9b, 0xc9, 0xc2, 0xac, 0x2b, 0x11, 0xe3, 0x7f, 0xc0, 0xd0, 0xb1, 0x20, 0xa3, 0x93,
+	0x36, 0xdc, 0x89, 0x36, 0xf1, 0xf5, 0x63, 0xa2, 0x8d, 0x2d, 0xc3, 0x73, 0x34, 0x9f, 0x18, 0x8e,
+	0x6d, 0xfa, 0x72, 0xd2, 0x86, 0x3b, 0x79, 0x42, 0x7b, 0xf7, 0x68, 0xe7, 0x01, 0xef, 0x43, 0x6f,
+	0xc3, 0xa2, 0x4d, 0x02, 0x41, 0x76, 0xe6, 0x78, 0x26, 0x37, 0x9c, 0x4d, 0xdc, 0xb4, 0x49, 0xc0,
+	0xd0, 0x9f, 0x51, 0x20, 0x7a, 0x0a, 0xc8, 0xd5, 0x8d, 0x17, 0xd4, 0xa5, 0x8a, 0xa6, 0x20, 0x2c,
+	0xd6, 0xac, 0xeb, 0xcb, 0xf0, 0xe3, 0x3b, 0xb2, 0xe4, 0xa6, 0x41, 0xea, 0x2f, 0xe9, 0x35, 0x4e,
+	0x43, 0xd1, 0xeb, 0x00, 0x61, 0x5c, 0xcb, 0x6d, 0x4a, 0x0d, 0xc7, 0x20, 0x68, 0x03, 0xea, 0x86,
+	0x33, 0x76, 0x3d, 0xe2, 0x33, 0x0d, 0xc3, 0x17, 0x18, 0x07, 0xa1, 0x6f, 0x80, 0x22, 0xe6, 0x6b,
+	0x38, 0x76, 0x40, 0xce, 0x03, 0xed, 0xc8, 0x23, 0x44, 0x33, 0xf5, 0x40, 0x67, 0x0b, 0x6c, 0xe0,
+	0x15, 0xde, 0xdf, 0xe1, 0xdd, 0x8f, 0x3c, 0x42, 0xba, 0x7a, 0xa0, 0xb3, 0xd8, 0x7a, 0x7a, 0xa1,
+	0x45, 0x46, 0x92, 0x31, 0xff, 0xbf, 0x2c, 0x40, 0x3d, 0x16, 0xa2, 0xa3, 0x6f, 0x42, 0x2d, 0x4c,
+	0x0d, 0x08, 0xd3, 0xb3, 0xbe, 0xc9, 0x93, 0x07, 0x9b, 0x32, 0x79, 0xb0, 0x39, 0x94, 0x18, 0x38,
+	0x42, 0x46, 0xeb, 0x50, 0x95, 0xda, 0x4d, 0x48, 0x4b, 0xd8, 0xa6, 0xd7, 0x59, 0x44, 0x4b, 0xc4,
+	0x64, 0x9b, 0xde, 0xc4, 0x11, 0x80, 0x53, 0x92, 0x53, 0xcb, 0x99, 0xf8, 0x4a, 0x59, 0x52, 0xf2,
+	0x76, 0x3a, 0x5a, 0x1f, 0xd3, 0x80, 0x53, 0xa9, 0xb0, 0xd5, 0xc4, 0x1d, 0x9b, 0x3d, 0x0a, 0x97,
+	0x17, 0x36, 0xc4, 0xab, 0x6e, 0xe4, 0xe4, 0x85, 0x95, 0x28, 0xef, 0xc6, 0x6c, 0xb5, 0x54, 0xf0,
+	0x3c, 0x56, 0x5e, 0x0c, 0xed, 0x1c, 0x07, 0xa3, 0x5d, 0x58, 0xe2, 0xf9, 0x8a, 0x78, 0xf0, 0x5f,
+	0xbf, 0x5c, 0xf0, 0xdf, 0xe2, 0x94, 0xb1, 0xe8, 0x7f, 0x1f, 0x5a, 0x36, 0x39, 0xd3, 0x42, 0x03,
+	0x70, 0x75, 0x47, 0x7b, 0xc1, 0x26, 0x67, 0x12, 0xe8, 0x3f, 0xbd, 0xaf, 0xfe, 0x2e, 0x40, 0x2b,
+	0x76, 0x94, 0xbd, 0x53, 0x62, 0x07, 0x53, 0x5e, 0xe9, 0x0d, 0xa8, 0x72, 0x35, 0x60, 0x99, 0xc2,
+	0x0e, 0x56, 0x58, 0x7b, 0xc7, 0x44, 0x37, 0xa1, 0x16, 0x6a, 0x08, 0x71, 0x69, 0x38, 0x2e, 0xf5,
+	0x54, 0xd2, 0x8e, 0x58, 0x71, 0xda, 0x11, 0x43, 0x04, 0x96, 0x2c, 0x3b, 0x20, 0x9e, 0x4d, 0x43,
+	0x14, 0xd3, 0xb4, 0x62, 0x57, 0xea, 0xeb, 0x2f, 0xbd, 0xfe, 0x6c, 0xba, 0x9b, 0x6d, 0xd3, 0x24,
+	0xe6, 0x8e, 0x60, 0x32, 0xba, 0xd8, 0xbe, 0x86, 0x5b, 0x92, 0x65, 0x5b, 0x70, 0x44, 0x9f, 0x43,
+	0x35, 0xe4, 0x5e, 0xde, 0xc8, 0xcd, 0xce, 0x23, 0x64, 0x73, 0xdf, 0xbe, 0x86, 0x43, 0x7a, 0x34,
+	0x80, 0x1a, 0x0f, 0x9c, 0x28, 0xb3, 0xca, 0x3c, 0x87, 0x68, 0x8a, 0x59, 0x4f, 0x04, 0x5c, 0xdb,
+	0xd7, 0x70, 0xc4, 0x03, 0x69, 0xb0, 0x68, 0x06, 0xde, 0xb9, 0x0c, 0x3a, 0x2c, 0xfb, 0x98, 0x49,
+	0x5d, 0xfd, 0xc1, 0x87, 0x97, 0x64, 0xdb, 0x0d, 0xbc, 0x73, 0x79, 0xc4, 0x94, 0xf7, 0x82, 0x19,
+	0x01, 0x2c, 0xfb, 0x18, 0x1d, 0xc2, 0x12, 0x1b, 0xc0, 0xd0, 0x6d, 0x83, 0x8c, 0x46, 0x7a, 0x20,
+	0x25, 0xb6, 0xfe, 0xe0, 0x83, 0x2b, 0x0c, 0xd1, 0x61, 0xe4, 0x6c, 0x84, 0x96, 0x19, 0xb6, 0x39,
+	0xbb, 0xf5, 0xef, 0xc1, 0x62, 0xea, 0x20, 0xd0, 0x0e, 0xd4, 0xe3, 0xfa, 0x23, 0x37, 0x4f, 0x51,
+	0x52, 0xfb, 0x9d, 0x54, 0x94, 0x71, 0xda, 0xf5, 0x7f, 0xc8, 0x41, 0x89, 0xb1, 0x47, 0x5b, 0x50,
+	0xf1, 0xb8, 0x55, 0x11, 0x0c, 0x2f, 0x9f, 0xc3, 0x93, 0x84, 0xe9, 0x89, 0xe5, 0x5f, 0x7d, 0x62,
+	0xa8, 0x0d, 0x75, 0x77, 0x72, 0x38, 0xb2, 0x0c, 0x8d, 0x79, 0x13, 0x5c, 0xdb, 0x6d, 0xcc, 0xb8,
+	0x8d, 0x0c, 0xf1, 0x31, 0xb9, 0xf0, 0x31, 0xb8, 0xe1, 0xef, 0xf5, 0x9f, 0xe6, 0xa0, 0x2a, 0x05,
+	0x03, 0x7d, 0x07, 0x4a, 0x2c, 0x1a, 0x52, 0x72, 0xf3, 0xee, 0xf5, 0x54, 0xde, 0x81, 0x13, 0xa1,
+	0x0e, 0xd4, 0x0f, 0x23, 0x45, 0x2c, 0x16, 0x76, 0x89, 0xa4, 0x6a, 0x9c, 0x6a, 0xfd, 0x0f, 0x72,
+	0xd0, 0x4c, 0x48, 0x14, 0xfa, 0x7f, 0x00, 0x86, 0x47, 0x58, 0xf2, 0xe8, 0xf0, 0x42, 0xcc, 0x6c,
+	0xb6, 0xfa, 0xea, 0xf2, 0x34, 0x4b, 0x4d, 0x90, 0x6c, 0x5d, 0x7c, 0x89, 0xfb, 0xbd, 0xbe, 0x0f,
+	0x8d, 0xb8, 0x28, 0xa2, 0x4f, 0xa1, 0x6e, 0x88, 0xdf, 0x57, 0x98, 0x1b, 0x48, 0x9a, 0xad, 0x8b,
+	0xad, 0x0a, 0x94, 0x08, 0x15, 0x71, 0xf5, 0x3d, 0x80, 0xe8, 0x84, 0xd0, 0x1b, 0xc9, 0x83, 0x15,
+	0xf6, 0x37, 0x3a, 0x36, 0xf5, 0x67, 0x65, 0x58, 0x8e, 0x4d, 0x73, 0xd7, 0x3a, 0x22, 0xc6, 0x85,
+	0x31, 0x22, 0x53, 0xea, 0xf3, 0x69, 0x32, 0xaf, 0x24, 0x5c, 0x9c, 0xfc, 0xd5, 0x5c, 0x9c, 0xa5,
+	0x20, 0x0d, 0x42, 0xcf, 0xe1, 0x7a, 0x32, 0x2c, 0xe7, 0xb7, 0xe2, 0x2b, 0x57, 0xbc, 0x15, 0x28,
+	0x98, 0x82, 0xa5, 0x0f, 0x0c, 0x7e, 0x8d, 0x0b, 0x92, 0xda, 0xc7, 0xeb, 0xe9, 0x7d, 0x44, 0x03,
+	0x58, 0x0c, 0x55, 0x21, 0xcf, 0x04, 0x28, 0xf5, 0x2b, 0xc9, 0xfe, 0x42, 0x48, 0xce, 0xda, 0xe8,
+	0x19, 0xac, 0x46, 0x0c, 0xb9, 0x75, 0x12, 0x45, 0x86, 0xc6, 0x65, 0xef, 0xc3, 0x72, 0xc8, 0x20,
+	0x06, 0x4d, 0x5d, 0x83, 0xe5, 0x2b, 0x5f, 0x83, 0x94, 0xac, 0xae, 0x5c, 0x59, 0x56, 0xd1, 0x07,
+	0xb0, 0xc2, 0xd8, 0xd1, 0x95, 0x25, 0x4c, 0xeb, 0x6d, 0x66, 0x5a, 0x97, 0x65, 0x67, 0x3c, 0x5d,
+	0x8f, 0x3e, 0x8a, 0xef, 0x47, 0x82, 0x4a, 0x65, 0x54, 0x2b, 0x61, 0x6f, 0x82, 0xec, 0x63, 0x50,
+	0xf8, 0xc8, 0x19, 0xc3, 0xbd, 0xc9, 0x08, 0xd7, 0x62, 0xfd, 0x71, 0xd2, 0xcf, 0x8b, 0xd5, 0x66,
+	0xeb, 0xfa, 0xe7, 0xc5, 0xea, 0x6a, 0xeb, 0xb6, 0xfa, 0xb3, 0x1c, 0x2c, 0x4d, 0x89, 0x08, 0x55,
+	0x54, 0xd3, 0xa6, 0xe1, 0xf6, 0xcb, 0x65, 0xb6, 0x1e, 0xcc, 0xf4, 0x90, 0xf3, 0x53, 0x1e, 0xf2,
+	0x5d, 0x58, 0xca, 0x72, 0x7c, 0x69, 0x00, 0xb6, 0x68, 0x24, 0x5d, 0x5e, 0xf5, 0xf7, 0xf3, 0x50,
+	0x8f, 0x4f, 0xf0, 0x61, 0x58, 0x99, 0x9a, 0x6b, 0xb6, 0x62, 0x24, 0xa9, 0xfa, 0x54, 0x1f, 0x96,
+	0x13, 0x83, 0xcb, 0xc2, 0x13, 0x8f, 0x37, 0x6f, 0xcd, 0xce, 0xb5, 0x3b, 0x36, 0x46, 0xb1, 0xd9,
+	0x71, 0x10, 0x4d, 0x23, 0x57, 0x24, 0x8b, 0xc2, 0x25, 0x58, 0x48, 0x64, 0xf4, 0x10, 0x20, 0xe6,
+	0x7a, 0x16, 0x2f, 0xe7, 0x7a, 0xc6, 0x48, 0xd4, 0xdf, 0xcb, 0xc3, 0xd2, 0xd4, 0x32, 0xd1, 0xb7,
+	0x28, 0x5b, 0xd7, 0xf2, 0xf4, 0xd8, 0xf9, 0xcd, 0x73, 0xf2, 0x63, 0xd8, 0x48, 0x85, 0xa6, 0x47,
+	0x8e, 0xa2, 0xd0, 0x52, 0xc6, 0x2e, 0x1e, 0x39, 0x92, 0x01, 0x25, 0xcd, 0x87, 0x45, 0x38, 0xae,
+	0x47, 0x8e, 0xac, 0x73, 0xe1, 0x5f, 0x2e, 0x48, 0xb4, 0x7d, 0x06, 0x45, 0xef, 0xc1, 0xf5, 0xb1,
+	0x7e, 0xae, 0xa5, 0x23, 0xb8, 0x22, 0x43, 0x6e, 0x8d, 0xf5, 0xf3, 0x7e, 0x22, 0x88, 0x7b, 0x07,
+	0x28, 0x4c, 0x8b, 0xc5, 0x89, 0xbe, 0x88, 0x26, 0x9a, 0x63, 0xfd, 0xbc, 0x23, 0xe3, 0x43, 0x9f,
+	0xba, 0xb6, 0x26, 0x19, 0xe9, 0x17, 0x34, 0x84, 0x64, 0x3e, 0x63, 0x13, 0x57, 0x19, 0xe0, 0x80,
+	0x18, 0xea, 0xbf, 0xd7, 0x12, 0x7e, 0x33, 0x57, 0x3c, 0x69, 0xc5, 0x9f, 0x70, 0x8e, 0xf3, 0x2c,
+	0xd2, 0x8d, 0x9c, 0xe3, 0x30, 0x04, 0x5e, 0x8f, 0x87, 0xc0, 0x1f, 0x03, 0x70, 0x12, 0x1a, 0x13,
+	0x5d, 0x26, 0x76, 0x62, 0xd8, 0xb4, 0x4d, 0xa5, 0x3d, 0x2c, 0x69, 0x85, 0xee, 0x3a, 0x0f, 0xa2,
+	0x16, 0x65, 0xc7, 0x96, 0x70, 0xdb, 0xb7, 0x23, 0x27, 0x8a, 0xfb, 0xda, 0x9b, 0x97, 0x35, 0x17,
+	0x42, 0xca, 0x25, 0x39, 0xcd, 0x71, 0x91, 0x91, 0xee, 0xfa, 0xc4, 0x64, 0x7b, 0x54, 0xc0, 0xb2,
+	0x49, 0x57, 0x1f, 0x9e, 0x09, 0x73, 0x93, 0x8b, 0xb8, 0x2a, 0xe3, 0x69, 0x1a, 0xcc, 0xc9, 0x50,
+	0xc9, 0x64, 0xce, 0x6e, 0x15, 0x47, 0x00, 0xf4, 0x08, 0x9a, 0xc9, 0x22, 0x4c, 0x6d, 0x5e, 0xe2,
+	0xaf, 0x1d, 0xb3, 0x05, 0x8d, 0x44, 0xe9, 0x05, 0xc3, 0xd2, 0x91, 0x6e, 0x51, 0x75, 0xcb, 0xdc,
+	0x5f, 0x6e, 0x5c, 0xe0, 0x4a, 0xc6, 0x65, 0x91, 0x33, 0xa0, 0x3e, 0x07, 0x3f, 0xe4, 0x4f, 0xa8,
+	0xf7, 0x6f, 0x10, 0x97, 0xc9, 0xfd, 0xe2, 0x7c, 0x15, 0x2e, 0xd0, 0x70, 0x44, 0x41, 0xd3, 0x45,
+	0xc4, 0xf3, 0x1c, 0x4f, 0xa3, 0x68, 0xac, 0xba, 0x57, 0xc4, 0x35, 0x06, 0xe9, 0x38, 0x26, 0x41,
+	0xf7, 0xa1, 0x6c, 0x1e, 0xb2, 0x42, 0xd6, 0x12, 0x5b, 0xf2, 0x7a, 0x36, 0xeb, 0xee, 0xd6, 0xc0,
+	0xc5, 0x25, 0xf3, 0x90, 0x96, 0xad, 0xbe, 0x01, 0x55, 0xb6, 0x3a, 0x4a, 0x84, 0xe6, 0x69, 0x06,
+	0x61, 0x4f, 0x2a, 0x14, 0x9b, 0x12, 0x7e, 0x0a, 0x75, 0x91, 0xb2, 0x8e, 0x95, 0xe1, 0x66, 0xac,
+	0x45, 0xe4, 0xb0, 0xa9, 0x39, 0x3a, 0x92, 0x3f, 0xd9, 0xd0, 0x2e, 0xf1, 0xc6, 0xb1, 0xc2, 0xdb,
+	0xad, 0x59, 0x85, 0x4a, 0x6f, 0x4c, 0x87, 0x76, 0xd9, 0x5f, 0x1f, 0x7d, 0x08, 0x15, 0x4f, 0xe7,
+	0x74, 0x2b, 0xf3, 0x0a, 0x76, 0xb8, 0xbd, 0x37, 0x70, 0x71, 0xd9, 0xd3, 0x19, 0xd5, 0x01, 0x20,
+	0x4a, 0x65, 0x38, 0x9e, 0x47, 0xa2, 0x8a, 0xdf, 0xea, 0x46, 0x61, 0x76, 0xc5, 0x00, 0xb7, 0xf7,
+	0x3a, 0x21, 0xfa, 0xc0, 0xc5, 0x2d, 0x4f, 0x1f, 0xc7, 0x01, 0x7e, 0xaa, 0x16, 0xb9, 0x76, 0xd5,
+	0x5a, 0xe4, 0xb7, 0xa0, 0x16, 0xe8, 0xb4, 0x6a, 0x4e, 0xa9, 0x15, 0x46, 0xfd, 0xda, 0x0c, 0xd1,
+	0xa2, 0x68, 0x03, 0x17, 0x57, 0x03, 0xfe, 0xc3, 0x47, 0x8f, 0xa1, 0x19, 0x5a, 0xf3, 0xc0, 0x23,
+	0x44, 0xb9, 0x31, 0xaf, 0xd6, 0xd8, 0x11, 0xa8, 0x8f, 0x46, 0x7a, 0x40, 0x13, 0x87, 0xb8, 0x21,
+	0x89, 0x87, 0x1e, 0x21, 0xe8, 0xfb, 0xb0, 0x6a, 0x1e, 0x8a, 0x74, 0x97, 0xee, 0x5d, 0x68, 0x4c,
+	0x9f, 0xb0, 0x59, 0xdd, 0x9c, 0xf7, 0xd4, 0xa1, 0xbb, 0x75, 0x20, 0x49, 0x76, 0x28, 0xc5, 0xc0,
+	0xc5, 0xd7, 0xcd, 0xc3, 0x34, 0xcc, 0x57, 0x7f, 0x91, 0x03, 0x65, 0x96, 0x36, 0xf8, 0xdf, 0x9e,
+	0xb8, 0x53, 0xff, 0x3a, 0x07, 0x65, 0xae, 0x25, 0xa8, 0xbe, 0x12, 0x89, 0x70, 0xa1, 0xa8, 0x65,
+	0x33, 0xcc, 0x82, 0xe6, 0x63, 0x59, 0xd0, 0xc7, 0xd0, 0x14, 0x99, 0xf1, 0x1f, 0x71, 0x43, 0x57,
+	0x98, 0x27, 0x6c, 0x54, 0xca, 0x2d, 0x96, 0x7a, 0xdb, 0x25, 0xa7, 0x64, 0x84, 0x93, 0xb4, 0x54,
+	0x21, 0xfe, 0xc0, 0x77, 0x6c, 0xee, 0x86, 0x88, 0xec, 0x16, 0x05, 0xb0, 0x94, 0xdb, 0x0d, 0xa8,
+	0x7a, 0xfa, 0x19, 0xef, 0x2b, 0xb1, 0x94, 0x53, 0xc5, 0xd3, 0xcf, 0x98, 0x6b, 0xf2, 0x17, 0x65,
+	0xa8, 0xc7, 0x74, 0x1c, 0x4d, 0x75, 0x31, 0xed, 0x7b, 0x4a, 0x3c, 0xe6, 0x29, 0xd7, 0x70, 0xd8,
+	0x46, 0x9f, 0xa4, 0xa3, 0xe3, 0x37, 0xe7, 0x7a, 0x09, 0xe9, 0xc0, 0xf8, 0x43, 0x28, 0x27, 0x62,
+	0xb4, 0xf9, 0x3e, 0x86, 0xc0, 0xa5, 0x29, 0xb3, 0xb8, 0xab, 0xc3, 0xce, 0xa0, 0x8a, 0xeb, 0x02,
+	0x46, 0x9d, 0x98, 0xb8, 0x99, 0x28, 0x26, 0xcd, 0x84, 0x02, 0x15, 0xc3, 0xb1, 0x7d, 0x67, 0x24,
+	0x1f, 0xef, 0xc8, 0x26, 0x7a, 0x0b, 0x16, 0xe2, 0xf1, 0x8d, 0x65, 0x8a, 0xc4, 0x5e, 0x33, 0x06,
+	0x4d, 0xa7, 0xa0, 0x2a, 0x29, 0x2b, 0x9b, 0x69, 0x14, 0xab, 0xd9, 0x46, 0x31, 0x69, 0x7b, 0x6b,
+	0x57, 0xb1, 0xbd, 0x07, 0x80, 0x84, 0x18, 0x69, 0x54, 0x43, 0x99, 0x64, 0x14, 0xe8, 0xbe, 0x02,
+	0xf3, 0x84, 0xa5, 0xcd, 0xf1, 0x71, 0x7b, 0xaf, 0x4b, 0xb1, 0x69, 0xb9, 0x98, 0x03, 0xf4, 0x31,
+	0x03, 0xf8, 0x5f, 0xae, 0xa5, 0x59, 0x4e, 0x5b, 0x9a, 0xb7, 0x60, 0x41, 0x6c, 0xac, 0xe3, 0x99,
+	0x96, 0xad, 0x8f, 0x98, 0x31, 0x6a, 0x62, 0x61, 0x79, 0x07, 0x1c, 0x88, 0x3e, 0x84, 0x55, 0xa6,
+	0x66, 0x1c, 0x4f, 0x4b, 0xa1, 0x2f, 0x89, 0x8b, 0xc9, 0x7b, 0xdb, 0x09, 0xaa, 0xef, 0xc2, 0x5d,
+	0x63, 0xe4, 0xf8, 0xc4, 0x0f, 0xb4, 0x89, 0x6d, 0x3b, 0x81, 0x75, 0x44, 0x1f, 0xd6, 0xd0, 0x68,
+	0xc1, 0xcf, 0xe0, 0x84, 0x18, 0xa7, 0xb7, 0x05, 0xc5, 0x93, 0x90, 0xa0, 0x2d, 0xf0, 0x93, 0xbc,
+	0xdf, 0x89, 0xc7, 0x8b, 0xdc, 0x85, 0xba, 0xce, 0x1d, 0xc3, 0x10, 0xcc, 0xb4, 0x96, 0xfa, 0xa7,
+	0x79, 0x68, 0x26, 0xe4, 0x3c, 0x71, 0x73, 0x72, 0xa9, 0x9b, 0xb3, 0x0a, 0x65, 0xd3, 0x3a, 0x26,
+	0x7e, 0x20, 0x14, 0x80, 0x68, 0xd1, 0xe1, 0x8e, 0x47, 0xce, 0xa1, 0x3e, 0xd2, 0x7c, 0xf2, 0xc3,
+	0x09, 0xb1, 0x0d, 0x2e, 0xdf, 0x45, 0xbc, 0xc0, 0xc1, 0x07, 0x02, 0x8a, 0x3e, 0xe3, 0xba, 0x22,
+	0x42, 0xe3, 0xbe, 0xb6, 0x3a, 0xe3, 0xf8, 0x27, 0xc1, 0x89, 0x24, 0xc5, 0x0d, 0x3d, 0xd6, 0xa2,
+	0x05, 0x5e, 0x8f, 0x18, 0xa7, 0x11, 0xa3, 0x12, 0x1b, 0xaf, 0x41, 0x81, 0x71, 0x24, 0xca, 0x2b,
+	0x42, 0xe2, 0x95, 0x94, 0x06, 0x05, 0x86, 0x48, 0x34, 0x97, 0x7d, 0x68, 0x45, 0x38, 0xfc, 0x76,
+	0xd4, 0xf5, 0x43, 0x4b, 0xa2, 0xa8, 0x7b, 0xd0, 0x88, 0x4f, 0xe5, 0x32, 0xc5, 0xc3, 0x75, 0xa8,
+	0x86, 0x1c, 0x85, 0x57, 0x2b, 0xdb, 0x6a, 0x1b, 0x16, 0x53, 0x82, 0x3d, 0x47, 0xe3, 0x2e, 0x43,
+	0x89, 0xdd, 0x14, 0xc6, 0xa5, 0x80, 0x79, 0x43, 0xfd, 0x00, 0x6a, 0x61, 0x20, 0x42, 0x95, 0x72,
+	0x70, 0xe1, 0x12, 0x51, 0x57, 0x63, 0xbf, 0x29, 0xcc, 0xd4, 0x05, 0x55, 0x03, 0xb3, 0xdf, 0xea,
+	0x4f, 0xf2, 0x50, 0x62, 0xee, 0x0d, 0xea, 0x40, 0xcd, 0x71, 0x49, 0x2c, 0x2e, 0x59, 0x98, 0xfd,
+	0x9a, 0xe0, 0x7c, 0xe0, 0x6e, 0x0e, 0x24, 0x32, 0x8e, 0xe8, 0x32, 0x6d, 0xc1, 0xb4, 0x3a, 0x2a,
+	0x64, 0xa9, 0xa3, 0x54, 0xea, 0xa4, 0xf8, 0xea, 0xa9, 0x13, 0xf5, 0x9b, 0x50, 0x0b, 0x67, 0x87,
+	0x56, 0x60, 0x69, 0xb0, 0xdf, 0xc3, 0xed, 0xe1, 0xce, 0xa0, 0xaf, 0x3d, 0xe9, 0x3f, 0xee, 0x0f,
+	0x9e, 0xf5, 0x5b, 0xd7, 0xd0, 0x32, 0xb4, 0x22, 0x70, 0x07, 0xf7, 0xda, 0xc3, 0x5e, 0x2b, 0xa7,
+	0xfe, 0x59, 0x01, 0x8a, 0xd4, 0x47, 0x44, 0x5b, 0xd3, 0xbb, 0xf1, 0x95, 0xd9, 0x2e, 0x65, 0xf6,
+	0x66, 0x44, 0x15, 0x11, 0x7e, 0xdb, 0x44, 0xb4, 0x26, 0x56, 0x4c, 0x41, 0x74, 0xbf, 0x98, 0x96,
+	0xe1, 0x3b, 0xc2, 0x7e, 0xd3, 0xd3, 0xf5, 0x0d, 0xc7, 0x25, 0xc2, 0xd4, 0xf1, 0x06, 0xd5, 0x4a,
+	0xdc, 0x5f, 0x62, 0xfb, 0xcb, 0x35, 0x3e, 0xf7, 0xa0, 0x98, 0x6c, 0xd1, 0x6c, 0x91, 0x67, 0x8d,
+	0xa9, 0x03, 0x43, 0xab, 0xee, 0x5c, 0xe1, 0x83, 0x00, 0xd1, 0xfa, 0xfd, 0x4d, 0xa8, 0x39, 0x23,
+	0x53, 0x73, 0xf5, 0x0b, 0xe2, 0x31, 0x79, 0xae, 0xe1, 0xaa, 0x33, 0x32, 0xf7, 0x69, 0x9b, 0x87,
+	0x1c, 0x67, 0xa2, 0x93, 0x6b, 0xf9, 0x2a, 0x2d, 0x78, 0xb0, 0xce, 0x1b, 0x40, 0x11, 0xb9, 0x85,
+	0xad, 0x71, 0x0b, 0xeb, 0x8c, 0x4c, 0x69, 0x7c, 0x29, 0x1d, 0xeb, 0x02, 0xde, 0x65, 0x13, 0x6e,
+	0x7c, 0xcd, 0xab, 0x9e, 0xc1, 0x4e, 0xff, 0xa0, 0x87, 0x87, 0xad, 0x5c, 0x12, 0xfa, 0x64, 0xbf,
+	0x4b, 0x4f, 0x26, 0x9f, 0x84, 0xe2, 0xde, 0xde, 0xe0, 0x69, 0xaf, 0x55, 0x50, 0x7f, 0x59, 0x00,
+	0x34, 0xed, 0x89, 0xfd, 0x9f, 0x3b, 0xbd, 0xb7, 0x60, 0x81, 0xbb, 0x9d, 0xae, 0xe3, 0x47, 0xb5,
+	0x96, 0x26, 0x6e, 0x32, 0xe8, 0xbe, 0x00, 0x52, 0x2e, 0x1c, 0xed, 0x85, 0x65, 0x9b, 0xe2, 0x10,
+	0x6b, 0x0c, 0xf2, 0xd8, 0xb2, 0xcd, 0xb4, 0x0c, 0x54, 0xe7, 0xcb, 0x40, 0x6d, 0x9e, 0x0c, 0x40,
+	0x4a, 0x06, 0xee, 0xc2, 0x12, 0xa5, 0x8c, 0xbc, 0x64, 0x3a, 0x40, 0x9d, 0x9d, 0xf8, 0xa2, 0x33,
+	0x32, 0xc3, 0x03, 0xa0, 0xa3, 0xdc, 0x85, 0x25, 0xca, 0x28, 0x89, 0xdb, 0xe0, 0xb8, 0x36, 0x39,
+	0x8b, 0xe3, 0xaa, 0x7f, 0xd3, 0x80, 0x12, 0x8b, 0x55, 0xae, 0xa0, 0x7e, 0x18, 0xfe, 0x2b, 0x9f,
+	0xd9, 0x32, 0x94, 0xf8, 0x12, 0xf9, 0xa1, 0xf1, 0x46, 0xa4, 0x51, 0x8b, 0x31, 0x8d, 0x4a, 0xa1,
+	0x3c, 0x0a, 0xe7, 0x86, 0x84, 0x37, 0xe8, 0x4c, 0xe9, 0x29, 0xfa, 0xae, 0x2e, 0xac, 0xc7, 0x4b,
+	0x66, 0xda, 0x97, 0xc8, 0x38, 0xa2, 0xa3, 0x47, 0x39, 0xb1, 0xad, 0x1f, 0x4e, 0x48, 0xec, 0xa8,
+	0x6a, 0x1c, 0x42, 0xf7, 0xf0, 0x5b, 0xa1, 0x3f, 0x59, 0x61, 0x03, 0xa8, 0xf3, 0x06, 0x48, 0x7a,
+	0x95, 0xea, 0xbf, 0x95, 0x2f, 0x71, 0xf5, 0xd6, 0x61, 0x35, 0xad, 0xfe, 0xb4, 0x61, 0x7b, 0x6b,
+	0xb7, 0xd7, 0xca, 0xa1, 0xd7, 0x61, 0x3d, 0xea, 0xeb, 0xf6, 0x1e, 0xf5, 0x30, 0xee, 0x75, 0xb5,
+	0x21, 0xfe, 0x42, 0x6b, 0x77, 0xbb, 0xad, 0x3c, 0xba, 0x0d, 0xaf, 0xcd, 0xe8, 0xef, 0xb4, 0xfb,
+	0x9d, 0xde, 0x6e, 0xab, 0x30, 0x07, 0x65, 0xff, 0xc9, 0xc1, 0x76, 0xaf, 0xdb, 0x2a, 0xa2, 0x77,
+	0xe1, 0xad, 0x19, 0x28, 0xb8, 0xbd, 0xa7, 0x75, 0x06, 0x18, 0xf7, 0x3a, 0xb4, 0xaf, 0x55, 0x42,
+	0x2a, 0xbc, 0x3e, 0x0b, 0x95, 0x29, 0x82, 0x6e, 0xab, 0x8c, 0x14, 0x58, 0x8e, 0xe3, 0xec, 0xf6,
+	0x86, 0xbd, 0xf6, 0x93, 0xe1, 0x76, 0xab, 0x82, 0x56, 0x01, 0x45, 0x3d, 0xbb, 0x3b, 0xfd, 0xc7,
+	0x0c, 0x5e, 0x4d, 0x52, 0xf4, 0x7b, 0xcf, 0xda, 0x9d, 0xce, 0xe0, 0x49, 0x7f, 0xd8, 0xaa, 0xa1,
+	0x37, 0xe0, 0x66, 0xd4, 0xb3, 0x8f, 0x77, 0xf6, 0xda, 0xf8, 0xb9, 0xb6, 0xd3, 0xef, 0xf6, 0xf8,
+	0x0e, 0x40, 0x72, 0x42, 0x49, 0x04, 0xa1, 0x9a, 0xea, 0xf3, 0x70, 0x84, 0x52, 0x6b, 0xa0, 0xf7,
+	0xe1, 0x6b, 0xf3, 0x71, 0xe8, 0x78, 0x74, 0x6e, 0xda, 0x7e, 0xfb, 0x79, 0x0f, 0xb7, 0x9a, 0xe8,
+	0x03, 0xb8, 0xf7, 0x12, 0x0a, 0x3e, 0x01, 0x6d, 0xb0, 0xdb, 0x15, 0x44, 0x0b, 0xc9, 0xc3, 0x16,
+	0xfd, 0xfc, 0xb0, 0x17, 0x93, 0x27, 0x75, 0xd0, 0xeb, 0x0c, 0xfa, 0xdd, 0xe4, 0x6a, 0x5b, 0xe8,
+	0x2b, 0xb0, 0x31, 0x1b, 0x45, 0xac, 0x77, 0x09, 0x3d, 0x80, 0xcd, 0xd9, 0x58, 0x99, 0xab, 0x41,
+	0xe8, 0x23, 0xb8, 0xff, 0x52, 0x9a, 0xa9, 0xf5, 0x5c, 0x4f, 0xda, 0x82, 0x83, 0xde, 0xb0, 0xbd,
+	0xb5, 0xd3, 0x5a, 0x4e, 0x4a, 0xfa, 0x41, 0x6f, 0xd8, 0x19, 0x74, 0x7b, 0xad, 0x95, 0xe4, 0x31,
+	0x3f, 0xe9, 0x87, 0x02, 0xb0, 0x9a, 0x3c, 0x66, 0x3e, 0x1a, 0xed, 0x91, 0xde, 0xc0, 0xda, 0x4c,
+	0x04, 0x71, 0x7e, 0x8a, 0xfa, 0x1f, 0x39, 0xa8, 0x85, 0xd7, 0x9b, 0x4e, 0xa0, 0xdf, 0xde, 0xeb,
+	0x1d, 0xec, 0xb7, 0x3b, 0xbd, 0xd8, 0x55, 0x5b, 0x82, 0x66, 0x04, 0xa6, 0x53, 0xcd, 0x25, 0x31,
+	0xa5, 0xdc, 0xe5, 0x11, 0x82, 0x85, 0x18, 0x98, 0x4e, 0xb2, 0x80, 0xd6, 0xe0, 0x7a, 0x12, 0xc6,
+	0x44, 0xb8, 0x55, 0x4c, 0x22, 0xb3, 0xb5, 0x96, 0xe8, 0x41, 0x47, 0xb0, 0xf8, 0x45, 0x69, 0x95,
+	0xd1, 0x6b, 0x70, 0x23, 0xea, 0x4b, 0xed, 0x75, 0xab, 0x82, 0xae, 0xc3, 0x62, 0xd4, 0xcd, 0x85,
+	0xa3, 0x9a, 0x1c, 0x9c, 0x01, 0x35, 0x3c, 0x78, 0xd6, 0xaa, 0xa9, 0x3f, 0x8d, 0x12, 0x03, 0x08,
+	0x16, 0xda, 0x9d, 0x94, 0x76, 0x59, 0x00, 0x10, 0x30, 0x2a, 0x41, 0x39, 0xba, 0x05, 0xa2, 0x2d,
+	0x34, 0x44, 0x9e, 0x6e, 0x81, 0x04, 0x45, 0x57, 0xbd, 0x80, 0x16, 0xa1, 0x2e, 0xc0, 0x54, 0x51,
+	0xb4, 0x8a, 0x31, 0x52, 0x21, 0x69, 0xa5, 0x18, 0x48, 0x1c, 0x44, 0x59, 0xfd, 0xad, 0x1c, 0x2c,
+	0xa6, 0x52, 0x56, 0xdc, 0xd3, 0x97, 0x6d, 0x2d, 0x4c, 0x30, 0x37, 0x22, 0xe0, 0x8e, 0x99, 0xd2,
+	0xc3, 0xf9, 0xb4, 0x1e, 0xbe, 0x82, 0xb5, 0xa0, 0x61, 0x53, 0x45, 0xe4, 0xaa, 0xe8, 0x3b, 0xc5,
+	0xb4, 0x35, 0x7b, 0x67, 0x6e, 0x76, 0xeb, 0x4b, 0xb6, 0x67, 0xd2, 0x33, 0x29, 0x66, 0x79, 0x26,
+	0xa5, 0xd9, 0x9e, 0x49, 0x39, 0xe5, 0x99, 0xa8, 0xfd, 0x2f, 0xc7, 0x8d, 0x13, 0x67, 0x97, 0x57,
+	0xff, 0xbe, 0x08, 0x65, 0x9e, 0x4f, 0x45, 0xdd, 0xe9, 0x3d, 0x7a, 0x7b, 0x5e, 0x02, 0xf6, 0x95,
+	0xb7, 0x68, 0x15, 0xca, 0x3e, 0xb1, 0xcd, 0x70, 0x8f, 0x44, 0x8b, 0x7a, 0x3c, 0xfc, 0x57, 0x94,
+	0xf0, 0xaf, 0x72, 0xc0, 0x8e, 0x19, 0xed, 0x6b, 0x29, 0xbe, 0xaf, 0xb7, 0xa1, 0xc1, 0x2a, 0xb0,
+	0xfe, 0x09, 0x0d, 0xcb, 0x03, 0xb1, 0x5f, 0xf5, 0x10, 0xd6, 0x0e, 0xa8, 0x17, 0xc6, 0xcb, 0x1f,
+	0x13, 0x3b, 0xb0, 0x46, 0xc2, 0x4b, 0x03, 0x06, 0x7a, 0x42, 0x21, 0x54, 0x2e, 0xa3, 0x9a, 0x0e,
+	0x65, 0xc2, 0xad, 0x7f, 0x23, 0x02, 0xb6, 0x83, 0x8c, 0xa0, 0xa9, 0x76, 0x89, 0xa0, 0xe9, 0xd7,
+	0xa8, 0x37, 0xab, 0x7f, 0x95, 0x7b, 0xd5, 0xa8, 0x09, 0xdd, 0x80, 0x95, 0x08, 0x4a, 0xef, 0xad,
+	0xec, 0x4a, 0xb9, 0xed, 0x8f, 0xda, 0x3b, 0xbb, 0xbd, 0x6e, 0xab, 0x90, 0x62, 0xc3, 0x55, 0x42,
+	0x11, 0xdd, 0x84, 0xb5, 0x08, 0xba, 0x37, 0xe8, 0xee, 0x3c, 0x7a, 0x2e, 0x3b, 0x4b, 0xd9, 0x9d,
+	0x7c, 0x94, 0xb2, 0xfa, 0xab, 0x1c, 0x8b, 0x7d, 0x85, 0x60, 0x3d, 0x80, 0x15, 0xdf, 0x99, 0x78,
+	0x06, 0xd1, 0x52, 0x5b, 0xc8, 0x15, 0xc0, 0x75, 0xde, 0x39, 0x9c, 0x9d, 0x0c, 0x4b, 0x97, 0x9c,
+	0xe2, 0xef, 0xb8, 0x0a, 0xc9, 0x77, 0x5c, 0xc9, 0xdc, 0x57, 0xf1, 0x2a, 0xb9, 0xaf, 0x8f, 0xa0,
+	0x22, 0xea, 0x0f, 0x4a, 0x69, 0x5e, 0xd2, 0x90, 0xaf, 0x0a, 0x97, 0x79, 0xf9, 0x41, 0xfd, 0xd7,
+	0x1c, 0xd4, 0xc2, 0xaa, 0x02, 0xbd, 0xe8, 0x2c, 0x18, 0x10, 0x4f, 0x50, 0xe9, 0xef, 0xcb, 0x5c,
+	0x89, 0xb7, 0x60, 0x41, 0x96, 0x30, 0x44, 0xf2, 0x46, 0xc4, 0xe4, 0x02, 0xda, 0x65, 0x40, 0xf4,
+	0x0d, 0xa8, 0x08, 0x80, 0x58, 0xda, 0x6b, 0x73, 0xab, 0x1c, 0x58, 0x62, 0xab, 0x5b, 0x50, 0x64,
+	0x21, 0x49, 0x0b, 0x1a, 0x8f, 0x77, 0xfa, 0xdd, 0x98, 0x04, 0xad, 0xc0, 0x12, 0x83, 0xec, 0x63,
+	0x6a, 0xf9, 0x86, 0x3b, 0x4f, 0xb9, 0x08, 0x2d, 0x41, 0x93, 0x81, 0x43, 0x50, 0x5e, 0xfd, 0x11,
+	0xb4, 0xd2, 0xa9, 0x7b, 0xf4, 0x3e, 0x2c, 0xa7, 0xb2, 0x6a, 0x7c, 0x89, 0x74, 0xf9, 0x25, 0x8c,
+	0x12, 0x39, 0x35, 0xbe, 0xd2, 0x0f, 0xe3, 0x45, 0xfc, 0x8c, 0x6d, 0x89, 0x5e, 0x2c, 0xc4, 0xa8,
+	0xd4, 0x7f, 0xcc, 0x43, 0x99, 0xd7, 0x5e, 0xae, 0xa0, 0xa6, 0x38, 0xc1, 0x2b, 0xab, 0xa9, 0x36,
+	0x8f, 0xb3, 0x69, 0xa9, 0x47, 0x3c, 0x63, 0x7b, 0xfb, 0x65, 0xe9, 0xf2, 0xc1, 0xe1, 0x0f, 0x88,
+	0x11, 0xb0, 0x78, 0x9c, 0x02, 0x29, 0x0b, 0x16, 0xc3, 0x51, 0x16, 0xb5, 0xab, 0xb1, 0xa0, 0xa1,
+	0x1e, 0xf1, 0xc6, 0xff, 0x43, 0x71, 0xfb, 0x2f, 0x72, 0xd0, 0x4a, 0xcf, 0x81, 0xaa, 0x5c, 0xe7,
+	0xcc, 0x0e, 0x53, 0x8c, 0xbc, 0x91, 0x99, 0x52, 0xfa, 0x04, 0x1a, 0xec, 0x91, 0xf5, 0xc4, 0xe5,
+	0x1f, 0xca, 0xbd, 0xbc, 0xde, 0x5b, 0xa7, 0xf8, 0x4f, 0x5c, 0xf9, 0x19, 0x5d, 0x2d, 0x7a, 0xb7,
+	0x5f, 0x9c, 0x97, 0x20, 0x8e, 0x7d, 0x3d, 0x10, 0x52, 0xa8, 0x3f, 0x06, 0x88, 0xe6, 0x9e, 0xf9,
+	0x08, 0x7c, 0x15, 0xca, 0xae, 0xee, 0x11, 0x3b, 0xcc, 0x89, 0xf2, 0x16, 0xea, 0xd2, 0x0c, 0xe5,
+	0x0f, 0x27, 0x96, 0x47, 0xad, 0xc7, 0x24, 0x38, 0x51, 0x0a, 0x97, 0x1b, 0xbc, 0x21, 0xa9, 0x28,
+	0x48, 0xfd, 0xe7, 0x1c, 0xd4, 0xc2, 0xbe, 0xff, 0x86, 0xd7, 0xfa, 0xe8, 0x33, 0xa8, 0x8a, 0x54,
+	0xa3, 0x7c, 0x33, 0xf1, 0xd5, 0x4b, 0x15, 0x6e, 0x04, 0x93, 0x90, 0x18, 0x7d, 0x1d, 0x4a, 0x67,
+	0xba, 0x15, 0xc8, 0xe7, 0x13, 0x33, 0x9e, 0xf7, 0x3d, 0xd3, 0xad, 0x40, 0x90, 0x72, 0x74, 0x75,
+	0x0b, 0x6a, 0xe1, 0x9c, 0xa8, 0x87, 0x12, 0x3d, 0x84, 0x12, 0xdb, 0x5c, 0x0b, 0xdf, 0x41, 0xd1,
+	0xbd, 0x3e, 0x63, 0x88, 0xf2, 0x13, 0x69, 0xde, 0x52, 0x3f, 0x83, 0xc5, 0xd4, 0xf4, 0xa8, 0x80,
+	0xe9, 0x46, 0xe0, 0x84, 0x02, 0xc6, 0x1a, 0xf4, 0x35, 0x8c, 0x1b, 0x22, 0x8a, 0x03, 0x8b, 0x41,
+	0xd4, 0x53, 0x58, 0xc9, 0x5c, 0x27, 0xea, 0x25, 0x08, 0x73, 0xf3, 0x3e, 0xc0, 0x4a, 0x31, 0x88,
+	0xf3, 0x9f, 0xb9, 0x80, 0x87, 0x00, 0xd1, 0xce, 0x50, 0x1b, 0x44, 0xf7, 0x86, 0x3d, 0xaa, 0x10,
+	0x1f, 0xc5, 0xd0, 0xf6, 0x01, 0x31, 0x66, 0x32, 0xf8, 0xdb, 0x02, 0x54, 0x65, 0xe9, 0x15, 0x3d,
+	0x9a, 0x56, 0x63, 0x77, 0xe6, 0x57, 0x6b, 0xb3, 0x15, 0xd9, 0xc7, 0x50, 0xa2, 0xb5, 0x47, 0x32,
+	0xff, 0x8d, 0x24, 0xe7, 0x41, 0x8b, 0x95, 0x64, 0xfb, 0x1a, 0xe6, 0x14, 0xe8, 0x3b, 0x50, 0x66,
+	0xef, 0xce, 0x8f, 0x85, 0xd8, 0xab, 0xf3, 0x68, 0x3b, 0x0c, 0x73, 0xfb, 0x1a, 0x16, 0x34, 0x08,
+	0xc3, 0x82, 0x90, 0x2b, 0x8d, 0x21, 0xc8, 0x4f, 0xe9, 0xde, 0x9d, 0xc7, 0x45, 0xe4, 0xd4, 0x77,
+	0x19, 0xc1, 0xf6, 0x35, 0x5a, 0xa4, 0x89, 0x01, 0xd0, 0x00, 0x24, 0x40, 0x8b, 0x12, 0x3d, 0xf5,
+	0x07, 0x77, 0x2e, 0xc1, 0x92, 0x55, 0x49, 0xb7, 0xaf, 0xe1, 0x86, 0x1e, 0x6b, 0xab, 0xfd, 0x2f,
+	0x57, 0x7b, 0x6e, 0x95, 0xb9, 0x79, 0x57, 0xff, 0xb3, 0x00, 0xf5, 0xd8, 0x9e, 0xa2, 0xef, 0xc3,
+	0x9a, 0x7e, 0x4a, 0x3c, 0x5a, 0xbe, 0x15, 0x6e, 0x4b, 0xf8, 0x62, 0x64, 0xee, 0xfb, 0x57, 0x36,
+	0xcb, 0xb6, 0x61, 0x4c, 0xc6, 0x93, 0x11, 0xb5, 0x94, 0x78, 0x59, 0xb0, 0xe1, 0xef, 0x87, 0xe4,
+	0x2b, 0x93, 0x29, 0xf6, 0x61, 0x91, 0x59, 0xc9, 0xbf, 0x3a, 0x7b, 0xf9, 0x46, 0x88, 0x15, 0x17,
+	0xc5, 0xf7, 0xda, 0xd1, 0xbc, 0x79, 0x71, 0x48, 0x7e, 0x81, 0x1d, 0x4e, 0x25, 0x86, 0x1b, 0x4d,
+	0xa2, 0x98, 0xc0, 0x0d, 0xf9, 0xde, 0x81, 0x16, 0xff, 0x66, 0x97, 0x72, 0x15, 0x57, 0x82, 0xa7,
+	0xee, 0x16, 0x18, 0xbc, 0x4f, 0xe4, 0x6d, 0x0a, 0x31, 0x29, 0x4f, 0x81, 0x59, 0x8e, 0x61, 0x76,
+	0xdc, 0x89, 0xc0, 0x7c, 0x1b, 0x16, 0x39, 0x26, 0xad, 0x4f, 0x1e, 0x5e, 0x04, 0xc4, 0x17, 0xd5,
+	0xa0, 0x26, 0x03, 0x63, 0x7d, 0xbc, 0x45, 0x81, 0x74, 0x9e, 0xa7, 0x96, 0x17, 0x4c, 0xc4, 0xe8,
+	0xec, 0xac, 0x98, 0x19, 0x2f, 0xe2, 0x45, 0xd1, 0xd1, 0x27, 0x5c, 0xee, 0xe2, 0xb8, 0x74, 0x7c,
+	0x8e, 0x5b, 0x4b, 0xe0, 0x76, 0xdc, 0x09, 0xc3, 0x55, 0xff, 0x29, 0x0f, 0x8d, 0xf8, 0x8d, 0x40,
+	0xbf, 0x01, 0xcb, 0x21, 0x91, 0xe6, 0xea, 0x9e, 0x3e, 0x26, 0x01, 0xfd, 0x1a, 0x2e, 0x37, 0xef,
+	0x75, 0x7e, 0x8f, 0x9a, 0x3f, 0xcb, 0x60, 0x2c, 0xf7, 0x43, 0x1a, 0x8c, 0x0c, 0x77, 0x92, 0x82,
+	0x51, 0xfe, 0xe1, 0x02, 0xe2, 0xfc, 0xf3, 0xaf, 0xc2, 0xdf, 0x26, 0x41, 0x0a, 0x86, 0x1e, 0xc1,
+	0x86, 0xbc, 0x73, 0xd1, 0xd3, 0x05, 0x29, 0x6d, 0x67, 0x96, 0x6d, 0x3a, 0x67, 0xe2, 0x31, 0xc2,
+	0x2d, 0x81, 0x27, 0xcf, 0xb7, 0xcd, 0x91, 0x9e, 0x31, 0x9c, 0x38, 0x9f, 0xe8, 0x2d, 0x43, 0x8a,
+	0x4f, 0x31, 0xc1, 0x47, 0xca, 0x54, 0x82, 0x8f, 0xfa, 0x27, 0x39, 0xb8, 0x9e, 0xa1, 0x2c, 0x66,
+	0x78, 0x23, 0x0a, 0x54, 0x84, 0xd4, 0xb1, 0x0d, 0xa9, 0x62, 0xd9, 0x64, 0xdf, 0xb3, 0x45, 0x62,
+	0x57, 0x60, 0x99, 0x01, 0xfa, 0x90, 0x2b, 0xb2, 0x62, 0x31, 0x59, 0xe3, 0x89, 0x83, 0x9a, 0x11,
+	0x8a, 0xd9, 0x4d, 0xa8, 0x45, 0x02, 0x56, 0x62, 0xbd, 0x55, 0x4f, 0xc8, 0x96, 0xfa, 0x77, 0x39,
+	0x40, 0xd3, 0xca, 0x67, 0xc6, 0x0c, 0x3b, 0xf1, 0xe7, 0x63, 0x57, 0xbb, 0xad, 0xd1, 0x33, 0xb3,
+	0x0e, 0xd4, 0xa2, 0xdb, 0x56, 0xb8, 0x1a, 0x13, 0xf9, 0xe2, 0x44, 0xae, 0x29, 0x7e, 0x65, 0xe9,
+	0x9a, 0xb8, 0xa6, 0x1c, 0x41, 0x2b, 0x4d, 0x4a, 0x9d, 0x64, 0xe6, 0xd6, 0xc9, 0xfa, 0x36, 0xb7,
+	0x73, 0xcc, 0x75, 0x93, 0x45, 0xec, 0x1b, 0x50, 0x3d, 0xd5, 0x47, 0x13, 0xa2, 0x09, 0x1f, 0xba,
+	0x88, 0x2b, 0xac, 0xdd, 0x3b, 0xa7, 0xe5, 0x55, 0xc3, 0xb1, 0xfd, 0xc9, 0x58, 0x38, 0x84, 0x45,
+	0x1c, 0xb6, 0xe9, 0x17, 0xc4, 0xab, 0xd9, 0x32, 0x4a, 0xad, 0x67, 0xa0, 0x7b, 0xc7, 0x84, 0x57,
+	0x59, 0x8b, 0x58, 0xb4, 0x50, 0x0b, 0x0a, 0x63, 0x5d, 0x0e, 0x42, 0x7f, 0xf2, 0xb3, 0xf7, 0x2c,
+	0x27, 0x7c, 0x2d, 0x23, 0x9b, 0x34, 0x9c, 0xa2, 0x6f, 0x23, 0xc7, 0x93, 0x51, 0x60, 0xd1, 0xaf,
+	0x03, 0x3d, 0xa5, 0x18, 0xbe, 0x8c, 0xdc, 0x0b, 0x81, 0xe8, 0x53, 0xf6, 0xcf, 0x2c, 0x02, 0x4f,
+	0x37, 0xe8, 0x73, 0x87, 0x40, 0x9a, 0x9b, 0x59, 0x6f, 0xb8, 0xa8, 0x15, 0xc1, 0x0d, 0x49, 0x81,
+	0xb9, 0x09, 0xad, 0x93, 0x73, 0x57, 0xb7, 0x4d, 0x4e, 0x5f, 0x7e, 0x39, 0x3d, 0x70, 0x7c, 0x4a,
+	0xad, 0x7e, 0x06, 0x25, 0x06, 0xa4, 0x3e, 0xa3, 0x3d, 0x19, 0x53, 0x3b, 0x25, 0x9c, 0xa1, 0x22,
+	0x8e, 0x00, 0xf4, 0x03, 0x39, 0x93, 0xd8, 0xce, 0xd8, 0xb2, 0x59, 0x3f, 0xdf, 0x81, 0x38, 0x48,
+	0xfd, 0xf3, 0x22, 0x8d, 0xb7, 0xe5, 0x83, 0x09, 0x99, 0x6c, 0xe2, 0x41, 0x18, 0xfb, 0x9d, 0xe9,
+	0xb5, 0x2b, 0x50, 0x19, 0x13, 0x3f, 0x14, 0xa9, 0x1a, 0x96, 0x4d, 0xf4, 0x29, 0x73, 0x2a, 0x8c,
+	0x17, 0xc2, 0x4f, 0xbc, 0xfb, 0x92, 0xd7, 0x1a, 0x9b, 0xbb, 0xce, 0xf1, 0x1e, 0x27, 0xc5, 0x9c,
+	0x70, 0xfd, 0xc7, 0x00, 0x11, 0x10, 0x75, 0xa1, 0x22, 0x1e, 0xd1, 0x08, 0xb5, 0x78, 0x19, 0x8e,
+	0xe2, 0x63, 0x3e, 0x2c, 0x49, 0xa9, 0x64, 0x1c, 0x39, 0xde, 0x58, 0x0f, 0xbd, 0x78, 0xde, 0x0a,
+	0xeb, 0xe8, 0xc5, 0xa8, 0x8e, 0xbe, 0xfe, 0xc7, 0x79, 0x80, 0x88, 0x07, 0xbd, 0x9a, 0x23, 0xea,
+	0xe8, 0xc9, 0xab, 0xc9, 0x1a, 0x94, 0xf0, 0xc8, 0x1a, 0x85, 0x9b, 0x42, 0x7f, 0x53, 0xd8, 0xc8,
+	0xb2, 0xf9, 0x8e, 0x94, 0x30, 0xfb, 0x4d, 0x07, 0x1e, 0x93, 0xe0, 0xc4, 0x91, 0x59, 0x29, 0xd1,
+	0xa2, 0x12, 0x7e, 0xe2, 0xf8, 0x41, 0xac, 0x86, 0x18, 0xb6, 0x69, 0xda, 0x89, 0x7a, 0xfd, 0xba,
+	0x19, 0x4f, 0xe4, 0x01, 0x07, 0xb1, 0x1a, 0x63, 0xe2, 0xe3, 0xc2, 0xca, 0x55, 0x3e, 0x2e, 0x8c,
+	0xed, 0x66, 0xf5, 0x95, 0x77, 0x53, 0xfd, 0x55, 0x1e, 0x2a, 0x22, 0x4f, 0x90, 0x91, 0x7e, 0xc8,
+	0x65, 0xa5, 0x1f, 0x08, 0xac, 0xf9, 0x13, 0x16, 0x1b, 0xd2, 0xef, 0x80, 0x3d, 0xe2, 0x07, 0x9e,
+	0x15, 0xbe, 0x06, 0x9f, 0x63, 0x8d, 0x0e, 0x42, 0x22, 0x1c, 0xa3, 0xc1, 0xab, 0x7e, 0x26, 0x9c,
+	0x7e, 0xb5, 0x69, 0x12, 0xdf, 0xf0, 0x2c, 0x36, 0xf9, 0x64, 0x42, 0x64, 0x29, 0xd6, 0x23, 0x66,
+	0xa5, 0x42, 0xc3, 0x24, 0x54, 0xeb, 0x13, 0xdb, 0xb0, 0x08, 0x8f, 0x6d, 0x6a, 0x38, 0x01, 0xa3,
+	0x29, 0xa8, 0xf4, 0xbf, 0x37, 0xd0, 0xd8, 0x7b, 0x0c, 0x7e, 0x6c, 0xd7, 0x53, 0xff, 0xe2, 0x60,
+	0x48, 0x9f, 0x67, 0xec, 0x40, 0xd3, 0x77, 0x89, 0x61, 0x1d, 0x59, 0x86, 0x2e, 0x6a, 0xc0, 0x85,
+	0xd9, 0x0f, 0xd1, 0x0e, 0xe2, 0xa8, 0x38, 0x49, 0xa9, 0xfe, 0x3c, 0x07, 0xab, 0xd9, 0x9b, 0x40,
+	0x2f, 0x21, 0xb1, 0x69, 0x7a, 0x97, 0x07, 0x8b, 0x55, 0x2c, 0x9b, 0xf4, 0x73, 0x07, 0xd7, 0x23,
+	0xe2, 0x5f, 0xd3, 0xf0, 0x0f, 0x63, 0x78, 0xd0, 0x29, 0x2c, 0xdd, 0x4a, 0xa2, 0x17, 0x8b, 0x4e,
+	0xf4, 0x19, 0x6c, 0x10, 0xdd, 0x1b, 0x59, 0xf4, 0xcd, 0x92, 0x3e, 0x1a, 0x39, 0x67, 0x34, 0xb6,
+	0x8d, 0x98, 0x84, 0xef, 0xb1, 0x6b, 0xf8, 0x35, 0x89, 0xd7, 0xe6, 0x68, 0xed, 0x10, 0x8b, 0x8a,
+	0x9d, 0xfa, 0x31, 0x34, 0x13, 0x8b, 0xca, 0x8c, 0xac, 0x97, 0xa1, 0xc4, 0xf4, 0xbd, 0xb8, 0x43,
+	0xbc, 0xa1, 0xfe, 0x4b, 0x0e, 0x90, 0x30, 0x8d, 0x32, 0x65, 0x84, 0xc9, 0xd1, 0x9c, 0x17, 0x34,
+	0xf4, 0xf1, 0x1c, 0xcf, 0x15, 0xc9, 0x0f, 0x33, 0x45, 0x73, 0xfa, 0xc3, 0xcc, 0x59, 0x89, 0xc0,
+	0xe2, 0xbc, 0x44, 0x60, 0xe9, 0x2a, 0x89, 0xc0, 0xcb, 0xbd, 0xd7, 0xbb, 0xfb, 0x8b, 0x1c, 0x20,
+	0xfe, 0x11, 0xbd, 0xf8, 0x60, 0xc4, 0x1a, 0xd1, 0xf8, 0xff, 0x26, 0xac, 0x6d, 0xed, 0x0e, 0x3a,
+	0x8f, 0x71, 0xef, 0x69, 0x0f, 0x1f, 0xec, 0x6c, 0xed, 0xec, 0xee, 0x0c, 0x9f, 0x6b, 0xfd, 0x41,
+	0xbf, 0xd7, 0xba, 0x46, 0xab, 0x7b, 0x19, 0x9d, 0xb2, 0xc5, 0xaa, 0xbd, 0x6f, 0xc2, 0x1b, 0x19,
+	0x28, 0x3b, 0x38, 0x86, 0x94, 0x47, 0xb7, 0x40, 0xc9, 0x40, 0x3a, 0x18, 0xb6, 0x77, 0x7b, 0xbc,
+	0xda, 0x9b, 0xd1, 0xbb, 0xd7, 0x7e, 0xbe, 0xd5, 0xe3, 0x28, 0xc5, 0xbb, 0x3f, 0x49, 0x7e, 0x0c,
+	0x21, 0xbe, 0xc4, 0x5a, 0x87, 0xd5, 0x21, 0x6e, 0xf7, 0x0f, 0x78, 0x39, 0xe7, 0x60, 0xd8, 0x1e,
+	0x3e, 0x39, 0x90, 0x53, 0x7f, 0x1d, 0xd6, 0xa7, 0xfb, 0x7a, 0x5f, 0xf4, 0x3a, 0x4f, 0x86, 0xbd,
+	0x6e, 0x2b, 0x97, 0xdd, 0x7f, 0x30, 0x78, 0x34, 0xa4, 0x59, 0xe6, 0x56, 0x3e, 0xbb, 0x7f, 0xbb,
+	0x8d, 0xbb, 0xac, 0xbf, 0x40, 0xeb, 0x61, 0xd3, 0xfd, 0xdd, 0xde, 0x6e, 0xfb, 0x39, 0x2b, 0x4f,
+	0x67, 0x76, 0xf7, 0xbe, 0xd8, 0xdf, 0xc1, 0xbd, 0x6e, 0xab, 0x94, 0xdd, 0x2d, 0x63, 0xbc, 0x72,
+	0xf6, 0xe0, 0x3c, 0x97, 0xdd, 0xeb, 0xb6, 0x2a, 0x5b, 0xed, 0xef, 0x3e, 0x3c, 0xb6, 0x82, 0x93,
+	0xc9, 0xe1, 0xa6, 0xe1, 0x8c, 0xef, 0xb1, 0x0b, 0xfe, 0x9e, 0xe5, 0x88, 0x1f, 0xfc, 0x1f, 0xcc,
+	0xb9, 0x87, 0xf7, 0xb2, 0xfe, 0xdf, 0xdc, 0xb7, 0xdd, 0x43, 0xf6, 0xf3, 0xb0, 0xcc, 0x84, 0xea,
+	0x83, 0xff, 0x1a, 0x00, 0x7a, 0xc6, 0x31, 0xa0, 0x96, 0x4e, 0x00, 0x00,
 }
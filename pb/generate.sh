@@ -24,9 +24,18 @@ function main() {
   trap "cd \"$current_dir\"" EXIT
   pushd "$ROOT/pb" &> /dev/null
 
+  # `DBSecondaryIndexOp` (and `TransactionTrace.db_secondary_index_ops`) are part of the
+  # generated `codec.pb.go` but must come from `proto-eosio`, regenerating from a revision
+  # not having them yet would silently drop them
+  if ! grep -q "message DBSecondaryIndexOp" "$PROTO_EOSIO/dfuse/eosio/codec/v1/codec.proto"; then
+    echo "The proto-eosio checkout at '$PROTO_EOSIO' does not define 'DBSecondaryIndexOp' in 'dfuse/eosio/codec/v1/codec.proto', update it first"
+    exit 1
+  fi
+
   generate "dfuse/eosio/abicodec/v1/abicodec.proto"
   generate "dfuse/eosio/codec/v1/codec.proto"
   generate "dfuse/eosio/eosdb/v1/eosdb.proto"
+  generate "dfuse/eosio/fluxdb/v1/fluxdb.proto"
   generate "dfuse/eosio/funnel/v1/funnel.proto"
   generate "dfuse/eosio/search/v1/search.proto"
 
@@ -36,7 +45,8 @@ function main() {
 }
 
 function generate() {
-    protoc -I$PROTO -I$PROTO_EOSIO $1 --go_out=plugins=grpc,paths=source_relative:.
+    # `-I.` resolves the definitions living only in this repository (`fluxdb.proto`), all the others come from `proto` and `proto-eosio`
+    protoc -I. -I$PROTO -I$PROTO_EOSIO $1 --go_out=plugins=grpc,paths=source_relative:.
 }
 
 main "$@"